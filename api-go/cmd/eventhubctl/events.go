@@ -0,0 +1,188 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+type attendeeOutput struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// exportedEvent replaces the attendee IDs of the event with the attendees.
+type exportedEvent struct {
+	dtos.EventDto
+	Attendees []attendeeOutput `json:"attendees"`
+}
+
+// eventSelection holds the flags choosing the events to list or export.
+type eventSelection struct {
+	category  *string
+	term      *string
+	organizer *string
+}
+
+func newEventSelection(flags *flag.FlagSet) eventSelection {
+	return eventSelection{
+		category:  flags.String("category", "", "only the published events of this category"),
+		term:      flags.String("term", "", "only the published events whose name or description contains this"),
+		organizer: flags.String("organizer", "", "every event of the organizer with this email, unpublished included"),
+	}
+}
+
+func runEvents(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch command, args := args[0], args[1:]; command {
+	case "list":
+		flags := flag.NewFlagSet("events list", flag.ExitOnError)
+		selection := newEventSelection(flags)
+		flags.Parse(args)
+
+		events, err := a.selectEvents(selection)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(events))
+		for _, event := range events {
+			rows = append(rows, []string{
+				event.ID,
+				event.Name,
+				event.Date.Format(time.RFC3339),
+				event.Category,
+				strconv.Itoa(len(event.Attendees)) + "/" + limitString(event.Limit),
+				event.OrganizerID,
+			})
+		}
+		return a.out.print(events, []string{"ID", "NAME", "DATE", "CATEGORY", "ATTENDEES", "ORGANIZER"}, rows)
+	case "attendees":
+		flags := flag.NewFlagSet("events attendees", flag.ExitOnError)
+		eventID := flags.String("event", "", "ID of the event")
+		flags.Parse(args)
+		if *eventID == "" {
+			return errors.New("-event is required")
+		}
+
+		event, err := a.events.FindByID(a.ctx, *eventID)
+		if err != nil {
+			return err
+		}
+		attendees, err := a.attendees(event.Attendees())
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(attendees))
+		for _, attendee := range attendees {
+			rows = append(rows, []string{attendee.ID, attendee.Name, attendee.Email})
+		}
+		return a.out.print(attendees, []string{"ID", "NAME", "EMAIL"}, rows)
+	case "export":
+		flags := flag.NewFlagSet("events export", flag.ExitOnError)
+		selection := newEventSelection(flags)
+		file := flags.String("file", "", "file to write, standard output when empty")
+		flags.Parse(args)
+
+		events, err := a.selectEvents(selection)
+		if err != nil {
+			return err
+		}
+		exported := make([]exportedEvent, 0, len(events))
+		for _, event := range events {
+			attendees, err := a.attendees(event.Attendees)
+			if err != nil {
+				return err
+			}
+			exported = append(exported, exportedEvent{EventDto: event, Attendees: attendees})
+		}
+
+		var w io.Writer = os.Stdout
+		if *file != "" {
+			f, err := os.Create(*file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := writeJSON(w, exported); err != nil {
+			return err
+		}
+		if *file != "" {
+			fmt.Fprintf(os.Stderr, "exported %d events to %s\n", len(exported), *file)
+		}
+		return nil
+	default:
+		return errUsage
+	}
+}
+
+// selectEvents runs the listing use case matching the selection. Finding no
+// events is not an error here.
+func (a *app) selectEvents(s eventSelection) ([]dtos.EventDto, error) {
+	set := 0
+	for _, value := range []string{*s.category, *s.term, *s.organizer} {
+		if value != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, errors.New("-category, -term and -organizer are exclusive")
+	}
+
+	var (
+		events []dtos.EventDto
+		err    error
+	)
+	switch {
+	case *s.organizer != "":
+		organizer, findErr := a.users.FindByEmail(a.ctx, *s.organizer)
+		if findErr != nil {
+			return nil, findErr
+		}
+		events, err = usecases.NewGetEventsByOrganizerUseCase(a.events).Execute(usecases.GetEventsByOrganizerProps{Ctx: a.ctx, OrganizerID: organizer.GetID()})
+	case *s.category != "":
+		events, err = usecases.NewGetEventsByCategoryUseCase(a.events).Execute(usecases.GetEventsByCategoryProps{Ctx: a.ctx, Category: *s.category})
+	case *s.term != "":
+		events, err = usecases.NewGetEventsByTermUseCase(a.events).Execute(usecases.GetEventsByTermProps{Ctx: a.ctx, Term: *s.term})
+	default:
+		events, err = usecases.NewGetEventsUseCase(a.events).Execute(usecases.GetEventsProps{Ctx: a.ctx})
+	}
+
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return []dtos.EventDto{}, nil
+	}
+	return events, err
+}
+
+func (a *app) attendees(ids []string) ([]attendeeOutput, error) {
+	attendees := make([]attendeeOutput, 0, len(ids))
+	for _, id := range ids {
+		user, err := a.users.FindById(a.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		attendees = append(attendees, attendeeOutput{ID: user.GetID(), Name: user.GetName(), Email: user.GetEmail()})
+	}
+	return attendees, nil
+}
+
+func limitString(limit int) string {
+	if limit == 0 {
+		return "∞"
+	}
+	return strconv.Itoa(limit)
+}
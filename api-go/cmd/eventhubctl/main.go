@@ -0,0 +1,137 @@
+// Command eventhubctl runs operational tasks against the EventHub database
+// through the same repositories and use cases as the API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/bootstrap"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/migrations"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"github.com/joho/godotenv"
+)
+
+const usage = `usage: eventhubctl [-env FILE] [-o table|json] <command> [flags]
+
+commands:
+  users create-admin -email E -name N [-password P]
+                     create a verified admin account; a password is
+                     generated and printed when omitted
+  users reset-password -email E [-password P]
+                     replace a password and log the user out everywhere
+  events list [-category C | -term T | -organizer EMAIL]
+                     list published events, or every event of an organizer
+  events attendees -event ID
+                     list the attendees of an event
+  events export [-category C | -term T | -organizer EMAIL] [-file F]
+                     write the events with their attendees as JSON
+  migrate up | down [-steps N] | status
+                     apply, revert or list schema migrations
+  seed [-password P] [-events N]
+                     create demo users and events`
+
+// errUsage makes main print the usage and exit with status 2.
+var errUsage = errors.New("invalid usage")
+
+// app holds what the commands share. Commands print through out.
+type app struct {
+	ctx    context.Context
+	out    output
+	uow    repositories.UnitOfWork
+	users  repositories.UserRepository
+	events repositories.IEventRepository
+	hasher services.IPasswordHasher
+	policy usecases.PasswordPolicy
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("eventhubctl: ")
+
+	flags := flag.NewFlagSet("eventhubctl", flag.ExitOnError)
+	flags.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	envFile := flags.String("env", "../.env", "file to load the environment from, if it exists")
+	format := flags.String("o", formatTable, "output format, table or json")
+	flags.Parse(os.Args[1:])
+
+	if *format != formatTable && *format != formatJSON {
+		log.Fatalf("unknown output format %q", *format)
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if err := godotenv.Load(*envFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Fatalf("loading %s: %v", *envFile, err)
+	}
+
+	sqlDb := connection.SetupConfig(os.Getenv("DB_HOST"), os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"))
+	defer sqlDb.Close()
+
+	a := &app{ctx: context.Background(), out: output{format: *format, w: os.Stdout}}
+	command, args := flags.Arg(0), flags.Args()[1:]
+
+	var err error
+	if command == "migrate" {
+		err = runMigrate(a, args)
+	} else {
+		err = a.setup()
+		if err == nil {
+			err = a.run(command, args)
+		}
+	}
+	if errors.Is(err, errUsage) {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%s: %v", command, err)
+	}
+}
+
+// setup checks the schema is current and builds the repositories and the
+// password settings the other commands need.
+func (a *app) setup() error {
+	if err := migrations.EnsureUpToDate(connection.Db); err != nil {
+		return fmt.Errorf("database schema is not up to date (%w), run `eventhubctl migrate up` first", err)
+	}
+
+	passwordConfig, err := config.NewPasswordConfig(os.Getenv)
+	if err != nil {
+		return fmt.Errorf("loading password settings: %w", err)
+	}
+	a.hasher, a.policy, err = bootstrap.NewPasswords(passwordConfig)
+	if err != nil {
+		return fmt.Errorf("loading password settings: %w", err)
+	}
+
+	a.uow = database.NewUnitOfWork(connection.Db)
+	a.users = database.NewUserRepository(connection.Db, mappers.UserMapper{})
+	a.events = database.NewEventRepository(connection.Db, mappers.EventMapper{})
+	return nil
+}
+
+func (a *app) run(command string, args []string) error {
+	switch command {
+	case "users":
+		return runUsers(a, args)
+	case "events":
+		return runEvents(a, args)
+	case "seed":
+		return runSeed(a, args)
+	default:
+		return errUsage
+	}
+}
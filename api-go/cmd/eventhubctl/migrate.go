@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/migrations"
+)
+
+type migrationOutput struct {
+	Version   int64  `json:"version"`
+	Name      string `json:"name"`
+	AppliedAt string `json:"applied_at,omitempty"`
+	// Missing is true when the database records a version that has no file.
+	Missing bool `json:"missing,omitempty"`
+}
+
+func runMigrate(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	migrator, err := migrations.NewMigrator(connection.Db)
+	if err != nil {
+		return err
+	}
+
+	var changed []migrations.Migration
+	switch command, args := args[0], args[1:]; command {
+	case "up":
+		changed, err = migrator.Up()
+	case "down":
+		flags := flag.NewFlagSet("migrate down", flag.ExitOnError)
+		steps := flags.Int("steps", 1, "number of migrations to revert")
+		flags.Parse(args)
+		changed, err = migrator.Down(*steps)
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+
+		result := make([]migrationOutput, 0, len(statuses))
+		rows := make([][]string, 0, len(statuses))
+		for _, s := range statuses {
+			m := migrationOutput{Version: s.Version, Name: s.Name, Missing: s.Missing}
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				m.AppliedAt = s.AppliedAt.Format("2006-01-02T15:04:05Z07:00")
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if s.Missing {
+				appliedAt += " (file missing)"
+			}
+			result = append(result, m)
+			rows = append(rows, []string{fmt.Sprintf("%04d", s.Version), s.Name, appliedAt})
+		}
+		return a.out.print(result, []string{"VERSION", "NAME", "APPLIED AT"}, rows)
+	default:
+		return errUsage
+	}
+
+	// Print what changed even when a later migration failed.
+	result := make([]migrationOutput, 0, len(changed))
+	rows := make([][]string, 0, len(changed))
+	for _, m := range changed {
+		result = append(result, migrationOutput{Version: m.Version, Name: m.Name})
+		rows = append(rows, []string{fmt.Sprintf("%04d", m.Version), m.Name})
+	}
+	if printErr := a.out.print(result, []string{"VERSION", "NAME"}, rows); printErr != nil && err == nil {
+		err = printErr
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+// demoDomain keeps the demo accounts apart from real ones; seeding twice
+// fails as their emails are taken.
+const demoDomain = "eventhub.demo"
+
+type demoEvent struct {
+	name, location, category, description string
+	limit                                 int
+}
+
+var demoEvents = []demoEvent{
+	{name: "Go Meetup", location: "Curitiba", category: "tech", description: "Talks and pizza with the local Go community", limit: 40},
+	{name: "Jazz Night", location: "São Paulo", category: "music", description: "An evening of live jazz", limit: 0},
+	{name: "City Run 5K", location: "Florianópolis", category: "sports", description: "A run along the coast", limit: 200},
+	{name: "Design Workshop", location: "Porto Alegre", category: "design", description: "Hands-on prototyping session", limit: 15},
+	{name: "Startup Pitch", location: "Belo Horizonte", category: "business", description: "Founders pitch to investors", limit: 60},
+}
+
+var demoParticipants = []string{"Ana", "Bruno", "Carla", "Diego"}
+
+type seedOutput struct {
+	Users  []dtos.UserResponseDTO `json:"users"`
+	Events []dtos.EventDto        `json:"events"`
+}
+
+// runSeed creates an organizer, a few participants and events they are
+// registered to, all through the use cases the API runs.
+func runSeed(a *app, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ExitOnError)
+	password := flags.String("password", "eventhub-demo-2024", "password of every demo account")
+	count := flags.Int("events", len(demoEvents), "number of events to create")
+	flags.Parse(args)
+	if *count < 1 {
+		return fmt.Errorf("-events must be positive")
+	}
+
+	provision := usecases.NewProvisionUserUseCase(a.uow, a.hasher, a.policy)
+	newUser := func(name, userType string) (*dtos.UserResponseDTO, error) {
+		return provision.Execute(dtos.ProvisionUserDto{
+			Ctx:      a.ctx,
+			Name:     name,
+			Email:    fmt.Sprintf("%s@%s", strings.ToLower(name), demoDomain),
+			Password: *password,
+			UserType: userType,
+			Method:   auditMethod,
+		})
+	}
+
+	var result seedOutput
+	organizer, err := newUser("Organizer", "organizer")
+	if err != nil {
+		return err
+	}
+	result.Users = append(result.Users, *organizer)
+	for _, name := range demoParticipants {
+		participant, err := newUser(name, "participant")
+		if err != nil {
+			return err
+		}
+		result.Users = append(result.Users, *participant)
+	}
+
+	createEvent := usecases.NewCreateEventUseCase(a.uow, usecases.EmailVerificationPolicy{})
+	register := usecases.NewRegisterToEventUseCase(a.uow, usecases.EmailVerificationPolicy{})
+	start := time.Now().AddDate(0, 0, 7)
+	for i := 0; i < *count; i++ {
+		demo := demoEvents[i%len(demoEvents)]
+		name := demo.name
+		if i >= len(demoEvents) {
+			name = fmt.Sprintf("%s #%d", demo.name, i/len(demoEvents)+1)
+		}
+		date := time.Date(start.Year(), start.Month(), start.Day()+7*i, 19, 0, 0, 0, time.UTC)
+
+		event, err := createEvent.Execute(dtos.CreateEventProps{
+			Ctx:         a.ctx,
+			Name:        name,
+			Location:    demo.location,
+			Date:        date.Format("2006-01-02T15:04"),
+			Description: demo.description,
+			OrganizerID: organizer.ID,
+			Category:    demo.category,
+			Limit:       demo.limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Every other participant registers, starting with a different one
+		// per event.
+		for j, participant := range result.Users[1:] {
+			if (i+j)%2 != 0 {
+				continue
+			}
+			attendees, err := register.Execute(usecases.RegisterToEventUseCaseProps{Ctx: a.ctx, UserId: participant.ID, EventId: event.ID})
+			if err != nil {
+				return err
+			}
+			event.Attendees = attendees
+		}
+		result.Events = append(result.Events, *event)
+	}
+
+	rows := make([][]string, 0, len(result.Users)+len(result.Events))
+	for _, user := range result.Users {
+		rows = append(rows, []string{"user", user.ID, user.Email})
+	}
+	for _, event := range result.Events {
+		rows = append(rows, []string{"event", event.ID, event.Name})
+	}
+	return a.out.print(result, []string{"KIND", "ID", "NAME"}, rows)
+}
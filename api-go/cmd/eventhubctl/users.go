@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// auditMethod tells the audit log the changes came from eventhubctl.
+const auditMethod = "cli"
+
+// credentialsOutput shows a password only when eventhubctl generated it.
+type credentialsOutput struct {
+	dtos.UserResponseDTO
+	Password string `json:"password,omitempty"`
+}
+
+func runUsers(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch command, args := args[0], args[1:]; command {
+	case "create-admin":
+		flags := flag.NewFlagSet("users create-admin", flag.ExitOnError)
+		email := flags.String("email", "", "email of the admin")
+		name := flags.String("name", "", "name of the admin")
+		password := flags.String("password", "", "password to set, generated when empty")
+		flags.Parse(args)
+		if *email == "" || *name == "" {
+			return errors.New("-email and -name are required")
+		}
+
+		generated, err := passwordOrGenerate(password)
+		if err != nil {
+			return err
+		}
+		user, err := usecases.NewProvisionUserUseCase(a.uow, a.hasher, a.policy).Execute(dtos.ProvisionUserDto{
+			Ctx:      a.ctx,
+			Name:     *name,
+			Email:    *email,
+			Password: *password,
+			UserType: models.UserTypeAdmin,
+			Method:   auditMethod,
+		})
+		if err != nil {
+			return err
+		}
+		return a.printCredentials(*user, generated)
+	case "reset-password":
+		flags := flag.NewFlagSet("users reset-password", flag.ExitOnError)
+		email := flags.String("email", "", "email of the user")
+		password := flags.String("password", "", "password to set, generated when empty")
+		flags.Parse(args)
+		if *email == "" {
+			return errors.New("-email is required")
+		}
+
+		generated, err := passwordOrGenerate(password)
+		if err != nil {
+			return err
+		}
+		if _, err := usecases.NewSetPasswordUseCase(a.uow, a.hasher, a.policy).Execute(dtos.SetPasswordDto{
+			Ctx:      a.ctx,
+			Email:    *email,
+			Password: *password,
+			Method:   auditMethod,
+		}); err != nil {
+			return err
+		}
+		user, err := a.users.FindByEmail(a.ctx, *email)
+		if err != nil {
+			return err
+		}
+		return a.printCredentials(dtos.UserResponseDTO{
+			ID:        user.GetID(),
+			Name:      user.GetName(),
+			Email:     user.GetEmail(),
+			UserType:  user.GetUserType(),
+			CreatedAt: user.GetCreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		}, generated)
+	default:
+		return errUsage
+	}
+}
+
+// passwordOrGenerate fills an empty password with a random one, which it
+// returns to be shown.
+func passwordOrGenerate(password *string) (string, error) {
+	if *password != "" {
+		return "", nil
+	}
+	generated, err := utils.NewOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	*password = generated
+	return generated, nil
+}
+
+func (a *app) printCredentials(user dtos.UserResponseDTO, generated string) error {
+	headers := []string{"ID", "NAME", "EMAIL", "TYPE"}
+	row := []string{user.ID, user.Name, user.Email, user.UserType}
+	if generated != "" {
+		headers, row = append(headers, "PASSWORD"), append(row, generated)
+	}
+	return a.out.print(credentialsOutput{UserResponseDTO: user, Password: generated}, headers, [][]string{row})
+}
@@ -1,13 +1,23 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/bootstrap"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
 	"github.com/Gabriel-Schiestl/api-go/internal/controllers"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 	_ "github.com/Gabriel-Schiestl/api-go/internal/controllers"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/migrations"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/ports"
 	"github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/validation"
 	"github.com/Gabriel-Schiestl/go-clarch/presentation/controller"
 	"github.com/joho/godotenv"
 )
@@ -18,11 +28,87 @@ func main() {
 		log.Fatalf("Error loading env: %v", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
 	sqlDb := connection.SetupConfig(os.Getenv("DB_HOST"), os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"))
 	defer sqlDb.Close()
 
-	controllers.SetupControllers()
+	if err := migrations.EnsureUpToDate(connection.Db); err != nil {
+		log.Fatalf("Database schema is not up to date (%v), run `go run ./cmd migrate up` first", err)
+	}
+
+	timeouts, err := config.NewTimeoutConfig(os.Getenv("REQUEST_TIMEOUT"), os.Getenv("ROUTE_TIMEOUTS"))
+	if err != nil {
+		log.Fatalf("Error loading timeouts: %v", err)
+	}
+
+	authConfig, err := config.NewAuthConfig(os.Getenv)
+	if err != nil {
+		log.Fatalf("Error loading auth settings: %v", err)
+	}
+
+	oidcConfig, err := config.NewOIDCConfig(os.Getenv, authConfig.APIURL)
+	if err != nil {
+		log.Fatalf("Error loading OIDC providers: %v", err)
+	}
+
+	cookieConfig, err := config.NewCookieConfig(os.Getenv, authConfig.APIURL)
+	if err != nil {
+		log.Fatalf("Error loading cookie settings: %v", err)
+	}
+
+	passwordConfig, err := config.NewPasswordConfig(os.Getenv)
+	if err != nil {
+		log.Fatalf("Error loading password settings: %v", err)
+	}
+
+	jwtService, err := newJWTService(config.NewJWTConfig(os.Getenv, authConfig.APIURL))
+	if err != nil {
+		log.Fatalf("Error loading JWT signing keys: %v", err)
+	}
+
+	passwordHasher, passwordPolicy, err := bootstrap.NewPasswords(passwordConfig)
+	if err != nil {
+		log.Fatalf("Error loading password settings: %v", err)
+	}
+
+	if err := usecases.FailInterruptedEventImports(context.Background(), database.NewEventImportRepository(connection.Db, mappers.EventImportMapper{})); err != nil {
+		log.Fatalf("Error failing interrupted event imports: %v", err)
+	}
+
+	if err := validation.Setup(database.NewUserRepository(connection.Db, mappers.UserMapper{})); err != nil {
+		log.Fatalf("Error setting up request validation: %v", err)
+	}
+
+	// Not decorated: the props carry the raw key, and sessions are checked
+	// on every request.
+	apiKeys := usecases.NewAuthenticateAPIKeyUseCase(database.NewUnitOfWork(connection.Db))
+	sessions := usecases.NewAuthenticateSessionUseCase(database.NewUnitOfWork(connection.Db))
+	if err := server.Setup(timeouts, config.ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES")), jwtService, sessions, apiKeys); err != nil {
+		log.Fatalf("Error setting up the server: %v", err)
+	}
+	controllers.SetupControllers(authConfig, oidcConfig, cookieConfig, jwtService, passwordHasher, passwordPolicy)
 	controller.SetupRoutes()
 
 	server.Router.Run(":8080")
-}
\ No newline at end of file
+}
+
+func newJWTService(cfg *config.JWTConfig) (services.IJWTService, error) {
+	if cfg.KeysFile == "" {
+		log.Println("JWT_KEYS_FILE is not set, signing tokens with a temporary key; they will not survive a restart")
+		key, err := ports.NewEphemeralSigningKey()
+		if err != nil {
+			return nil, err
+		}
+		return ports.NewJWTService(ports.JWTSettings{Issuer: cfg.Issuer, Audience: cfg.Audience, Keys: []ports.SigningKey{key}})
+	}
+
+	keys, err := ports.LoadSigningKeys(cfg.KeysFile)
+	if err != nil {
+		return nil, err
+	}
+	return ports.NewJWTService(ports.JWTSettings{Issuer: cfg.Issuer, Audience: cfg.Audience, Keys: keys})
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/migrations"
+)
+
+const migrateUsage = `usage: main migrate <command> [flags]
+
+commands:
+  up                 apply all pending migrations
+  down [-steps N]    revert the last N applied migrations (default 1)
+  status             list migrations and whether they are applied
+  create [-dir D] NAME
+                     write an empty up/down pair for a new migration`
+
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+
+	command, args := args[0], args[1:]
+
+	if command == "create" {
+		flags := flag.NewFlagSet("create", flag.ExitOnError)
+		dir := flags.String("dir", migrations.DefaultDir, "directory holding the migration files")
+		flags.Parse(args)
+		if flags.NArg() != 1 {
+			log.Fatalf("migrate create: expected exactly one migration name")
+		}
+
+		paths, err := migrations.Create(*dir, flags.Arg(0))
+		if err != nil {
+			log.Fatalf("migrate create: %v", err)
+		}
+		for _, path := range paths {
+			fmt.Println("created", path)
+		}
+		return
+	}
+
+	sqlDb := connection.SetupConfig(os.Getenv("DB_HOST"), os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"))
+	defer sqlDb.Close()
+
+	migrator, err := migrations.NewMigrator(connection.Db)
+	if err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("migrate up: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("nothing to apply, schema is up to date")
+		}
+	case "down":
+		flags := flag.NewFlagSet("down", flag.ExitOnError)
+		steps := flags.Int("steps", 1, "number of migrations to revert")
+		flags.Parse(args)
+
+		reverted, err := migrator.Down(*steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("migrate down: %v", err)
+		}
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatalf("migrate status: %v", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if s.Missing {
+				appliedAt += " (file missing)"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		w.Flush()
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,52 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+// What DeleteAccountDto.OrganizedEvents does with the events the user
+// organizes.
+const (
+	OrganizedEventsTransfer = "transfer"
+	OrganizedEventsCancel   = "cancel"
+)
+
+// UserDataExportDto holds everything the account stores about its owner,
+// as LGPD requires us to hand over on request.
+type UserDataExportDto struct {
+	ExportedAt      time.Time          `json:"exported_at"`
+	Profile         ProfileDto         `json:"profile"`
+	Registrations   []ExportedEventDto `json:"registrations"`
+	OrganizedEvents []ExportedEventDto `json:"organized_events"`
+}
+
+// ExportedEventDto counts the attendees instead of listing them, as they
+// are other people's data.
+type ExportedEventDto struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Location       string    `json:"location"`
+	Date           time.Time `json:"date"`
+	Description    string    `json:"description"`
+	Category       string    `json:"category"`
+	Limit          int       `json:"limit"`
+	AttendeesCount int       `json:"attendees_count"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type DeleteAccountDto struct {
+	Ctx    context.Context `json:"-"`
+	UserID string          `json:"-"`
+	// Password is required when the account has one. Accounts without a
+	// password, such as those created by an OIDC login, are confirmed by a
+	// recent login instead.
+	Password string `json:"password"`
+	// OrganizedEvents is required when the user organizes events.
+	OrganizedEvents string `json:"organized_events" binding:"omitempty,oneof=transfer cancel"`
+	// TransferTo is the email of the user who takes the events over.
+	TransferTo string `json:"transfer_to" binding:"required_if=OrganizedEvents transfer,omitempty,email"`
+	IP         string `json:"-"`
+	// SessionID is the session of the access token making the request.
+	SessionID string `json:"-"`
+}
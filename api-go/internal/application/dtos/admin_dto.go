@@ -0,0 +1,75 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+// UserSearchDto filters the users listed to admins. Term matches names and
+// emails regardless of case.
+type UserSearchDto struct {
+	Ctx      context.Context `json:"-"`
+	AdminID  string          `json:"-"`
+	Term     string          `form:"q" binding:"omitempty,max=255"`
+	UserType string          `form:"user_type" binding:"omitempty,oneof=participant organizer admin"`
+	Status   string          `form:"status" binding:"omitempty,oneof=active suspended deleted"`
+	Limit    int             `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+// AdminUserDto is a user as admins see them.
+type AdminUserDto struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Email            string     `json:"email"`
+	UserType         string     `json:"userType"`
+	EmailVerified    bool       `json:"email_verified"`
+	CreatedAt        time.Time  `json:"created_at"`
+	SuspendedAt      *time.Time `json:"suspended_at"`
+	SuspensionReason string     `json:"suspension_reason,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at"`
+}
+
+type SuspendUserDto struct {
+	Ctx     context.Context `json:"-"`
+	AdminID string          `json:"-"`
+	UserID  string          `json:"-"`
+	Reason  string          `json:"reason" binding:"required,max=500"`
+	IP      string          `json:"-"`
+}
+
+// ReactivateUserDto, ModerateEventDto and RemoveAttendeeDto take their
+// optional reason from the query string, as the requests have no body.
+type ReactivateUserDto struct {
+	Ctx     context.Context `json:"-"`
+	AdminID string          `json:"-"`
+	UserID  string          `json:"-"`
+	Reason  string          `form:"reason" binding:"max=500"`
+	IP      string          `json:"-"`
+}
+
+// ModerateEventDto asks to cancel, unpublish or republish an event.
+type ModerateEventDto struct {
+	Ctx     context.Context `json:"-"`
+	AdminID string          `json:"-"`
+	EventID string          `json:"-"`
+	Reason  string          `form:"reason" binding:"max=500"`
+	IP      string          `json:"-"`
+}
+
+type ReassignOrganizerDto struct {
+	Ctx         context.Context `json:"-"`
+	AdminID     string          `json:"-"`
+	EventID     string          `json:"-"`
+	OrganizerID string          `json:"organizer_id" binding:"required,max=255"`
+	Reason      string          `json:"reason" binding:"max=500"`
+	IP          string          `json:"-"`
+}
+
+type RemoveAttendeeDto struct {
+	Ctx     context.Context `json:"-"`
+	AdminID string          `json:"-"`
+	EventID string          `json:"-"`
+	UserID  string          `json:"-"`
+	Reason  string          `form:"reason" binding:"max=500"`
+	IP      string          `json:"-"`
+}
@@ -0,0 +1,33 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+type APIKeyDto struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedAPIKeyDto is the only response carrying the key itself.
+type CreatedAPIKeyDto struct {
+	APIKeyDto
+	Key string `json:"key"`
+}
+
+type CreateAPIKeyDto struct {
+	Ctx    context.Context `json:"-"`
+	UserID string          `json:"-"`
+	Name   string          `json:"name" binding:"required,max=100"`
+	Scopes []string        `json:"scopes" binding:"required,min=1,dive,oneof=events:read events:write registrations:write"`
+	// ExpiresAt is optional; keys without it stay valid until revoked.
+	ExpiresAt *time.Time `json:"expires_at"`
+	IP        string     `json:"-"`
+}
@@ -0,0 +1,42 @@
+package dtos
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Formats attendee lists can be exported to.
+const (
+	AttendeeExportCSV  = "csv"
+	AttendeeExportXLSX = "xlsx"
+)
+
+type ExportAttendeesDto struct {
+	Ctx         context.Context `json:"-"`
+	EventID     string          `json:"-"`
+	OrganizerID string          `json:"-"`
+	Format      string          `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	IP          string          `json:"-"`
+}
+
+// AttendeeExportDto streams the attendees of an event in registration order.
+// Rows reads them in batches as it is iterated and stops at the first error.
+type AttendeeExportDto struct {
+	EventID        string
+	EventName      string
+	AttendeesCount int
+	// Questions are the columns of the answers, in the order of the event.
+	Questions []string
+	Rows      iter.Seq2[AttendeeRowDto, error]
+}
+
+type AttendeeRowDto struct {
+	Name  string
+	Email string
+	// RegisteredAt is zero for registrations made before it was recorded.
+	RegisteredAt time.Time
+	TicketType   string
+	Answers      map[string]string
+	CheckedInAt  *time.Time
+}
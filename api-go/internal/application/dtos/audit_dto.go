@@ -0,0 +1,42 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+// AuditLogQueryDto filters the audit log. From is inclusive and To
+// exclusive, both in RFC 3339; pass the created_at of the last entry of a
+// page as To to get the next one.
+type AuditLogQueryDto struct {
+	Ctx context.Context `json:"-"`
+	// AdminID is the user asking, who must be an admin.
+	AdminID    string    `json:"-"`
+	ActorID    string    `form:"actor_id" binding:"omitempty,max=255"`
+	TargetType string    `form:"target_type" binding:"omitempty,oneof=user event"`
+	TargetID   string    `form:"target_id" binding:"omitempty,max=255"`
+	Action     string    `form:"action" binding:"omitempty,max=100"`
+	From       time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00" binding:"omitempty,gtfield=From"`
+	Limit      int       `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+type AuditEntryDto struct {
+	ID string `json:"id"`
+	// ActorID is null for anonymous actors, such as failed logins.
+	ActorID    *string                   `json:"actor_id"`
+	Action     string                    `json:"action"`
+	TargetType string                    `json:"target_type"`
+	TargetID   string                    `json:"target_id"`
+	IP         string                    `json:"ip"`
+	RequestID  string                    `json:"request_id,omitempty"`
+	Details    map[string]string         `json:"details,omitempty"`
+	Changes    map[string]AuditChangeDto `json:"changes,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+// AuditChangeDto is null on the side where the resource did not exist.
+type AuditChangeDto struct {
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
@@ -1,8 +1,18 @@
 package dtos
 
+import (
+	"context"
+	"time"
+)
+
 type LoginDto struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Ctx      context.Context `json:"-"`
+	Email    string          `json:"email" binding:"required,email"`
+	Password string          `json:"password" binding:"required"`
+	// IP is the client address failed attempts are also counted against.
+	IP string `json:"-"`
+	// UserAgent is recorded, with IP, on the session the login opens.
+	UserAgent string `json:"-"`
 }
 
 type LoginResponseDto struct {
@@ -10,8 +20,48 @@ type LoginResponseDto struct {
 	User  UserResponseDTO `json:"user"`
 }
 
-type AuthResponseDTO struct {
-	ID        string `json:"id"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
+// CredentialsDto tells users how they can sign in, leaving the secrets out.
+type CredentialsDto struct {
+	HasPassword       bool                `json:"has_password"`
+	PasswordChangedAt *time.Time          `json:"password_changed_at"`
+	TwoFactorEnabled  bool                `json:"two_factor_enabled"`
+	Identities        []LinkedIdentityDto `json:"identities"`
+}
+
+type LinkedIdentityDto struct {
+	Provider string    `json:"provider"`
+	Email    string    `json:"email"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+type ForgotPasswordDto struct {
+	Ctx   context.Context `json:"-"`
+	Email string          `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordDto struct {
+	Ctx      context.Context `json:"-"`
+	Token    string          `json:"token" binding:"required"`
+	Password string          `json:"password" binding:"required,max=256,strongpassword"`
+	IP       string          `json:"-"`
+}
+
+type VerifyEmailDto struct {
+	Ctx   context.Context `json:"-"`
+	Token string          `form:"token" binding:"required"`
+}
+
+type UnlockAccountDto struct {
+	Ctx   context.Context `json:"-"`
+	Token string          `form:"token" binding:"required"`
+	IP    string          `form:"-" json:"-"`
+}
+
+// SetPasswordDto replaces the password of the account with Email on behalf
+// of an operator. Method tells the audit log how, such as "cli".
+type SetPasswordDto struct {
+	Ctx      context.Context
+	Email    string
+	Password string
+	Method   string
 }
@@ -0,0 +1,105 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+// Outcomes of the attendees of a bulk operation.
+const (
+	AttendeeAdded   = "added"
+	AttendeeInvited = "invited"
+	AttendeeRemoved = "removed"
+	AttendeeMoved   = "moved"
+	AttendeeFailed  = "failed"
+)
+
+// AddAttendeesDto registers the owners of the emails, and invites the
+// addresses without an account. OverrideLimit lets them in even when the
+// event is full.
+type AddAttendeesDto struct {
+	Ctx           context.Context `json:"-"`
+	OrganizerID   string          `json:"-"`
+	EventID       string          `json:"-"`
+	Emails        []string        `json:"emails" binding:"required,min=1,max=500,dive,required,email,max=255"`
+	OverrideLimit bool            `json:"override_limit"`
+	IP            string          `json:"-"`
+}
+
+type RemoveAttendeesDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	EventID     string          `json:"-"`
+	UserIDs     []string        `json:"user_ids" binding:"required,min=1,max=500,dive,required,max=255"`
+	IP          string          `json:"-"`
+}
+
+// MoveAttendeesDto moves attendees from EventID to ToEventID, both
+// organized by the caller.
+type MoveAttendeesDto struct {
+	Ctx           context.Context `json:"-"`
+	OrganizerID   string          `json:"-"`
+	EventID       string          `json:"-"`
+	ToEventID     string          `json:"to_event_id" binding:"required,max=255"`
+	UserIDs       []string        `json:"user_ids" binding:"required,min=1,max=500,dive,required,max=255"`
+	OverrideLimit bool            `json:"override_limit"`
+	IP            string          `json:"-"`
+}
+
+// AttendeeOutcomeDto is what a bulk operation did with one of the emails or
+// users it was given. Error explains failed outcomes.
+type AttendeeOutcomeDto struct {
+	Email    string `json:"email,omitempty"`
+	UserID   string `json:"user_id,omitempty"`
+	Status   string `json:"status"`
+	InviteID string `json:"invite_id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BulkAttendeesResultDto lists an outcome per email or user, in the order
+// they were given, and the attendees of the event afterwards.
+type BulkAttendeesResultDto struct {
+	Results   []AttendeeOutcomeDto `json:"results"`
+	Attendees []string             `json:"attendees"`
+}
+
+// EventRegistrationDto is what attendees registering themselves send: one
+// of the ticket types of the event and an answer to each of its questions.
+type EventRegistrationDto struct {
+	TicketType string            `json:"ticket_type" binding:"max=100"`
+	Answers    map[string]string `json:"answers" binding:"max=20,dive,max=2000"`
+}
+
+type CheckInAttendeeDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	EventID     string          `json:"-"`
+	UserID      string          `json:"-"`
+	IP          string          `json:"-"`
+}
+
+type AttendeeCheckInDto struct {
+	UserID      string    `json:"user_id"`
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
+
+type ListEventInvitesDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	EventID     string          `json:"-"`
+}
+
+type CancelEventInviteDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	EventID     string          `json:"-"`
+	InviteID    string          `json:"-"`
+	IP          string          `json:"-"`
+}
+
+type EventInviteDto struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	OverLimit bool      `json:"over_limit"`
+	CreatedAt time.Time `json:"created_at"`
+}
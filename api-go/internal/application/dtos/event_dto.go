@@ -1,6 +1,9 @@
 package dtos
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type EventDto struct {
 	ID          string    `json:"id"`
@@ -13,16 +16,26 @@ type EventDto struct {
 	CreatedAt   time.Time    `json:"created_at"`
 	Category	string    `json:"category"`
 	Limit 	 int       `json:"limit"`
+	TicketTypes []string `json:"ticket_types,omitempty"`
+	Questions   []string `json:"questions,omitempty"`
 }
 
 type CreateEventProps struct {
-	Name        string    `json:"name"`
-    Location    string    `json:"location"`
-    Date        string    `json:"date"`
-    Description string    `json:"description"`
+	Ctx         context.Context `json:"-"`
+	Name        string    `json:"name" binding:"required,max=255"`
+    Location    string    `json:"location" binding:"required,max=255"`
+    Date        string    `json:"date" binding:"required,futuredate"`
+    // Timezone is the IANA zone the date is expressed in; UTC when omitted.
+    Timezone    string    `json:"timezone" binding:"omitempty,ianazone"`
+    Description string    `json:"description" binding:"max=5000"`
     OrganizerID string    
-    Category    string    `json:"category"`
-    Limit       int       `json:"limit"`
+    Category    string    `json:"category" binding:"required,max=255"`
+    Limit       int       `json:"limit" binding:"gte=0"`
+    // TicketTypes, when given, are the ones attendees choose from.
+    TicketTypes []string  `json:"ticket_types" binding:"omitempty,max=20,dive,required,max=100"`
+    // Questions are asked to attendees when they register.
+    Questions   []string  `json:"questions" binding:"omitempty,max=20,dive,required,max=255"`
+    IP          string    `json:"-"`
 }
 
 type EventWithAttendeesDto struct {
@@ -37,15 +50,26 @@ type EventWithAttendeesDto struct {
 	CreatedAt     time.Time `json:"created_at"`
 	Category      string    `json:"category"`
 	Limit         int       `json:"limit"`
+	Published     bool      `json:"published"`
+	TicketTypes   []string  `json:"ticket_types"`
+	Questions     []string  `json:"questions"`
 }
 
 type UpdateEventProps struct {
+	Ctx         context.Context `json:"-"`
 	EventID     string    `json:"event_id"`
-	Name        string    `json:"name"`
-	Location    string    `json:"location"`
-	Date        string    `json:"date"`
-	Description string    `json:"description"`
+	Name        string    `json:"name" binding:"required,max=255"`
+	Location    string    `json:"location" binding:"required,max=255"`
+	// Past dates are accepted so organizers can still edit finished events.
+	Date        string    `json:"date" binding:"required,eventdate"`
+	Timezone    string    `json:"timezone" binding:"omitempty,ianazone"`
+	Description string    `json:"description" binding:"max=5000"`
 	OrganizerID string    
-	Category    string    `json:"category"`
-	Limit       int       `json:"limit"`
+	Category    string    `json:"category" binding:"required,max=255"`
+	Limit       int       `json:"limit" binding:"gte=0"`
+	// TicketTypes and Questions are kept when omitted; an empty list
+	// removes them.
+	TicketTypes []string  `json:"ticket_types" binding:"omitempty,max=20,dive,required,max=100"`
+	Questions   []string  `json:"questions" binding:"omitempty,max=20,dive,required,max=255"`
+	IP          string    `json:"-"`
 }
\ No newline at end of file
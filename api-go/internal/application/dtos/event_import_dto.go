@@ -0,0 +1,63 @@
+package dtos
+
+import (
+	"context"
+	"time"
+)
+
+// Formats events can be imported from.
+const (
+	EventImportCSV  = "csv"
+	EventImportJSON = "json"
+)
+
+// ImportEventsDto reads its options from the query string, as the body is
+// the file being imported.
+type ImportEventsDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	Format      string          `form:"format" binding:"omitempty,oneof=csv json"`
+	Mode        string          `form:"mode" binding:"omitempty,oneof=all_or_nothing best_effort"`
+	DryRun      bool            `form:"dry_run"`
+	Async       bool            `form:"async"`
+	Data        []byte          `json:"-"`
+	IP          string          `json:"-"`
+}
+
+// ImportEventRowDto is an event of an imported file. CSV files name their
+// columns after the JSON fields.
+type ImportEventRowDto struct {
+	Name        string `json:"name"`
+	Location    string `json:"location"`
+	Date        string `json:"date"`
+	Timezone    string `json:"timezone"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	Limit       int    `json:"limit"`
+}
+
+type GetEventImportDto struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string          `json:"-"`
+	ImportID    string          `json:"-"`
+}
+
+type EventImportDto struct {
+	ID         string                   `json:"id"`
+	Format     string                   `json:"format"`
+	Mode       string                   `json:"mode"`
+	DryRun     bool                     `json:"dry_run"`
+	Status     string                   `json:"status"`
+	Total      int                      `json:"total"`
+	Processed  int                      `json:"processed"`
+	Imported   int                      `json:"imported"`
+	Errors     []EventImportRowErrorDto `json:"errors"`
+	Failure    string                   `json:"failure,omitempty"`
+	CreatedAt  time.Time                `json:"created_at"`
+	FinishedAt *time.Time               `json:"finished_at"`
+}
+
+type EventImportRowErrorDto struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,27 @@
+package dtos
+
+import "context"
+
+// OIDCLoginStartDto holds the provider URL to redirect to and the values the
+// callback is checked against, which the controller keeps in a cookie.
+type OIDCLoginStartDto struct {
+	AuthURL      string
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+type OIDCCallbackDto struct {
+	Ctx      context.Context `form:"-" json:"-"`
+	Provider string          `form:"-" json:"-"`
+	Code     string          `form:"code"`
+	State    string          `form:"state"`
+	// Error is set by the provider when the user did not log in.
+	Error string `form:"error"`
+	// ExpectedState, Nonce and CodeVerifier come from OIDCLoginStartDto.
+	ExpectedState string `form:"-" json:"-"`
+	Nonce         string `form:"-" json:"-"`
+	CodeVerifier  string `form:"-" json:"-"`
+	IP            string `form:"-" json:"-"`
+	UserAgent     string `form:"-" json:"-"`
+}
@@ -0,0 +1,48 @@
+package dtos
+
+import "context"
+
+// ProfileDto is what users see of their own account.
+type ProfileDto struct {
+	UserResponseDTO
+	EmailVerified bool `json:"email_verified"`
+	// PendingEmail is the new address awaiting confirmation, if any.
+	PendingEmail string `json:"pending_email,omitempty"`
+	AvatarURL    string `json:"avatar_url"`
+	Bio          string `json:"bio"`
+	Phone        string `json:"phone"`
+	Company      string `json:"company"`
+}
+
+// UpdateProfileDto only changes the fields present in the request; an empty
+// string clears an optional field.
+type UpdateProfileDto struct {
+	Ctx       context.Context `json:"-"`
+	UserID    string          `json:"-"`
+	Name      *string         `json:"name" binding:"omitnil,min=1,max=255"`
+	AvatarURL *string         `json:"avatar_url" binding:"omitnil,max=500,len=0|http_url"`
+	Bio       *string         `json:"bio" binding:"omitnil,max=500"`
+	Phone     *string         `json:"phone" binding:"omitnil,len=0|e164"`
+	Company   *string         `json:"company" binding:"omitnil,max=255"`
+	// Email is only switched once the link mailed to it is followed, and
+	// changing it requires CurrentPassword.
+	Email           *string `json:"email" binding:"omitnil,email,max=255"`
+	CurrentPassword string  `json:"current_password"`
+	IP              string  `json:"-"`
+}
+
+type ChangePasswordDto struct {
+	Ctx             context.Context `json:"-"`
+	UserID          string          `json:"-"`
+	CurrentPassword string          `json:"current_password" binding:"required"`
+	// The PasswordPolicy sets the length; max only bounds the hashing work.
+	NewPassword string `json:"new_password" binding:"required,max=256,strongpassword"`
+	IP          string `json:"-"`
+	UserAgent   string `json:"-"`
+}
+
+type ConfirmEmailChangeDto struct {
+	Ctx   context.Context `json:"-"`
+	Token string          `form:"token" binding:"required"`
+	IP    string          `form:"-" json:"-"`
+}
@@ -0,0 +1,52 @@
+package dtos
+
+import "context"
+
+// LoginResultDto carries either the access token or, when two-factor
+// authentication is enabled, the challenge to answer at /auth/login/2fa.
+type LoginResultDto struct {
+	Token             string `json:"token,omitempty"`
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	ChallengeToken    string `json:"challenge_token,omitempty"`
+}
+
+type TwoFactorLoginDto struct {
+	Ctx            context.Context `json:"-"`
+	ChallengeToken string          `json:"challenge_token" binding:"required"`
+	// Code is either the current TOTP code or an unused recovery code.
+	Code      string `json:"code" binding:"required,max=32"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type TwoFactorEnrollmentDto struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+	// QRCode is a PNG data URI of OTPAuthURI.
+	QRCode string `json:"qr_code"`
+}
+
+type ConfirmTwoFactorDto struct {
+	Ctx    context.Context `json:"-"`
+	UserID string          `json:"-"`
+	Code   string          `json:"code" binding:"required,len=6,numeric"`
+}
+
+type DisableTwoFactorDto struct {
+	Ctx      context.Context `json:"-"`
+	UserID   string          `json:"-"`
+	Password string          `json:"password" binding:"required"`
+	Code     string          `json:"code" binding:"required,max=32"`
+}
+
+type RegenerateRecoveryCodesDto struct {
+	Ctx    context.Context `json:"-"`
+	UserID string          `json:"-"`
+	Code   string          `json:"code" binding:"required,len=6,numeric"`
+}
+
+// RecoveryCodesDto is only returned when the codes are generated; they cannot
+// be read back afterwards.
+type RecoveryCodesDto struct {
+	Codes []string `json:"recovery_codes"`
+}
@@ -1,10 +1,15 @@
 package dtos
 
+import "context"
+
 type CreateUserDTO struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	UserType string `json:"userType"`
+	Ctx   context.Context `json:"-"`
+	Name  string          `json:"name" binding:"required,max=255"`
+	Email string          `json:"email" binding:"required,email,max=255,emailavailable"`
+	// The PasswordPolicy sets the length; max only bounds the hashing work.
+	Password string `json:"password" binding:"required,max=256,strongpassword"`
+	UserType string `json:"userType" binding:"omitempty,oneof=participant organizer"`
+	IP       string `json:"-"`
 }
 
 type UserResponseDTO struct {
@@ -14,3 +19,14 @@ type UserResponseDTO struct {
 	UserType  string `json:"userType"`
 	CreatedAt string `json:"created_at"`
 }
+
+// ProvisionUserDto creates an account without going through sign-up.
+// Method tells the audit log how, such as "cli".
+type ProvisionUserDto struct {
+	Ctx      context.Context
+	Name     string
+	Email    string
+	Password string
+	UserType string
+	Method   string
+}
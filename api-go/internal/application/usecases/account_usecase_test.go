@@ -0,0 +1,166 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+func TestExportUserData(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	registered := f.addEvent(t, other.GetID(), 10, user.GetID(), other.GetID()+"-friend")
+	organized := f.addEvent(t, user.GetID(), 10)
+	f.addEvent(t, other.GetID(), 10)
+
+	export, err := usecases.NewExportUserDataUseCase(f.uow).Execute(usecases.ExportUserDataProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	if export.Profile.Email != "user@example.com" {
+		t.Fatalf("unexpected profile %+v", export.Profile)
+	}
+	if len(export.Registrations) != 1 || export.Registrations[0].ID != registered.ID() || export.Registrations[0].AttendeesCount != 2 {
+		t.Fatalf("unexpected registrations %+v", export.Registrations)
+	}
+	if len(export.OrganizedEvents) != 1 || export.OrganizedEvents[0].ID != organized.ID() {
+		t.Fatalf("unexpected organized events %+v", export.OrganizedEvents)
+	}
+
+	actions := f.auditActions()
+	if len(actions) != 1 || actions[0] != models.AuditActionDataExported {
+		t.Fatalf("expected the export to be audited, got %v", actions)
+	}
+}
+
+func TestDeleteAccountTransfersOrganizedEvents(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	heir := f.addUser(t, "heir@example.com", "secret123")
+	registered := f.addEvent(t, heir.GetID(), 10, user.GetID())
+	organized := f.addEvent(t, user.GetID(), 10, heir.GetID())
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	uc := usecases.NewDeleteAccountUseCase(f.uow, f.hasher)
+
+	var validation *exceptions.ValidationException
+	if _, err := uc.Execute(dtos.DeleteAccountDto{Ctx: f.ctx, UserID: user.GetID(), Password: "wrong", OrganizedEvents: dtos.OrganizedEventsCancel}); !errors.As(err, &validation) {
+		t.Fatalf("expected the password to be required, got %v", err)
+	}
+	var conflict *exceptions.ConflictException
+	if _, err := uc.Execute(dtos.DeleteAccountDto{Ctx: f.ctx, UserID: user.GetID(), Password: "secret123"}); !errors.As(err, &conflict) {
+		t.Fatalf("expected organizers to choose what happens to their events, got %v", err)
+	}
+	if got := f.attendees(t, registered.ID()); len(got) != 1 {
+		t.Fatalf("expected a failed deletion to change nothing, got attendees %v", got)
+	}
+
+	_, err := uc.Execute(dtos.DeleteAccountDto{
+		Ctx:             f.ctx,
+		UserID:          user.GetID(),
+		Password:        "secret123",
+		OrganizedEvents: dtos.OrganizedEventsTransfer,
+		TransferTo:      heir.GetEmail(),
+	})
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	if got := f.attendees(t, registered.ID()); len(got) != 0 {
+		t.Fatalf("expected the user to leave the event, got attendees %v", got)
+	}
+	event, err := f.events.FindByID(f.ctx, organized.ID())
+	if err != nil {
+		t.Fatalf("finding event: %v", err)
+	}
+	if event.OrganizerID() != heir.GetID() || len(event.Attendees()) != 0 {
+		t.Fatalf("expected the event to be transferred, got organizer %s and attendees %v", event.OrganizerID(), event.Attendees())
+	}
+
+	stored, err := f.users.FindById(f.ctx, user.GetID())
+	if err != nil {
+		t.Fatalf("find user: %v", err)
+	}
+	if stored.GetDeletedAt() == nil || stored.GetEmail() == "user@example.com" || stored.GetName() == "User user@example.com" || f.auth(t, user.GetID()).HasPassword() {
+		t.Fatalf("expected the user to be anonymized, got %q <%s>", stored.GetName(), stored.GetEmail())
+	}
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := usecases.NewAuthenticateSessionUseCase(f.uow).Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the sessions to be logged out, got %v", err)
+	}
+
+	actions := f.auditActions()
+	if actions[len(actions)-1] != models.AuditActionAccountDeleted {
+		t.Fatalf("expected the deletion to be audited, got %v", actions)
+	}
+}
+
+func TestDeleteAccountCancelsOrganizedEvents(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	organized := f.addEvent(t, user.GetID(), 10, attendee.GetID())
+
+	_, err := usecases.NewDeleteAccountUseCase(f.uow, f.hasher).Execute(dtos.DeleteAccountDto{
+		Ctx:             f.ctx,
+		UserID:          user.GetID(),
+		Password:        "secret123",
+		OrganizedEvents: dtos.OrganizedEventsCancel,
+	})
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	var notFound *exceptions.NotFoundException
+	if _, err := f.events.FindByID(f.ctx, organized.ID()); !errors.As(err, &notFound) {
+		t.Fatalf("expected the event to be cancelled, got %v", err)
+	}
+}
+
+func TestDeleteAccountWithoutPasswordNeedsARecentLogin(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	otherSessionID := f.loginSession(t, other.GetEmail(), "secret123", "10.0.0.2")
+	// Accounts created by an OIDC login have no password.
+	auth := f.auth(t, user.GetID())
+	auth.RemovePassword(time.Now())
+	if err := f.auths.Save(f.ctx, auth); err != nil {
+		t.Fatalf("saving credentials: %v", err)
+	}
+	userID := user.GetID()
+	oldLogin := time.Now().Add(-time.Hour)
+	stale, err := models.NewSession(models.SessionProps{UserID: &userID, CreatedAt: &oldLogin})
+	if err != nil {
+		t.Fatalf("creating session: %v", err)
+	}
+	if err := f.stores.Sessions.Create(f.ctx, stale); err != nil {
+		t.Fatalf("storing session: %v", err)
+	}
+	uc := usecases.NewDeleteAccountUseCase(f.uow, f.hasher)
+
+	for name, id := range map[string]string{"no session": "", "stale login": stale.GetID(), "someone else's login": otherSessionID} {
+		var forbidden *exceptions.ForbiddenException
+		if _, err := uc.Execute(dtos.DeleteAccountDto{Ctx: f.ctx, UserID: userID, SessionID: id}); !errors.As(err, &forbidden) {
+			t.Fatalf("%s: expected a new login to be required, got %v", name, err)
+		}
+	}
+
+	if _, err := uc.Execute(dtos.DeleteAccountDto{Ctx: f.ctx, UserID: userID, SessionID: sessionID}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	deleted, err := f.users.FindById(f.ctx, userID)
+	if err != nil {
+		t.Fatalf("finding user: %v", err)
+	}
+	if deleted.GetEmail() == user.GetEmail() {
+		t.Fatalf("expected the account to be anonymized, got %s", deleted.GetEmail())
+	}
+}
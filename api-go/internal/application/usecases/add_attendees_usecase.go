@@ -0,0 +1,150 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type addAttendeesUseCase struct {
+	uow         repositories.UnitOfWork
+	mailer      services.IMailer
+	frontendURL string
+}
+
+func NewAddAttendeesUseCase(uow repositories.UnitOfWork, mailer services.IMailer, frontendURL string) *addAttendeesUseCase {
+	return &addAttendeesUseCase{uow: uow, mailer: mailer, frontendURL: frontendURL}
+}
+
+// Execute registers the owners of the emails on their behalf and invites
+// the addresses without an account, which are registered once they sign up
+// and verify it. Emails repeated in any case are handled once. Attendees the
+// event rules refuse fail alone, the others are still added.
+func (uc *addAttendeesUseCase) Execute(props dtos.AddAttendeesDto) (*dtos.BulkAttendeesResultDto, error) {
+	var (
+		event   models.Event
+		results []dtos.AttendeeOutcomeDto
+		invited []models.EventInvite
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		event, err = findOrganizedEventForUpdate(ctx, repos.Events(), props.EventID, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+
+		eventID := event.ID()
+		pending, err := repos.EventInvites().FindPendingByEvent(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		invites := map[string]models.EventInvite{}
+		for _, invite := range pending {
+			invites[invite.GetEmail()] = invite
+		}
+
+		seen := map[string]bool{}
+		var added, invitedEmails []string
+		before := eventAuditFields(event)
+		for _, email := range props.Emails {
+			email = strings.TrimSpace(email)
+			key := models.NormalizeInviteEmail(email)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			outcome := dtos.AttendeeOutcomeDto{Email: email}
+			user, err := repos.Users().FindByEmail(ctx, email)
+			var notFound *exceptions.NotFoundException
+			switch {
+			case errors.As(err, &notFound):
+				invite, ok := invites[key]
+				if !ok {
+					invite, err = models.NewEventInvite(models.EventInviteProps{
+						EventID:   &eventID,
+						Email:     &email,
+						InvitedBy: &props.OrganizerID,
+						OverLimit: props.OverrideLimit,
+					})
+					if err != nil {
+						return err
+					}
+					if err := repos.EventInvites().Create(ctx, invite); err != nil {
+						return err
+					}
+					invites[key] = invite
+					invited = append(invited, invite)
+					invitedEmails = append(invitedEmails, invite.GetEmail())
+				}
+				outcome.Status = dtos.AttendeeInvited
+				outcome.InviteID = invite.GetID()
+			case err != nil:
+				return err
+			case user.IsSuspended():
+				outcome.UserID = user.GetID()
+				outcome.Status = dtos.AttendeeFailed
+				outcome.Error = "Account suspended"
+			default:
+				outcome.UserID = user.GetID()
+				if err := addAttendee(event, user.GetID(), props.OverrideLimit); err != nil {
+					if !attendeeRuleError(err) {
+						return err
+					}
+					outcome.Status = dtos.AttendeeFailed
+					outcome.Error = err.Error()
+					break
+				}
+				outcome.Status = dtos.AttendeeAdded
+				added = append(added, user.GetID())
+			}
+			results = append(results, outcome)
+		}
+
+		if len(added) == 0 && len(invitedEmails) == 0 {
+			return nil
+		}
+		if len(added) > 0 {
+			if err := repos.Events().Save(ctx, event); err != nil {
+				return err
+			}
+		}
+		details := map[string]string{}
+		setJoined(details, "user_ids", added)
+		setJoined(details, "invited", invitedEmails)
+		if props.OverrideLimit {
+			details["override_limit"] = "true"
+		}
+		return auditAttendees(ctx, repos.AuditLog(), models.AuditActionAttendeesAdded, props.OrganizerID, event.ID(), props.IP, details, before, eventAuditFields(event))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The invites stand even if a mail is lost; the organizer can see them
+	// and add the address again once it has an account.
+	for _, invite := range invited {
+		if err := uc.sendInvite(props.Ctx, event, invite); err != nil {
+			log.Printf("Sending invite %s: %v", invite.GetID(), err)
+		}
+	}
+
+	return &dtos.BulkAttendeesResultDto{Results: results, Attendees: event.Attendees()}, nil
+}
+
+func (uc *addAttendeesUseCase) sendInvite(ctx context.Context, event models.Event, invite models.EventInvite) error {
+	return uc.mailer.Send(ctx, services.Mail{
+		To:      invite.GetEmail(),
+		Subject: fmt.Sprintf("Convite para %s", event.Name()),
+		Body: fmt.Sprintf("Olá!\n\nVocê foi convidado para o evento %q, em %s, no dia %s.\n\nCrie a sua conta com este e-mail para confirmar a sua inscrição:\n\n%s/register\n\nSe você não esperava este convite, ignore este e-mail.",
+			event.Name(), event.Location(), event.Date().Format("02/01/2006 15:04 MST"), uc.frontendURL),
+	})
+}
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+// requireAdmin answers a ForbiddenException unless userID belongs to an
+// admin whose account was not deleted.
+func requireAdmin(ctx context.Context, users repositories.UserRepository, userID string) error {
+	user, err := users.FindById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsAdmin() || user.GetDeletedAt() != nil {
+		return exceptions.NewForbiddenException("Admin access required")
+	}
+	return nil
+}
+
+func errAccountSuspended() error {
+	return exceptions.NewForbiddenException("Account suspended")
+}
+
+// checkNotSuspended answers a ForbiddenException when an admin suspended
+// the account of userID.
+func checkNotSuspended(ctx context.Context, users repositories.UserRepository, userID string) error {
+	user, err := users.FindById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsSuspended() {
+		return errAccountSuspended()
+	}
+	return nil
+}
+
+func newAdminUserDto(user models.User) dtos.AdminUserDto {
+	dto := dtos.AdminUserDto{
+		ID:            user.GetID(),
+		Name:          user.GetName(),
+		Email:         user.GetEmail(),
+		UserType:      user.GetUserType(),
+		EmailVerified: user.IsEmailVerified(),
+		CreatedAt:     user.GetCreatedAt(),
+		DeletedAt:     user.GetDeletedAt(),
+	}
+	if suspension := user.GetSuspension(); suspension != nil {
+		dto.SuspendedAt = &suspension.At
+		dto.SuspensionReason = suspension.Reason
+	}
+	return dto
+}
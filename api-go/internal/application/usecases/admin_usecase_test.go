@@ -0,0 +1,270 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+func TestSuspendedUsersAreLockedOut(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	user := f.addUser(t, "user@example.com", "secret123")
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+
+	suspended, err := usecases.NewSuspendUserUseCase(f.uow).Execute(dtos.SuspendUserDto{
+		Ctx: f.ctx, AdminID: admin.GetID(), UserID: user.GetID(), Reason: "Spam", IP: "10.0.0.9",
+	})
+	if err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+	if suspended.SuspendedAt == nil || suspended.SuspensionReason != "Spam" {
+		t.Fatalf("expected the suspension to be returned, got %+v", suspended)
+	}
+
+	var forbidden *exceptions.ForbiddenException
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := usecases.NewAuthenticateSessionUseCase(f.uow).Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the open session to be revoked, got %v", err)
+	}
+	login := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard)
+	if _, err := login.Execute(dtos.LoginDto{Ctx: f.ctx, Email: user.GetEmail(), Password: "secret123"}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected suspended users to be refused at login, got %v", err)
+	}
+	if _, err := login.Execute(dtos.LoginDto{Ctx: f.ctx, Email: user.GetEmail(), Password: "wrong-password"}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected a wrong password not to reveal the suspension, got %v", err)
+	}
+
+	if _, err := usecases.NewReactivateUserUseCase(f.uow).Execute(dtos.ReactivateUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: user.GetID()}); err != nil {
+		t.Fatalf("reactivate: %v", err)
+	}
+	sessionID = f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	if _, err := usecases.NewAuthenticateSessionUseCase(f.uow).Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID()}); err != nil {
+		t.Fatalf("expected the new session to be accepted, got %v", err)
+	}
+
+	if got := f.auditActions(); !containsAll(got, models.AuditActionUserSuspended, models.AuditActionUserReactivated) {
+		t.Fatalf("expected the moderation to be audited, got %v", got)
+	}
+}
+
+func TestSuspensionRules(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	user := f.addUser(t, "user@example.com", "secret123")
+	suspend := usecases.NewSuspendUserUseCase(f.uow)
+
+	var forbidden *exceptions.ForbiddenException
+	if _, err := suspend.Execute(dtos.SuspendUserDto{Ctx: f.ctx, AdminID: user.GetID(), UserID: admin.GetID(), Reason: "Coup"}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected non-admins to be forbidden, got %v", err)
+	}
+	var validation *exceptions.ValidationException
+	if _, err := suspend.Execute(dtos.SuspendUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: admin.GetID(), Reason: "Oops"}); !errors.As(err, &validation) {
+		t.Fatalf("expected admins not to suspend themselves, got %v", err)
+	}
+
+	if _, err := suspend.Execute(dtos.SuspendUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: user.GetID(), Reason: "Spam"}); err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+	var conflict *exceptions.ConflictException
+	if _, err := suspend.Execute(dtos.SuspendUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: user.GetID(), Reason: "Spam"}); !errors.As(err, &conflict) {
+		t.Fatalf("expected suspending twice to conflict, got %v", err)
+	}
+	if _, err := usecases.NewReactivateUserUseCase(f.uow).Execute(dtos.ReactivateUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: admin.GetID()}); !errors.As(err, &conflict) {
+		t.Fatalf("expected reactivating an active account to conflict, got %v", err)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	f.addUser(t, "bruno@example.com", "secret123")
+	if _, err := usecases.NewSuspendUserUseCase(f.uow).Execute(dtos.SuspendUserDto{Ctx: f.ctx, AdminID: admin.GetID(), UserID: ana.GetID(), Reason: "Spam"}); err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+
+	search := usecases.NewSearchUsersUseCase(f.users)
+	tests := []struct {
+		name  string
+		query dtos.UserSearchDto
+		want  []string
+	}{
+		{name: "everyone, newest first", query: dtos.UserSearchDto{}, want: []string{"bruno@example.com", "ana@example.com", "admin@example.com"}},
+		{name: "by term", query: dtos.UserSearchDto{Term: "ANA"}, want: []string{"ana@example.com"}},
+		{name: "by type", query: dtos.UserSearchDto{UserType: models.UserTypeAdmin}, want: []string{"admin@example.com"}},
+		{name: "suspended", query: dtos.UserSearchDto{Status: repositories.UserStatusSuspended}, want: []string{"ana@example.com"}},
+		{name: "active", query: dtos.UserSearchDto{Status: repositories.UserStatusActive, Limit: 1}, want: []string{"bruno@example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Ctx = f.ctx
+			tt.query.AdminID = admin.GetID()
+			users, err := search.Execute(tt.query)
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			var got []string
+			for _, user := range users {
+				got = append(got, user.Email)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+
+	var forbidden *exceptions.ForbiddenException
+	if _, err := search.Execute(dtos.UserSearchDto{Ctx: f.ctx, AdminID: ana.GetID()}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected non-admins to be forbidden, got %v", err)
+	}
+}
+
+func TestUnpublishedEventsAreHidden(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	stranger := f.addUser(t, "stranger@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 0, attendee.GetID())
+
+	moderation := dtos.ModerateEventDto{Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), Reason: "Scam"}
+	if _, err := usecases.NewSetEventPublishedUseCase(f.uow, false).Execute(moderation); err != nil {
+		t.Fatalf("unpublish: %v", err)
+	}
+
+	var notFound *exceptions.NotFoundException
+	if _, err := usecases.NewGetEventsUseCase(f.events).Execute(usecases.GetEventsProps{Ctx: f.ctx}); !errors.As(err, &notFound) {
+		t.Fatalf("expected the event to leave the listing, got %v", err)
+	}
+	getEvent := usecases.NewGetEventByIdUseCase(f.events, f.users)
+	if _, err := getEvent.Execute(usecases.GetEventByIdUseCaseProps{Ctx: f.ctx, EventID: event.ID(), UserID: stranger.GetID()}); !errors.As(err, &notFound) {
+		t.Fatalf("expected the event to be hidden from strangers, got %v", err)
+	}
+	for _, userID := range []string{organizer.GetID(), attendee.GetID()} {
+		if got, err := getEvent.Execute(usecases.GetEventByIdUseCaseProps{Ctx: f.ctx, EventID: event.ID(), UserID: userID}); err != nil || got.Published {
+			t.Fatalf("expected %s to see the unpublished event, got %+v (%v)", userID, got, err)
+		}
+	}
+	var conflict *exceptions.ConflictException
+	if _, err := usecases.NewRegisterToEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(usecases.RegisterToEventUseCaseProps{Ctx: f.ctx, UserId: stranger.GetID(), EventId: event.ID()}); !errors.As(err, &conflict) {
+		t.Fatalf("expected registration to be closed, got %v", err)
+	}
+
+	if _, err := usecases.NewSetEventPublishedUseCase(f.uow, true).Execute(moderation); err != nil {
+		t.Fatalf("republish: %v", err)
+	}
+	if events, err := usecases.NewGetEventsUseCase(f.events).Execute(usecases.GetEventsProps{Ctx: f.ctx}); err != nil || len(events) != 1 {
+		t.Fatalf("expected the event to be listed again, got %+v (%v)", events, err)
+	}
+	if got := f.auditActions(); !containsAll(got, models.AuditActionEventUnpublished, models.AuditActionEventRepublished) {
+		t.Fatalf("expected the moderation to be audited, got %v", got)
+	}
+}
+
+func TestUpdatingKeepsEventsUnpublished(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 0)
+
+	if _, err := usecases.NewSetEventPublishedUseCase(f.uow, false).Execute(dtos.ModerateEventDto{Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), Reason: "Scam"}); err != nil {
+		t.Fatalf("unpublish: %v", err)
+	}
+	if _, err := usecases.NewUpdateEventUseCase(f.uow).Execute(dtos.UpdateEventProps{
+		Ctx:         f.ctx,
+		EventID:     event.ID(),
+		Name:        "Renamed",
+		Location:    "Online",
+		Date:        "2030-01-02T15:04",
+		OrganizerID: organizer.GetID(),
+		Category:    "tech",
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	stored, err := f.events.FindByID(f.ctx, event.ID())
+	if err != nil {
+		t.Fatalf("finding event: %v", err)
+	}
+	if stored.Name() != "Renamed" || stored.IsPublished() {
+		t.Fatalf("expected the update to keep the event unpublished, got %q published=%v", stored.Name(), stored.IsPublished())
+	}
+}
+
+func TestModerateEvents(t *testing.T) {
+	f := newFixture()
+	admin := f.addAdmin(t, "admin@example.com")
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 0, attendee.GetID())
+
+	if _, err := usecases.NewRemoveAttendeeUseCase(f.uow).Execute(dtos.RemoveAttendeeDto{
+		Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), UserID: attendee.GetID(), Reason: "Harassment",
+	}); err != nil {
+		t.Fatalf("remove attendee: %v", err)
+	}
+	if attendees := f.attendees(t, event.ID()); len(attendees) != 0 {
+		t.Fatalf("expected the attendee to be removed, got %v", attendees)
+	}
+
+	reassign := usecases.NewReassignEventOrganizerUseCase(f.uow)
+	var validation *exceptions.ValidationException
+	if _, err := reassign.Execute(dtos.ReassignOrganizerDto{Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), OrganizerID: "missing"}); !errors.As(err, &validation) {
+		t.Fatalf("expected unknown organizers to be refused, got %v", err)
+	}
+	if _, err := reassign.Execute(dtos.ReassignOrganizerDto{Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), OrganizerID: attendee.GetID()}); err != nil {
+		t.Fatalf("reassign: %v", err)
+	}
+	if stored, _ := f.events.FindByID(f.ctx, event.ID()); stored.OrganizerID() != attendee.GetID() {
+		t.Fatalf("expected %s to organize the event, got %s", attendee.GetID(), stored.OrganizerID())
+	}
+
+	if _, err := usecases.NewForceCancelEventUseCase(f.uow).Execute(dtos.ModerateEventDto{Ctx: f.ctx, AdminID: admin.GetID(), EventID: event.ID(), IP: "10.0.0.9"}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	var notFound *exceptions.NotFoundException
+	if _, err := f.events.FindByID(f.ctx, event.ID()); !errors.As(err, &notFound) {
+		t.Fatalf("expected the event to be deleted, got %v", err)
+	}
+
+	entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), TargetID: event.ID()})
+	wantActions := []string{models.AuditActionEventCancelled, models.AuditActionEventReassigned, models.AuditActionAttendeeRemoved}
+	if len(entries) != len(wantActions) {
+		t.Fatalf("expected %d entries, got %+v", len(wantActions), entries)
+	}
+	for i, action := range wantActions {
+		if entries[i].Action != action || *entries[i].ActorID != admin.GetID() {
+			t.Fatalf("entry %d: expected %s by the admin, got %+v", i, action, entries[i])
+		}
+	}
+	if removed := entries[2]; removed.Details["user_id"] != attendee.GetID() || removed.Details["reason"] != "Harassment" {
+		t.Fatalf("expected the removed attendee and reason, got %+v", removed.Details)
+	}
+	if organizerChange := entries[1].Changes["organizer_id"]; *organizerChange.After != attendee.GetID() {
+		t.Fatalf("expected the organizer change, got %+v", entries[1].Changes)
+	}
+}
+
+func containsAll(got []string, want ...string) bool {
+	seen := map[string]bool{}
+	for _, action := range got {
+		seen[action] = true
+	}
+	for _, action := range want {
+		if !seen[action] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+func (f *fixture) createAPIKey(t *testing.T, userID string, expiresAt *time.Time, scopes ...string) *dtos.CreatedAPIKeyDto {
+	t.Helper()
+
+	key, err := usecases.NewCreateAPIKeyUseCase(f.uow).Execute(dtos.CreateAPIKeyDto{
+		Ctx:       f.ctx,
+		UserID:    userID,
+		Name:      "CI",
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("creating API key: %v", err)
+	}
+	return key
+}
+
+func (f *fixture) authenticateAPIKey(key, scope string) (string, error) {
+	return usecases.NewAuthenticateAPIKeyUseCase(f.uow).Execute(usecases.AuthenticateAPIKeyProps{Ctx: f.ctx, Key: key, Scope: scope})
+}
+
+func TestCreateAPIKeyStoresOnlyTheHash(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	created := f.createAPIKey(t, user.GetID(), nil, models.APIKeyScopeEventsRead)
+	if !strings.HasPrefix(created.Key, "ehk_") || !strings.HasPrefix(created.Key, created.Prefix) {
+		t.Fatalf("expected a prefixed key, got %+v", created)
+	}
+
+	stored, err := f.stores.APIKeys.FindByID(f.ctx, created.ID)
+	if err != nil {
+		t.Fatalf("key was not stored: %v", err)
+	}
+	if stored.GetKeyHash() != utils.HashToken(created.Key) {
+		t.Fatalf("expected the hash of the key to be stored")
+	}
+
+	listed, err := usecases.NewListAPIKeysUseCase(f.stores.APIKeys).Execute(usecases.ListAPIKeysProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != created.ID || listed[0].Prefix != created.Prefix {
+		t.Fatalf("unexpected listing %+v", listed)
+	}
+	if got := f.auditActions(); len(got) != 1 || got[0] != models.AuditActionAPIKeyCreated {
+		t.Fatalf("expected the creation to be audited, got %v", got)
+	}
+}
+
+func TestCreateAPIKeyRejectsPastExpiry(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	past := time.Now().Add(-time.Minute)
+	_, err := usecases.NewCreateAPIKeyUseCase(f.uow).Execute(dtos.CreateAPIKeyDto{
+		Ctx:       f.ctx,
+		UserID:    user.GetID(),
+		Name:      "CI",
+		Scopes:    []string{models.APIKeyScopeEventsRead},
+		ExpiresAt: &past,
+	})
+	var validation *exceptions.ValidationException
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestAuthenticateAPIKeyChecksScopes(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	created := f.createAPIKey(t, user.GetID(), nil, models.APIKeyScopeEventsRead, models.APIKeyScopeRegistrationsWrite)
+
+	for _, scope := range []string{models.APIKeyScopeEventsRead, models.APIKeyScopeRegistrationsWrite} {
+		userID, err := f.authenticateAPIKey(created.Key, scope)
+		if err != nil || userID != user.GetID() {
+			t.Fatalf("scope %s: expected %s, got %q (%v)", scope, user.GetID(), userID, err)
+		}
+	}
+
+	var forbidden *exceptions.ForbiddenException
+	if _, err := f.authenticateAPIKey(created.Key, models.APIKeyScopeEventsWrite); !errors.As(err, &forbidden) {
+		t.Fatalf("expected a missing scope to be Forbidden, got %v", err)
+	}
+	if _, err := f.authenticateAPIKey(created.Key, ""); !errors.As(err, &forbidden) {
+		t.Fatalf("expected routes without a scope to be Forbidden, got %v", err)
+	}
+
+	stored, _ := f.stores.APIKeys.FindByID(f.ctx, created.ID)
+	if stored.GetLastUsedAt() == nil {
+		t.Fatalf("expected the last use to be recorded")
+	}
+}
+
+func TestAuthenticateAPIKeyRejectsInactiveKeys(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+
+	soon := time.Now().Add(50 * time.Millisecond)
+	expiring := f.createAPIKey(t, user.GetID(), &soon, models.APIKeyScopeEventsRead)
+	revoked := f.createAPIKey(t, user.GetID(), nil, models.APIKeyScopeEventsRead)
+
+	revoke := usecases.NewRevokeAPIKeyUseCase(f.uow)
+	var notFound *exceptions.NotFoundException
+	if _, err := revoke.Execute(usecases.RevokeAPIKeyProps{Ctx: f.ctx, UserID: other.GetID(), KeyID: revoked.ID}); !errors.As(err, &notFound) {
+		t.Fatalf("expected keys of other users to be NotFound, got %v", err)
+	}
+	if _, err := revoke.Execute(usecases.RevokeAPIKeyProps{Ctx: f.ctx, UserID: user.GetID(), KeyID: revoked.ID}); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+
+	var unauthorized *exceptions.UnauthorizedException
+	for name, key := range map[string]string{"expired": expiring.Key, "revoked": revoked.Key, "unknown": "ehk_unknown"} {
+		if _, err := f.authenticateAPIKey(key, models.APIKeyScopeEventsRead); !errors.As(err, &unauthorized) {
+			t.Fatalf("%s: expected Unauthorized, got %v", name, err)
+		}
+	}
+}
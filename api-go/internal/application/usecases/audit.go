@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// auditUser appends an entry about the given user account. actorID is nil
+// for anonymous actors and userID may be empty when the account is unknown.
+func auditUser(ctx context.Context, log repositories.AuditLogRepository, action string, actorID *string, userID, ip string, details map[string]string) error {
+	targetType := models.AuditTargetUser
+	return appendAudit(ctx, log, models.AuditEntryProps{
+		ActorID:    actorID,
+		Action:     &action,
+		TargetType: &targetType,
+		TargetID:   &userID,
+		IP:         &ip,
+		Details:    details,
+	})
+}
+
+// auditEvent appends an entry about an event with the fields that differ
+// between before and after, as returned by eventAuditFields. before is nil
+// for created events and after for deleted ones.
+func auditEvent(ctx context.Context, log repositories.AuditLogRepository, action, actorID, eventID, ip string, before, after map[string]string) error {
+	targetType := models.AuditTargetEvent
+	return appendAudit(ctx, log, models.AuditEntryProps{
+		ActorID:    &actorID,
+		Action:     &action,
+		TargetType: &targetType,
+		TargetID:   &eventID,
+		IP:         &ip,
+		Changes:    diffFields(before, after),
+	})
+}
+
+// auditModeration appends an entry about an admin acting on a user or an
+// event, with the reason they gave in details and the fields that differ
+// between before and after.
+func auditModeration(ctx context.Context, log repositories.AuditLogRepository, action, adminID, targetType, targetID, ip, reason string, details, before, after map[string]string) error {
+	if reason != "" {
+		if details == nil {
+			details = map[string]string{}
+		}
+		details["reason"] = reason
+	}
+	return appendAudit(ctx, log, models.AuditEntryProps{
+		ActorID:    &adminID,
+		Action:     &action,
+		TargetType: &targetType,
+		TargetID:   &targetID,
+		IP:         &ip,
+		Details:    details,
+		Changes:    diffFields(before, after),
+	})
+}
+
+// appendAudit records the ID of the request in ctx along with the entry.
+func appendAudit(ctx context.Context, log repositories.AuditLogRepository, props models.AuditEntryProps) error {
+	requestID := utils.RequestIDFromContext(ctx)
+	props.RequestID = &requestID
+
+	entry, err := models.NewAuditEntry(props)
+	if err != nil {
+		return err
+	}
+	return log.Append(ctx, entry)
+}
+
+// eventAuditFields snapshots the audited fields of event. Take it before
+// changing the event to compare with the result.
+func eventAuditFields(event models.Event) map[string]string {
+	return map[string]string{
+		"name":            event.Name(),
+		"location":        event.Location(),
+		"date":            event.Date().UTC().Format(time.RFC3339),
+		"description":     event.Description(),
+		"category":        event.Category(),
+		"limit":           strconv.Itoa(event.Limit()),
+		"organizer_id":    event.OrganizerID(),
+		"attendees_count": strconv.Itoa(len(event.Attendees())),
+		"ticket_types":    strings.Join(event.TicketTypes(), ", "),
+		"questions":       strings.Join(event.Questions(), " | "),
+	}
+}
+
+// diffFields returns the fields whose values differ between before and
+// after, nil when none do.
+func diffFields(before, after map[string]string) map[string]models.AuditChange {
+	var changes map[string]models.AuditChange
+	record := func(field string, change models.AuditChange) {
+		if changes == nil {
+			changes = map[string]models.AuditChange{}
+		}
+		changes[field] = change
+	}
+
+	for field, old := range before {
+		value, ok := after[field]
+		switch {
+		case !ok:
+			record(field, models.AuditChange{Before: &old})
+		case value != old:
+			record(field, models.AuditChange{Before: &old, After: &value})
+		}
+	}
+	for field, value := range after {
+		if _, ok := before[field]; !ok {
+			record(field, models.AuditChange{After: &value})
+		}
+	}
+	return changes
+}
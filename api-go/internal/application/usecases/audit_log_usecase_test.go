@@ -0,0 +1,120 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+func (f *fixture) addAdmin(t *testing.T, email string) models.User {
+	t.Helper()
+
+	name, userType := "Admin", models.UserTypeAdmin
+	admin := models.NewUser(models.UserProps{Name: &name, Email: &email, UserType: &userType})
+	if err := f.users.Create(f.ctx, admin); err != nil {
+		t.Fatalf("creating admin: %v", err)
+	}
+	return admin
+}
+
+func (f *fixture) auditLog(t *testing.T, query dtos.AuditLogQueryDto) []dtos.AuditEntryDto {
+	t.Helper()
+
+	query.Ctx = f.ctx
+	entries, err := usecases.NewListAuditLogUseCase(f.users, f.stores.AuditLog).Execute(query)
+	if err != nil {
+		t.Fatalf("listing audit log: %v", err)
+	}
+	return entries
+}
+
+func TestEventChangesAreAudited(t *testing.T) {
+	f := newFixture()
+	f.ctx = utils.WithRequestID(f.ctx, "req-1")
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	admin := f.addAdmin(t, "admin@example.com")
+
+	created, err := usecases.NewCreateEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(dtos.CreateEventProps{
+		Ctx: f.ctx, Name: "Go Meetup", Location: "Curitiba", Date: "2030-05-10T19:00", Category: "tech", Limit: 30, OrganizerID: organizer.GetID(), IP: "10.0.0.1",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := usecases.NewUpdateEventUseCase(f.uow).Execute(dtos.UpdateEventProps{
+		Ctx: f.ctx, EventID: created.ID, Name: "Go Meetup", Location: "Curitiba", Date: "2030-05-10T19:00", Category: "tech", Limit: 10, OrganizerID: organizer.GetID(), IP: "10.0.0.1",
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := usecases.NewRegisterToEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(usecases.RegisterToEventUseCaseProps{Ctx: f.ctx, UserId: attendee.GetID(), EventId: created.ID}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if _, err := usecases.NewCancelEventSubscriptionUseCase(f.uow).Execute(usecases.CancelEventSubscriptionUseCaseProps{Ctx: f.ctx, UserId: attendee.GetID(), EventId: created.ID}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if _, err := usecases.NewDeleteEventUseCase(f.uow).Execute(usecases.DeleteEventProps{Ctx: f.ctx, EventID: created.ID, OrganizerID: organizer.GetID()}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), TargetType: models.AuditTargetEvent, TargetID: created.ID})
+	wantActions := []string{models.AuditActionEventDeleted, models.AuditActionEventUnregistered, models.AuditActionEventRegistered, models.AuditActionEventUpdated, models.AuditActionEventCreated}
+	if len(entries) != len(wantActions) {
+		t.Fatalf("expected %d entries, got %+v", len(wantActions), entries)
+	}
+	for i, action := range wantActions {
+		if entries[i].Action != action || entries[i].RequestID != "req-1" {
+			t.Fatalf("entry %d: expected %s in req-1, got %s in %q", i, action, entries[i].Action, entries[i].RequestID)
+		}
+	}
+
+	update := entries[3]
+	if limit := update.Changes["limit"]; len(update.Changes) != 1 || *limit.Before != "30" || *limit.After != "10" {
+		t.Fatalf("expected only the limit to change, got %+v", update.Changes)
+	}
+	if *update.ActorID != organizer.GetID() || update.IP != "10.0.0.1" {
+		t.Fatalf("expected the organizer and their IP, got %v from %q", *update.ActorID, update.IP)
+	}
+	if count := entries[2].Changes["attendees_count"]; *entries[2].ActorID != attendee.GetID() || *count.Before != "0" || *count.After != "1" {
+		t.Fatalf("expected the registration to count the attendee, got %+v", entries[2])
+	}
+	if name := entries[4].Changes["name"]; name.Before != nil || *name.After != "Go Meetup" {
+		t.Fatalf("expected the creation to record the new values, got %+v", entries[4].Changes)
+	}
+	if limit := entries[0].Changes["limit"]; *limit.Before != "10" || limit.After != nil {
+		t.Fatalf("expected the deletion to record the last values, got %+v", entries[0].Changes)
+	}
+}
+
+func TestListAuditLogFilters(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	admin := f.addAdmin(t, "admin@example.com")
+	f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	f.loginSession(t, other.GetEmail(), "secret123", "10.0.0.2")
+
+	entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), ActorID: user.GetID()})
+	if len(entries) != 1 || entries[0].Action != models.AuditActionLoginSucceeded || entries[0].TargetID != user.GetID() {
+		t.Fatalf("expected the login of the user, got %+v", entries)
+	}
+	if entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), Limit: 1}); len(entries) != 1 || entries[0].TargetID != other.GetID() {
+		t.Fatalf("expected the newest entry only, got %+v", entries)
+	}
+	if entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), From: time.Now().Add(time.Hour)}); len(entries) != 0 {
+		t.Fatalf("expected no entries in the future, got %+v", entries)
+	}
+	if entries := f.auditLog(t, dtos.AuditLogQueryDto{AdminID: admin.GetID(), To: time.Now().Add(time.Hour)}); len(entries) != 2 {
+		t.Fatalf("expected both logins before the end of the range, got %+v", entries)
+	}
+
+	var forbidden *exceptions.ForbiddenException
+	if _, err := usecases.NewListAuditLogUseCase(f.users, f.stores.AuditLog).Execute(dtos.AuditLogQueryDto{Ctx: f.ctx, AdminID: user.GetID()}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected users to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// apiKeyUsageResolution limits how often the last use of a key is written,
+// so busy scripts do not cause a write per request.
+const apiKeyUsageResolution = time.Minute
+
+type authenticateAPIKeyUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewAuthenticateAPIKeyUseCase(uow repositories.UnitOfWork) *authenticateAPIKeyUseCase {
+	return &authenticateAPIKeyUseCase{uow: uow}
+}
+
+type AuthenticateAPIKeyProps struct {
+	Ctx context.Context `json:"-"`
+	Key string
+	// Scope is the one the route requires, empty for routes API keys cannot
+	// reach.
+	Scope string
+}
+
+// Execute returns the ID of the user the key acts for.
+func (uc *authenticateAPIKeyUseCase) Execute(props AuthenticateAPIKeyProps) (string, error) {
+	var userID string
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		now := time.Now()
+		key, err := repos.APIKeys().FindByHash(ctx, utils.HashToken(props.Key))
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) || (err == nil && !key.IsActive(now)) {
+			return exceptions.NewUnauthorizedException("Invalid or expired API key")
+		}
+		if err != nil {
+			return err
+		}
+
+		if props.Scope == "" {
+			return exceptions.NewForbiddenException("API keys cannot access this route")
+		}
+		if !key.HasScope(props.Scope) {
+			return exceptions.NewForbiddenException(fmt.Sprintf("API key lacks the %s scope", props.Scope))
+		}
+
+		user, err := repos.Users().FindById(ctx, key.GetUserID())
+		if err != nil {
+			return err
+		}
+		if user.IsSuspended() {
+			return errAccountSuspended()
+		}
+		userID = user.GetID()
+
+		if last := key.GetLastUsedAt(); last == nil || now.Sub(*last) >= apiKeyUsageResolution {
+			key.MarkUsed(now)
+			return repos.APIKeys().Save(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return userID, nil
+}
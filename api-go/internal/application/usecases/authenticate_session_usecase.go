@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+// sessionActivityResolution limits how often the last activity of a session
+// is written, as it is checked on every request.
+const sessionActivityResolution = time.Minute
+
+type authenticateSessionUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewAuthenticateSessionUseCase(uow repositories.UnitOfWork) *authenticateSessionUseCase {
+	return &authenticateSessionUseCase{uow: uow}
+}
+
+type AuthenticateSessionProps struct {
+	Ctx context.Context `json:"-"`
+	// SessionID and UserID are the sid and sub claims of the access token.
+	SessionID string
+	UserID    string
+	IP        string
+}
+
+// Execute rejects tokens whose session was revoked, belongs to someone else
+// or started before the last password change, or whose user is suspended,
+// and records the activity of the others.
+func (uc *authenticateSessionUseCase) Execute(props AuthenticateSessionProps) (struct{}, error) {
+	if props.SessionID == "" {
+		return struct{}{}, exceptions.NewUnauthorizedException("Session expired, please log in again")
+	}
+
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		now := time.Now()
+		session, err := repos.Sessions().FindByID(ctx, props.SessionID)
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) || (err == nil && (session.GetUserID() != props.UserID || !session.IsActive(now))) {
+			return exceptions.NewUnauthorizedException("Session expired, please log in again")
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkNotSuspended(ctx, repos.Users(), props.UserID); err != nil {
+			return err
+		}
+		// Logins made before the last password change are no longer valid.
+		// The session is compared rather than the iat claim, which only has
+		// whole seconds and cannot tell apart the tokens issued in the
+		// second of the change.
+		auth, err := repos.Auths().FindByUserID(ctx, props.UserID)
+		if errors.As(err, &notFound) || (err == nil && auth.GetPasswordChangedAt() != nil && session.GetCreatedAt().Before(*auth.GetPasswordChangedAt())) {
+			return exceptions.NewUnauthorizedException("Session expired, please log in again")
+		}
+		if err != nil {
+			return err
+		}
+
+		if now.Sub(session.GetLastSeenAt()) >= sessionActivityResolution || (props.IP != "" && props.IP != session.GetIP()) {
+			session.MarkSeen(now, props.IP)
+			return repos.Sessions().Save(ctx, session)
+		}
+		return nil
+	})
+	return struct{}{}, err
+}
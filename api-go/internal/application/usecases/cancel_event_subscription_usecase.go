@@ -1,44 +1,57 @@
 package usecases
 
 import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
 
 type CancelEventSubscriptionUseCase struct {
-	userRepo  repositories.UserRepository
-	eventRepo repositories.IEventRepository
+	uow repositories.UnitOfWork
 }
 
-func NewCancelEventSubscriptionUseCase(userRepo repositories.UserRepository, eventRepo repositories.IEventRepository) *CancelEventSubscriptionUseCase {
+func NewCancelEventSubscriptionUseCase(uow repositories.UnitOfWork) *CancelEventSubscriptionUseCase {
 	return &CancelEventSubscriptionUseCase{
-		userRepo:  userRepo,
-		eventRepo: eventRepo,
+		uow: uow,
 	}
 }
 
 type CancelEventSubscriptionUseCaseProps struct {
+	Ctx     context.Context `json:"-"`
 	UserId  string
 	EventId string
+	IP      string
 }
 
 func (uc *CancelEventSubscriptionUseCase) Execute(input CancelEventSubscriptionUseCaseProps) ([]string, error) {
-	event, err := uc.eventRepo.FindByID(input.EventId)
-	if err != nil {
-		return nil, err
-	}
-	
-	user, err := uc.userRepo.FindById(input.UserId)
-	if err != nil {
-		return nil, err
-	}
+	var event models.Event
 
-	if err := event.CancelSubscription(user.GetID()); err != nil {
-		return nil, err
-	}
+	err := uc.uow.Do(input.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		event, err = repos.Events().FindByIDForUpdate(ctx, input.EventId)
+		if err != nil {
+			return err
+		}
+		
+		user, err := repos.Users().FindById(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
 
-	if err := uc.eventRepo.Save(event); err != nil {
+		before := eventAuditFields(event)
+		if err := event.CancelSubscription(user.GetID()); err != nil {
+			return err
+		}
+
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditEvent(ctx, repos.AuditLog(), models.AuditActionEventUnregistered, user.GetID(), event.ID(), input.IP, before, eventAuditFields(event))
+	})
+	if err != nil {
 		return nil, err
 	}
 	
 	return event.Attendees(), nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,51 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+func TestCancelEventSubscriptionUseCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		subscribed bool
+		wantErr    string
+	}{
+		{name: "cancels subscription", subscribed: true},
+		{name: "not subscribed", subscribed: false, wantErr: "Attendee not subscribed to the event"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			user := f.addUser(t, "user@example.com", "secret123")
+
+			var attendees []string
+			if tt.subscribed {
+				attendees = append(attendees, user.GetID())
+			}
+			event := f.addEvent(t, organizer.GetID(), 10, attendees...)
+
+			remaining, err := usecases.NewCancelEventSubscriptionUseCase(f.uow).Execute(usecases.CancelEventSubscriptionUseCaseProps{
+				Ctx:     f.ctx,
+				UserId:  user.GetID(),
+				EventId: event.ID(),
+			})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(remaining) != 0 || len(f.attendees(t, event.ID())) != 0 {
+				t.Fatalf("expected no attendees left, got %v", remaining)
+			}
+		})
+	}
+}
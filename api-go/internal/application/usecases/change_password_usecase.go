@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type changePasswordUseCase struct {
+	uow        repositories.UnitOfWork
+	hasher     services.IPasswordHasher
+	policy     PasswordPolicy
+	jwtService services.IJWTService
+}
+
+func NewChangePasswordUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, policy PasswordPolicy, jwtService services.IJWTService) *changePasswordUseCase {
+	return &changePasswordUseCase{uow: uow, hasher: hasher, policy: policy, jwtService: jwtService}
+}
+
+// Execute replaces the password of a user who knows the current one. Every
+// session is logged out, and the caller gets the token of a new one.
+func (uc *changePasswordUseCase) Execute(props dtos.ChangePasswordDto) (*dtos.LoginResultDto, error) {
+	if err := uc.policy.Check(props.NewPassword); err != nil {
+		return nil, err
+	}
+	hash, algorithm, err := uc.hasher.Hash(props.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *dtos.LoginResultDto
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		auth, err := verifyPassword(ctx, uc.hasher, repos.Auths(), user.GetID(), props.CurrentPassword)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		auth.SetPassword(hash, algorithm, now)
+		if err := repos.Auths().Save(ctx, auth); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().RevokeForUser(ctx, user.GetID(), models.TokenPurposePasswordReset, now); err != nil {
+			return err
+		}
+		if err := repos.Sessions().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+
+		result, err = startSession(ctx, repos.Sessions(), uc.jwtService, user.GetID(), props.IP, props.UserAgent)
+		if err != nil {
+			return err
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionPasswordChanged, &props.UserID, props.UserID, props.IP, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
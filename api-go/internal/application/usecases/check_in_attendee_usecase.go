@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type checkInAttendeeUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewCheckInAttendeeUseCase(uow repositories.UnitOfWork) *checkInAttendeeUseCase {
+	return &checkInAttendeeUseCase{uow: uow}
+}
+
+// Execute records that the attendee arrived at the event. Each attendee is
+// checked in once.
+func (uc *checkInAttendeeUseCase) Execute(props dtos.CheckInAttendeeDto) (*dtos.AttendeeCheckInDto, error) {
+	now := time.Now()
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		event, err := findOrganizedEventForUpdate(ctx, repos.Events(), props.EventID, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+		if err := event.CheckIn(props.UserID, now); err != nil {
+			return err
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditAttendees(ctx, repos.AuditLog(), models.AuditActionAttendeeCheckedIn, props.OrganizerID, event.ID(), props.IP,
+			map[string]string{"user_id": props.UserID}, nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.AttendeeCheckInDto{UserID: props.UserID, CheckedInAt: now}, nil
+}
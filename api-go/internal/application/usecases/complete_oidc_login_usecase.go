@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type completeOIDCLoginUseCase struct {
+	providers       map[string]services.IOIDCProvider
+	uow             repositories.UnitOfWork
+	jwtService      services.IJWTService
+	defaultUserType string
+}
+
+// NewCompleteOIDCLoginUseCase logs users in with the providers configured by
+// name, creating a defaultUserType account on the first login of an unknown
+// email.
+func NewCompleteOIDCLoginUseCase(providers map[string]services.IOIDCProvider, uow repositories.UnitOfWork, jwtService services.IJWTService, defaultUserType string) *completeOIDCLoginUseCase {
+	return &completeOIDCLoginUseCase{providers: providers, uow: uow, jwtService: jwtService, defaultUserType: defaultUserType}
+}
+
+// Execute checks the callback against the values of the login it answers,
+// redeems the code and resolves the local user: by a previous link to the
+// provider account, else by the verified email, else by creating one.
+func (uc *completeOIDCLoginUseCase) Execute(props dtos.OIDCCallbackDto) (*dtos.LoginResultDto, error) {
+	provider, ok := uc.providers[props.Provider]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Unknown identity provider")
+	}
+	if props.Error != "" {
+		return nil, exceptions.NewUnauthorizedException("Login was not completed at the identity provider")
+	}
+	if props.Code == "" || props.ExpectedState == "" || props.Nonce == "" || !equalSecrets(props.State, props.ExpectedState) {
+		return nil, exceptions.NewUnauthorizedException("Invalid or expired login attempt")
+	}
+
+	claims, err := provider.Exchange(props.Ctx, props.Code, props.CodeVerifier)
+	if err != nil {
+		log.Printf("CompleteOIDCLoginUseCase - %s rejected the login: %v", props.Provider, err)
+		return nil, exceptions.NewUnauthorizedException("The identity provider did not confirm the login")
+	}
+	if !equalSecrets(claims.Nonce, props.Nonce) {
+		return nil, exceptions.NewUnauthorizedException("Invalid or expired login attempt")
+	}
+
+	var result *dtos.LoginResultDto
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := uc.resolveUser(ctx, repos, props.Provider, claims, props.IP)
+		if err != nil {
+			return err
+		}
+		if user.IsSuspended() {
+			return errAccountSuspended()
+		}
+
+		result, err = issueLoginResult(ctx, repos.TwoFactors(), repos.Sessions(), uc.jwtService, user.GetID(), props.IP, props.UserAgent)
+		if err != nil || result.TwoFactorRequired {
+			return err
+		}
+		userID := user.GetID()
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionLoginSucceeded, &userID, userID, props.IP,
+			map[string]string{"method": "oidc", "provider": props.Provider})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (uc *completeOIDCLoginUseCase) resolveUser(ctx context.Context, repos repositories.Repositories, provider string, claims *services.OIDCClaims, ip string) (models.User, error) {
+	identity, err := repos.ExternalIdentities().FindByProviderSubject(ctx, provider, claims.Subject)
+	if err == nil {
+		return repos.Users().FindById(ctx, identity.GetUserID())
+	}
+	var notFound *exceptions.NotFoundException
+	if !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	// Linking trusts the provider about who owns the address, so it must
+	// have checked it.
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, exceptions.NewForbiddenException("The identity provider did not verify this email address")
+	}
+
+	now := time.Now()
+	user, err := repos.Users().FindByEmail(ctx, claims.Email)
+	switch {
+	case errors.As(err, &notFound):
+		if user, err = uc.provisionUser(ctx, repos, claims, now); err != nil {
+			return nil, err
+		}
+		userID := user.GetID()
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionUserProvisioned, &userID, userID, ip, map[string]string{"provider": provider}); err != nil {
+			return nil, err
+		}
+		if err := acceptEventInvites(ctx, repos, user, ip); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !user.IsEmailVerified():
+		// Whoever registered the address never proved owning it and may be
+		// someone else, so their password and sessions stop working.
+		auth, err := repos.Auths().FindByUserID(ctx, user.GetID())
+		if err != nil {
+			return nil, err
+		}
+		auth.RemovePassword(now)
+		if err := repos.Auths().Save(ctx, auth); err != nil {
+			return nil, err
+		}
+		user.VerifyEmail(now)
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return nil, err
+		}
+		if err := acceptEventInvites(ctx, repos, user, ip); err != nil {
+			return nil, err
+		}
+	}
+
+	userID, email := user.GetID(), claims.Email
+	identity, err = models.NewExternalIdentity(models.ExternalIdentityProps{
+		Provider:  &provider,
+		Subject:   &claims.Subject,
+		UserID:    &userID,
+		Email:     &email,
+		CreatedAt: &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := repos.ExternalIdentities().Create(ctx, identity); err != nil {
+		return nil, err
+	}
+	if err := auditUser(ctx, repos.AuditLog(), models.AuditActionIdentityLinked, &userID, userID, ip, map[string]string{"provider": provider}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// provisionUser creates an account without a password; the user can set one
+// through the password reset flow.
+func (uc *completeOIDCLoginUseCase) provisionUser(ctx context.Context, repos repositories.Repositories, claims *services.OIDCClaims, now time.Time) (models.User, error) {
+	name := claims.Name
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+	user := models.NewUser(models.UserProps{
+		Name:            &name,
+		Email:           &claims.Email,
+		UserType:        &uc.defaultUserType,
+		EmailVerifiedAt: &now,
+	})
+	if err := repos.Users().Create(ctx, user); err != nil {
+		return nil, err
+	}
+	userID := user.GetID()
+	auth, err := models.NewAuth(models.AuthProps{UserID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := repos.Auths().Create(ctx, auth); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func equalSecrets(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
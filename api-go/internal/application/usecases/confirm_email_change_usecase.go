@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type confirmEmailChangeUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewConfirmEmailChangeUseCase(uow repositories.UnitOfWork) *confirmEmailChangeUseCase {
+	return &confirmEmailChangeUseCase{uow: uow}
+}
+
+// Execute switches the user to the address the link was mailed to, unless
+// another account took it meanwhile.
+func (uc *confirmEmailChangeUseCase) Execute(props dtos.ConfirmEmailChangeDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		token, err := repos.UserTokens().FindByHashForUpdate(ctx, models.TokenPurposeEmailChange, utils.HashToken(props.Token))
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			return exceptions.NewValidationException("Invalid or expired token")
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := token.Use(now); err != nil {
+			return err
+		}
+
+		user, err := repos.Users().FindById(ctx, token.GetUserID())
+		if err != nil {
+			return err
+		}
+		exists, err := repos.Users().ExistsByEmail(ctx, user.GetPendingEmail())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exceptions.NewConflictException("Email already registered")
+		}
+		if err := user.ConfirmEmailChange(now); err != nil {
+			return err
+		}
+
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().Save(ctx, token); err != nil {
+			return err
+		}
+		userID := user.GetID()
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionEmailChanged, &userID, userID, props.IP, nil); err != nil {
+			return err
+		}
+		return acceptEventInvites(ctx, repos, user, props.IP)
+	})
+
+	return struct{}{}, err
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type confirmTwoFactorUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewConfirmTwoFactorUseCase(uow repositories.UnitOfWork) *confirmTwoFactorUseCase {
+	return &confirmTwoFactorUseCase{uow: uow}
+}
+
+// Execute enables two-factor authentication once the user proves their app
+// generates valid codes, and returns the initial recovery codes.
+func (uc *confirmTwoFactorUseCase) Execute(props dtos.ConfirmTwoFactorDto) (*dtos.RecoveryCodesDto, error) {
+	var codes []string
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		twoFactor, err := repos.TwoFactors().FindByUserIDForUpdate(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		if twoFactor.IsEnabled() {
+			return exceptions.NewConflictException("Two-factor authentication is already enabled")
+		}
+
+		ok, err := checkSecondFactor(ctx, repos, twoFactor, props.Code, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return exceptions.NewValidationException("Invalid two-factor code")
+		}
+
+		twoFactor.Confirm(time.Now())
+		if err := repos.TwoFactors().Save(ctx, twoFactor); err != nil {
+			return err
+		}
+
+		codes, err = replaceRecoveryCodes(ctx, repos, props.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.RecoveryCodesDto{Codes: codes}, nil
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// apiKeyPrefix marks API keys so they are recognizable, e.g. by secret
+// scanners, and apiKeyPrefixLength characters of a key are kept in clear.
+const (
+	apiKeyPrefix       = "ehk_"
+	apiKeyPrefixLength = 12
+)
+
+type createAPIKeyUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewCreateAPIKeyUseCase(uow repositories.UnitOfWork) *createAPIKeyUseCase {
+	return &createAPIKeyUseCase{uow: uow}
+}
+
+// Execute mints a key for the user. The key is only returned here; later
+// listings show its prefix.
+func (uc *createAPIKeyUseCase) Execute(props dtos.CreateAPIKeyDto) (*dtos.CreatedAPIKeyDto, error) {
+	now := time.Now()
+	if props.ExpiresAt != nil && !props.ExpiresAt.After(now) {
+		return nil, exceptions.NewValidationException("API key expiration must be in the future")
+	}
+
+	token, err := utils.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	rawKey := apiKeyPrefix + token
+	prefix, hash := rawKey[:apiKeyPrefixLength], utils.HashToken(rawKey)
+
+	key, err := models.NewAPIKey(models.APIKeyProps{
+		UserID:    &props.UserID,
+		Name:      &props.Name,
+		Prefix:    &prefix,
+		KeyHash:   &hash,
+		Scopes:    props.Scopes,
+		ExpiresAt: props.ExpiresAt,
+		CreatedAt: &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := repos.APIKeys().Create(ctx, key); err != nil {
+			return err
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionAPIKeyCreated, &props.UserID, props.UserID, props.IP,
+			map[string]string{"api_key_id": key.GetID(), "name": key.GetName()})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.CreatedAPIKeyDto{APIKeyDto: apiKeyToDto(key), Key: rawKey}, nil
+}
+
+func apiKeyToDto(key models.APIKey) dtos.APIKeyDto {
+	return dtos.APIKeyDto{
+		ID:         key.GetID(),
+		Name:       key.GetName(),
+		Prefix:     key.GetPrefix(),
+		Scopes:     key.GetScopes(),
+		ExpiresAt:  key.GetExpiresAt(),
+		LastUsedAt: key.GetLastUsedAt(),
+		RevokedAt:  key.GetRevokedAt(),
+		CreatedAt:  key.GetCreatedAt(),
+	}
+}
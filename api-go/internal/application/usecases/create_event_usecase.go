@@ -1,21 +1,25 @@
 package usecases
 
 import (
+	"context"
 	"log"
-	"time"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
 )
 
 type createEventUseCase struct{
-	eventRepository repositories.IEventRepository
+	uow    repositories.UnitOfWork
+	policy EmailVerificationPolicy
 }
 
-func NewCreateEventUseCase(eventRepository repositories.IEventRepository) *createEventUseCase {
+func NewCreateEventUseCase(uow repositories.UnitOfWork, policy EmailVerificationPolicy) *createEventUseCase {
 	return &createEventUseCase{
-		eventRepository: eventRepository,
+		uow:    uow,
+		policy: policy,
 	}
 }
 
@@ -27,10 +31,10 @@ func (uc *createEventUseCase) Execute(props dtos.CreateEventProps) (*dtos.EventD
 	log.Printf("CreateEventUseCase - Creating event with OrganizerID: %s", props.OrganizerID)
 	log.Printf("CreateEventUseCase - Event props: %+v", props)
 
-	parsedDate, err := time.Parse("2006-01-02T15:04", props.Date)
+	parsedDate, err := utils.ParseEventDate(props.Date, props.Timezone)
 	if err != nil {
 		log.Printf("CreateEventUseCase - Date parsing error: %v", err)
-		return nil, err
+		return nil, exceptions.NewValidationException("Invalid event date, expected format YYYY-MM-DDTHH:MM")
 	}
 
 	event, businessErr := models.NewEvent(models.EventProps{
@@ -41,14 +45,29 @@ func (uc *createEventUseCase) Execute(props dtos.CreateEventProps) (*dtos.EventD
 		OrganizerID: &props.OrganizerID,
 		Category: 	 &props.Category,
 		Limit:       &props.Limit,
+		TicketTypes: props.TicketTypes,
+		Questions:   props.Questions,
 	}); 
 	if businessErr != nil {
 		return nil, businessErr
 	}
 
-	saveErr := uc.eventRepository.Save(event)
-	if saveErr != nil {
-		return nil, saveErr
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		organizer, err := repos.Users().FindById(ctx, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+		if err := uc.policy.Check(organizer); err != nil {
+			return err
+		}
+
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditEvent(ctx, repos.AuditLog(), models.AuditActionEventCreated, props.OrganizerID, event.ID(), props.IP, nil, eventAuditFields(event))
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &dtos.EventDto{
@@ -61,5 +80,7 @@ func (uc *createEventUseCase) Execute(props dtos.CreateEventProps) (*dtos.EventD
 		Attendees:   event.Attendees(),
 		CreatedAt:   event.CreatedAt(),
 		Category: 	 event.Category(),
+		TicketTypes: event.TicketTypes(),
+		Questions:   event.Questions(),
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,56 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+func TestCreateEventUseCase(t *testing.T) {
+	valid := dtos.CreateEventProps{
+		Name:        "Go Meetup",
+		Location:    "Curitiba",
+		Date:        "2030-05-10T19:00",
+		Description: "Monthly meetup",
+		Category:    "tech",
+		Limit:       30,
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(p *dtos.CreateEventProps)
+		wantErr string
+	}{
+		{name: "creates event", mutate: func(p *dtos.CreateEventProps) {}},
+		{name: "name is required", mutate: func(p *dtos.CreateEventProps) { p.Name = "" }, wantErr: "Event name is required"},
+		{name: "category is required", mutate: func(p *dtos.CreateEventProps) { p.Category = "" }, wantErr: "Event category is required"},
+		{name: "negative limit", mutate: func(p *dtos.CreateEventProps) { p.Limit = -5 }, wantErr: "Event limit cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			props := valid
+			props.Ctx = f.ctx
+			props.OrganizerID = organizer.GetID()
+			tt.mutate(&props)
+
+			created, err := usecases.NewCreateEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(props)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := f.events.FindByID(f.ctx, created.ID); err != nil {
+				t.Fatalf("event was not stored: %v", err)
+			}
+		})
+	}
+}
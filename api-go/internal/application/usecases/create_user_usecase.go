@@ -1,25 +1,34 @@
 package usecases
 
 import (
+	"context"
+	"log"
+	"time"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
-	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 )
 
 type createUserUseCase struct {
-	repo repositories.UserRepository
-	authRepo repositories.AuthRepository
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+	policy PasswordPolicy
+	mailer services.IMailer
+	apiURL string
+	ttl    time.Duration
 }
 
-func NewCreateUserUseCase(repo repositories.UserRepository, authRepo repositories.AuthRepository) *createUserUseCase {
-	return &createUserUseCase{repo: repo, authRepo: authRepo}
+// NewCreateUserUseCase creates unverified accounts and mails them a
+// verification link valid for ttl.
+func NewCreateUserUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, policy PasswordPolicy, mailer services.IMailer, apiURL string, ttl time.Duration) *createUserUseCase {
+	return &createUserUseCase{uow: uow, hasher: hasher, policy: policy, mailer: mailer, apiURL: apiURL, ttl: ttl}
 }
 
 func (uc *createUserUseCase) Execute(props dtos.CreateUserDTO) (*dtos.UserResponseDTO, error) {
-	// Hash da senha antes de criar o usuário
-	hashedPassword, err := utils.HashPassword(props.Password)
-	if err != nil {
+	if err := uc.policy.Check(props.Password); err != nil {
 		return nil, err
 	}
 
@@ -29,19 +38,50 @@ func (uc *createUserUseCase) Execute(props dtos.CreateUserDTO) (*dtos.UserRespon
 		userType = "participant"
 	}
 
-	// Criar usuário com senha hasheada e userType
+	// Criar usuário e suas credenciais, com a senha hasheada
 	user := models.NewUser(models.UserProps{
 		Name:     &props.Name,
 		Email:    &props.Email,
-		Password: &hashedPassword,
 		UserType: &userType,
 	})
+	auth, err := newPasswordAuth(uc.hasher, user.GetID(), props.Password)
+	if err != nil {
+		return nil, err
+	}
 
-	err = uc.repo.Create(user)
+	var rawToken string
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		exists, err := repos.Users().ExistsByEmail(ctx, user.GetEmail())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exceptions.NewConflictException("Email already registered")
+		}
+
+		if err := repos.Users().Create(ctx, user); err != nil {
+			return err
+		}
+		if err := repos.Auths().Create(ctx, auth); err != nil {
+			return err
+		}
+		userID := user.GetID()
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionUserCreated, &userID, userID, props.IP, map[string]string{"user_type": userType}); err != nil {
+			return err
+		}
+
+		rawToken, err = issueUserToken(ctx, repos.UserTokens(), user.GetID(), models.TokenPurposeEmailVerification, uc.ttl)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// A conta já existe; o link pode ser reenviado se o e-mail falhar
+	if err := sendVerificationEmail(props.Ctx, uc.mailer, uc.apiURL, user, rawToken, uc.ttl); err != nil {
+		log.Printf("CreateUserUseCase - Error sending verification email to user %s: %v", user.GetID(), err)
+	}
+
 	return &dtos.UserResponseDTO{
 		ID:        user.GetID(),
 		Name:      user.GetName(),
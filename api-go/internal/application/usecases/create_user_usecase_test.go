@@ -0,0 +1,86 @@
+package usecases_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+func TestCreateUserUseCase(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        dtos.CreateUserDTO
+		wantUserType string
+		wantErr      string
+	}{
+		{
+			name:         "defaults to participant",
+			input:        dtos.CreateUserDTO{Name: "Ana", Email: "ana@example.com", Password: "secret123"},
+			wantUserType: "participant",
+		},
+		{
+			name:         "keeps organizer type",
+			input:        dtos.CreateUserDTO{Name: "Bia", Email: "bia@example.com", Password: "secret123", UserType: "organizer"},
+			wantUserType: "organizer",
+		},
+		{
+			name:    "email already registered",
+			input:   dtos.CreateUserDTO{Name: "Dup", Email: "taken@example.com", Password: "secret123"},
+			wantErr: "Email already registered",
+		},
+		{
+			name:    "breached password",
+			input:   dtos.CreateUserDTO{Name: "Caio", Email: "caio@example.com", Password: "Password1"},
+			wantErr: "This password appeared in a data breach, choose another one",
+		},
+		{
+			name:    "shorter than the policy minimum",
+			input:   dtos.CreateUserDTO{Name: "Duda", Email: "duda@example.com", Password: "short12"},
+			wantErr: "Password must have at least 8 characters",
+		},
+		{
+			name:    "longer than the policy maximum",
+			input:   dtos.CreateUserDTO{Name: "Edu", Email: "edu@example.com", Password: strings.Repeat("é", 36) + "a1"},
+			wantErr: "Password must have at most 72 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.addUser(t, "taken@example.com", "secret123")
+
+			tt.input.Ctx = f.ctx
+			created, err := usecases.NewCreateUserUseCase(f.uow, f.hasher, f.policy, f.mailer, "https://api.example.com", time.Hour).Execute(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.UserType != tt.wantUserType {
+				t.Fatalf("expected user type %q, got %q", tt.wantUserType, created.UserType)
+			}
+
+			stored, err := f.users.FindByEmail(f.ctx, tt.input.Email)
+			if err != nil {
+				t.Fatalf("user was not stored: %v", err)
+			}
+			auth := f.auth(t, stored.GetID())
+			if auth.GetPasswordHash() == tt.input.Password || !f.hasher.Verify(tt.input.Password, auth.GetPasswordHash(), auth.GetAlgorithm()) {
+				t.Fatalf("password was not hashed")
+			}
+			if actions := f.auditActions(); len(actions) != 1 || actions[0] != models.AuditActionUserCreated {
+				t.Fatalf("expected the sign-up to be audited, got %v", actions)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+// newPasswordAuth returns the credentials of a new user, without a password
+// when it is empty.
+func newPasswordAuth(hasher services.IPasswordHasher, userID, password string) (models.Auth, error) {
+	props := models.AuthProps{UserID: &userID}
+	if password != "" {
+		hash, algorithm, err := hasher.Hash(password)
+		if err != nil {
+			return nil, err
+		}
+		props.PasswordHash, props.Algorithm = &hash, &algorithm
+	}
+	return models.NewAuth(props)
+}
+
+// checkPassword reports whether password matches the one stored in auth.
+func checkPassword(hasher services.IPasswordHasher, auth models.Auth, password string) bool {
+	return auth.HasPassword() && hasher.Verify(password, auth.GetPasswordHash(), auth.GetAlgorithm())
+}
+
+// verifyPassword loads the credentials of a signed-in user, answering a
+// ValidationException when password is not theirs.
+func verifyPassword(ctx context.Context, hasher services.IPasswordHasher, auths repositories.AuthRepository, userID, password string) (models.Auth, error) {
+	auth, err := auths.FindByUserID(ctx, userID)
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) || (err == nil && !checkPassword(hasher, auth, password)) {
+		return nil, exceptions.NewValidationException("Invalid password")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+// deletionLoginWindow is how recent the login of an account without a
+// password must be for it to confirm the deletion.
+const deletionLoginWindow = 10 * time.Minute
+
+type deleteAccountUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+}
+
+func NewDeleteAccountUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher) *deleteAccountUseCase {
+	return &deleteAccountUseCase{uow: uow, hasher: hasher}
+}
+
+// Execute anonymizes the account of a user who knows its password, or who
+// signed in recently when the account has none. The user leaves every event
+// they registered to, the events they organize are transferred or
+// cancelled, and whatever lets them sign in is removed.
+//
+// The audit log is append-only, so the entries about the user, with the IPs
+// they came from, are kept; only the user row is anonymized.
+func (uc *deleteAccountUseCase) Execute(props dtos.DeleteAccountDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		auth, err := uc.confirm(ctx, repos, user.GetID(), props.Password, props.SessionID, now)
+		if err != nil {
+			return err
+		}
+
+		registrations, err := leaveEvents(ctx, repos, user.GetID(), props.IP)
+		if err != nil {
+			return err
+		}
+		details, err := handOverEvents(ctx, repos, user, props.OrganizedEvents, props.TransferTo, props.IP)
+		if err != nil {
+			return err
+		}
+		details["registrations"] = strconv.Itoa(registrations)
+
+		if err := repos.TwoFactors().Delete(ctx, user.GetID()); err != nil {
+			return err
+		}
+		if err := repos.RecoveryCodes().DeleteForUser(ctx, user.GetID()); err != nil {
+			return err
+		}
+		if err := repos.ExternalIdentities().DeleteForUser(ctx, user.GetID()); err != nil {
+			return err
+		}
+		if err := repos.APIKeys().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+		if err := repos.Sessions().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+		for _, purpose := range []string{models.TokenPurposePasswordReset, models.TokenPurposeEmailVerification, models.TokenPurposeAccountUnlock, models.TokenPurposeEmailChange} {
+			if err := repos.UserTokens().RevokeForUser(ctx, user.GetID(), purpose, now); err != nil {
+				return err
+			}
+		}
+
+		if auth != nil {
+			auth.RemovePassword(now)
+			if err := repos.Auths().Save(ctx, auth); err != nil {
+				return err
+			}
+		}
+		if err := user.Anonymize(now); err != nil {
+			return err
+		}
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return err
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionAccountDeleted, &props.UserID, props.UserID, props.IP, details)
+	})
+	if err != nil {
+		return struct{}{}, err
+	}
+	return struct{}{}, nil
+}
+
+// confirm checks that the user is the one deleting the account and returns
+// their credentials, nil when they have none. Accounts with a password need
+// it; the others need the request to come from a login made in the last
+// deletionLoginWindow, such as a new OIDC login.
+func (uc *deleteAccountUseCase) confirm(ctx context.Context, repos repositories.Repositories, userID, password, sessionID string, now time.Time) (models.Auth, error) {
+	auth, err := repos.Auths().FindByUserID(ctx, userID)
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		auth = nil
+	} else if err != nil {
+		return nil, err
+	}
+	if auth != nil && auth.HasPassword() {
+		if !checkPassword(uc.hasher, auth, password) {
+			return nil, exceptions.NewValidationException("Invalid password")
+		}
+		return auth, nil
+	}
+
+	reauthenticate := exceptions.NewForbiddenException("Sign in again to delete an account without a password")
+	if sessionID == "" {
+		return nil, reauthenticate
+	}
+	session, err := repos.Sessions().FindByID(ctx, sessionID)
+	if errors.As(err, &notFound) {
+		return nil, reauthenticate
+	}
+	if err != nil {
+		return nil, err
+	}
+	if session.GetUserID() != userID || !session.IsActive(now) || now.Sub(session.GetCreatedAt()) > deletionLoginWindow {
+		return nil, reauthenticate
+	}
+	return auth, nil
+}
+
+// leaveEvents removes the user from every attendee list and returns how
+// many they were on.
+func leaveEvents(ctx context.Context, repos repositories.Repositories, userID, ip string) (int, error) {
+	registrations, err := orNoEvents(repos.Events().FindByAttendee(ctx, userID))
+	if err != nil {
+		return 0, err
+	}
+	for _, registration := range registrations {
+		event, err := repos.Events().FindByIDForUpdate(ctx, registration.ID())
+		if err != nil {
+			return 0, err
+		}
+		before := eventAuditFields(event)
+		if err := event.CancelSubscription(userID); err != nil {
+			return 0, err
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return 0, err
+		}
+		if err := auditEvent(ctx, repos.AuditLog(), models.AuditActionEventUnregistered, userID, event.ID(), ip, before, eventAuditFields(event)); err != nil {
+			return 0, err
+		}
+	}
+	return len(registrations), nil
+}
+
+// handOverEvents transfers or cancels the events the user organizes and
+// returns the audit details describing what happened to them. Each event
+// also gets its own audit entry.
+func handOverEvents(ctx context.Context, repos repositories.Repositories, user models.User, mode, transferTo, ip string) (map[string]string, error) {
+	organized, err := orNoEvents(repos.Events().FindByOrganizerID(ctx, user.GetID()))
+	if err != nil {
+		return nil, err
+	}
+	if len(organized) == 0 {
+		return map[string]string{}, nil
+	}
+
+	ids := make([]string, 0, len(organized))
+	for _, event := range organized {
+		ids = append(ids, event.ID())
+	}
+	details := map[string]string{"organized_events": mode, "events": strings.Join(ids, ",")}
+
+	switch mode {
+	case dtos.OrganizedEventsTransfer:
+		organizer, err := repos.Users().FindByEmail(ctx, transferTo)
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			return nil, exceptions.NewValidationException(fmt.Sprintf("No user with email %s", transferTo))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if organizer.GetID() == user.GetID() {
+			return nil, exceptions.NewValidationException("Events cannot be transferred to yourself")
+		}
+
+		for _, id := range ids {
+			event, err := repos.Events().FindByIDForUpdate(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			before := eventAuditFields(event)
+			if err := event.TransferTo(organizer.GetID()); err != nil {
+				return nil, err
+			}
+			if err := repos.Events().Save(ctx, event); err != nil {
+				return nil, err
+			}
+			if err := auditEvent(ctx, repos.AuditLog(), models.AuditActionEventUpdated, user.GetID(), id, ip, before, eventAuditFields(event)); err != nil {
+				return nil, err
+			}
+		}
+		details["transfer_to"] = organizer.GetID()
+	case dtos.OrganizedEventsCancel:
+		for _, event := range organized {
+			if err := repos.Events().Delete(ctx, event.ID()); err != nil {
+				return nil, err
+			}
+			if err := auditEvent(ctx, repos.AuditLog(), models.AuditActionEventDeleted, user.GetID(), event.ID(), ip, eventAuditFields(event), nil); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, exceptions.NewConflictException(fmt.Sprintf("You organize %d events; choose whether to transfer or cancel them", len(organized)))
+	}
+	return details, nil
+}
@@ -1,41 +1,50 @@
 package usecases
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
-	"github.com/Gabriel-Schiestl/go-clarch/domain/exceptions"
 )
 
 type deleteEventUseCase struct {
-	eventRepository repositories.IEventRepository
+	uow repositories.UnitOfWork
 }
 
-func NewDeleteEventUseCase(eventRepository repositories.IEventRepository) *deleteEventUseCase {
+func NewDeleteEventUseCase(uow repositories.UnitOfWork) *deleteEventUseCase {
 	return &deleteEventUseCase{
-		eventRepository: eventRepository,
+		uow: uow,
 	}
 }
 
 type DeleteEventProps struct {
+	Ctx         context.Context `json:"-"`
 	EventID     string
 	OrganizerID string
+	IP          string
 }
 
 func (uc *deleteEventUseCase) Execute(props DeleteEventProps) (struct{}, error) {
-	event, err := uc.eventRepository.FindByID(props.EventID)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		event, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+
+		if event.OrganizerID() != props.OrganizerID {
+			return exceptions.NewForbiddenException(fmt.Sprintf("User %s is not authorized to delete event %s", props.OrganizerID, props.EventID))
+		}
+
+		if err := repos.Events().Delete(ctx, props.EventID); err != nil {
+			return err
+		}
+		return auditEvent(ctx, repos.AuditLog(), models.AuditActionEventDeleted, props.OrganizerID, props.EventID, props.IP, eventAuditFields(event), nil)
+	})
 	if err != nil {
 		return struct{}{}, err
 	}
 
-	if event.OrganizerID() != props.OrganizerID {
-		return struct{}{}, exceptions.NewBusinessException(fmt.Sprintf("User %s is not authorized to delete event %s", props.OrganizerID, props.EventID))
-	}
-
-	deleteErr := uc.eventRepository.Delete(props.EventID)
-	if deleteErr != nil {
-		return struct{}{}, deleteErr
-	}
-
 	return struct{}{}, nil
 }
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type disableTwoFactorUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+}
+
+func NewDisableTwoFactorUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher) *disableTwoFactorUseCase {
+	return &disableTwoFactorUseCase{uow: uow, hasher: hasher}
+}
+
+// Execute turns two-factor authentication off, requiring both the password
+// and a TOTP or recovery code so a stolen session alone cannot do it.
+func (uc *disableTwoFactorUseCase) Execute(props dtos.DisableTwoFactorDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		if _, err := verifyPassword(ctx, uc.hasher, repos.Auths(), user.GetID(), props.Password); err != nil {
+			return err
+		}
+
+		twoFactor, err := repos.TwoFactors().FindByUserIDForUpdate(ctx, user.GetID())
+		if err != nil {
+			return err
+		}
+		if twoFactor.IsEnabled() {
+			ok, err := checkSecondFactor(ctx, repos, twoFactor, props.Code, true)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return exceptions.NewValidationException("Invalid two-factor code")
+			}
+		}
+
+		if err := repos.RecoveryCodes().DeleteForUser(ctx, user.GetID()); err != nil {
+			return err
+		}
+		return repos.TwoFactors().Delete(ctx, user.GetID())
+	})
+
+	return struct{}{}, err
+}
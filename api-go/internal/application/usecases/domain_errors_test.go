@@ -0,0 +1,90 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+func TestUseCasesReturnTypedErrors(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	user := f.addUser(t, "user@example.com", "secret123")
+	full := f.addEvent(t, organizer.GetID(), 1, f.addUser(t, "first@example.com", "secret123").GetID())
+
+	var (
+		notFound     *exceptions.NotFoundException
+		forbidden    *exceptions.ForbiddenException
+		conflict     *exceptions.ConflictException
+		validation   *exceptions.ValidationException
+		unauthorized *exceptions.UnauthorizedException
+	)
+
+	tests := []struct {
+		name   string
+		run    func() error
+		target any
+	}{
+		{
+			name: "unknown event is NotFound",
+			run: func() error {
+				_, err := usecases.NewGetEventByIdUseCase(f.events, f.users).Execute(usecases.GetEventByIdUseCaseProps{Ctx: f.ctx, EventID: "missing"})
+				return err
+			},
+			target: &notFound,
+		},
+		{
+			name: "deleting someone else's event is Forbidden",
+			run: func() error {
+				_, err := usecases.NewDeleteEventUseCase(f.uow).Execute(usecases.DeleteEventProps{Ctx: f.ctx, EventID: full.ID(), OrganizerID: user.GetID()})
+				return err
+			},
+			target: &forbidden,
+		},
+		{
+			name: "attendee limit is a Conflict",
+			run: func() error {
+				_, err := usecases.NewRegisterToEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(usecases.RegisterToEventUseCaseProps{Ctx: f.ctx, UserId: user.GetID(), EventId: full.ID()})
+				return err
+			},
+			target: &conflict,
+		},
+		{
+			name: "duplicate email is a Conflict",
+			run: func() error {
+				_, err := usecases.NewCreateUserUseCase(f.uow, f.hasher, f.policy, f.mailer, "https://api.example.com", time.Hour).Execute(dtos.CreateUserDTO{Ctx: f.ctx, Name: "Dup", Email: user.GetEmail(), Password: "secret123"})
+				return err
+			},
+			target: &conflict,
+		},
+		{
+			name: "missing event name is a Validation error",
+			run: func() error {
+				_, err := usecases.NewCreateEventUseCase(f.uow, usecases.EmailVerificationPolicy{}).Execute(dtos.CreateEventProps{Ctx: f.ctx, Location: "x", Date: "2030-01-01T10:00", OrganizerID: organizer.GetID(), Category: "tech"})
+				return err
+			},
+			target: &validation,
+		},
+		{
+			name: "wrong password is Unauthorized",
+			run: func() error {
+				_, err := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard).Execute(dtos.LoginDto{Ctx: f.ctx, Email: user.GetEmail(), Password: "nope"})
+				return err
+			},
+			target: &unauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+			if err == nil || !errors.As(err, tt.target) {
+				t.Fatalf("expected %T, got %T (%v)", tt.target, err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// EmailVerificationPolicy decides whether users with an unverified email may
+// create events and register to them.
+type EmailVerificationPolicy struct {
+	RequireVerified bool
+}
+
+func (p EmailVerificationPolicy) Check(user models.User) error {
+	if p.RequireVerified && !user.IsEmailVerified() {
+		return exceptions.NewForbiddenException("Email address must be verified first")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+var verifyLinkPattern = regexp.MustCompile(`https://api\.example\.com/auth/verify\?token=(\S+)`)
+
+// lastVerificationToken extracts the raw token from the latest mail sent.
+func (f *fixture) lastVerificationToken(t *testing.T) string {
+	t.Helper()
+
+	sent := f.mailer.Sent()
+	if len(sent) == 0 {
+		t.Fatalf("no mail was sent")
+	}
+	match := verifyLinkPattern.FindStringSubmatch(sent[len(sent)-1].Body)
+	if match == nil {
+		t.Fatalf("no verification link in mail: %q", sent[len(sent)-1].Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("invalid token in link: %v", err)
+	}
+	return token
+}
+
+func TestSignupIsVerifiedThroughTheMailedLink(t *testing.T) {
+	f := newFixture()
+
+	created, err := usecases.NewCreateUserUseCase(f.uow, f.hasher, f.policy, f.mailer, "https://api.example.com", time.Hour).
+		Execute(dtos.CreateUserDTO{Ctx: f.ctx, Name: "Ana", Email: "ana@example.com", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("creating user: %v", err)
+	}
+
+	user, _ := f.users.FindById(f.ctx, created.ID)
+	if user.IsEmailVerified() {
+		t.Fatalf("new accounts must start unverified")
+	}
+
+	token := f.lastVerificationToken(t)
+	verify := usecases.NewVerifyEmailUseCase(f.uow)
+	if _, err := verify.Execute(dtos.VerifyEmailDto{Ctx: f.ctx, Token: token}); err != nil {
+		t.Fatalf("verifying: %v", err)
+	}
+
+	user, _ = f.users.FindById(f.ctx, created.ID)
+	if !user.IsEmailVerified() {
+		t.Fatalf("expected the email to be verified")
+	}
+
+	var validation *exceptions.ValidationException
+	if _, err := verify.Execute(dtos.VerifyEmailDto{Ctx: f.ctx, Token: token}); !errors.As(err, &validation) {
+		t.Fatalf("expected a used token to be rejected, got %v", err)
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	resend := usecases.NewResendVerificationUseCase(f.uow, f.mailer, "https://api.example.com", time.Hour, time.Hour)
+	props := usecases.ResendVerificationProps{Ctx: f.ctx, UserID: user.GetID()}
+
+	if _, err := resend.Execute(props); err != nil {
+		t.Fatalf("first resend: %v", err)
+	}
+	first := f.lastVerificationToken(t)
+
+	var tooMany *exceptions.TooManyRequestsException
+	if _, err := resend.Execute(props); !errors.As(err, &tooMany) || tooMany.RetryAfter() <= 0 {
+		t.Fatalf("expected a TooManyRequestsException with a retry delay, got %v", err)
+	}
+	if sent := f.mailer.Sent(); len(sent) != 1 {
+		t.Fatalf("expected a single mail, got %d", len(sent))
+	}
+
+	// Sem janela de espera, um novo link invalida o anterior
+	noWindow := usecases.NewResendVerificationUseCase(f.uow, f.mailer, "https://api.example.com", time.Hour, time.Nanosecond)
+	if _, err := noWindow.Execute(props); err != nil {
+		t.Fatalf("resend after the window: %v", err)
+	}
+	var validation *exceptions.ValidationException
+	if _, err := usecases.NewVerifyEmailUseCase(f.uow).Execute(dtos.VerifyEmailDto{Ctx: f.ctx, Token: first}); !errors.As(err, &validation) {
+		t.Fatalf("expected the superseded token to be rejected, got %v", err)
+	}
+	if _, err := usecases.NewVerifyEmailUseCase(f.uow).Execute(dtos.VerifyEmailDto{Ctx: f.ctx, Token: f.lastVerificationToken(t)}); err != nil {
+		t.Fatalf("verifying with the latest token: %v", err)
+	}
+
+	var conflict *exceptions.ConflictException
+	if _, err := noWindow.Execute(props); !errors.As(err, &conflict) {
+		t.Fatalf("expected a ConflictException once verified, got %v", err)
+	}
+}
+
+func TestVerifiedEmailPolicy(t *testing.T) {
+	required := usecases.EmailVerificationPolicy{RequireVerified: true}
+
+	tests := []struct {
+		name          string
+		verified      bool
+		policy        usecases.EmailVerificationPolicy
+		wantForbidden bool
+	}{
+		{name: "unverified user, policy off", policy: usecases.EmailVerificationPolicy{}},
+		{name: "unverified user, policy on", policy: required, wantForbidden: true},
+		{name: "verified user, policy on", verified: true, policy: required},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			user := f.addUser(t, "user@example.com", "secret123")
+			if tt.verified {
+				user.VerifyEmail(time.Now())
+				if err := f.users.Save(f.ctx, user); err != nil {
+					t.Fatalf("saving user: %v", err)
+				}
+			}
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			event := f.addEvent(t, organizer.GetID(), 10)
+
+			_, registerErr := usecases.NewRegisterToEventUseCase(f.uow, tt.policy).
+				Execute(usecases.RegisterToEventUseCaseProps{Ctx: f.ctx, UserId: user.GetID(), EventId: event.ID()})
+			_, createErr := usecases.NewCreateEventUseCase(f.uow, tt.policy).
+				Execute(dtos.CreateEventProps{Ctx: f.ctx, Name: "Talk", Location: "Room 1", Date: "2030-01-01T10:00", OrganizerID: user.GetID(), Category: "tech"})
+
+			for name, err := range map[string]error{"register": registerErr, "create": createErr} {
+				var forbidden *exceptions.ForbiddenException
+				if got := errors.As(err, &forbidden); got != tt.wantForbidden {
+					t.Fatalf("%s: expected forbidden=%v, got %v", name, tt.wantForbidden, err)
+				}
+				if !tt.wantForbidden && err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+			}
+		})
+	}
+}
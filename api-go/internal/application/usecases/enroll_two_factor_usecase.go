@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type enrollTwoFactorUseCase struct {
+	uow    repositories.UnitOfWork
+	issuer string
+}
+
+// NewEnrollTwoFactorUseCase starts a TOTP enrollment; issuer is the name
+// authenticator apps display next to the account.
+func NewEnrollTwoFactorUseCase(uow repositories.UnitOfWork, issuer string) *enrollTwoFactorUseCase {
+	return &enrollTwoFactorUseCase{uow: uow, issuer: issuer}
+}
+
+type EnrollTwoFactorProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+// Execute replaces any pending enrollment with a new secret. The enrollment
+// only takes effect once confirmed with a valid code.
+func (uc *enrollTwoFactorUseCase) Execute(props EnrollTwoFactorProps) (*dtos.TwoFactorEnrollmentDto, error) {
+	secret, err := utils.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		user, err = repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := repos.TwoFactors().FindByUserIDForUpdate(ctx, user.GetID())
+		var notFound *exceptions.NotFoundException
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+		if err == nil && existing.IsEnabled() {
+			return exceptions.NewConflictException("Two-factor authentication is already enabled")
+		}
+
+		userID := user.GetID()
+		twoFactor, err := models.NewTwoFactor(models.TwoFactorProps{UserID: &userID, Secret: &secret})
+		if err != nil {
+			return err
+		}
+		return repos.TwoFactors().Save(ctx, twoFactor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uri := utils.TOTPURI(uc.issuer, user.GetEmail(), secret)
+	qrCode, err := utils.QRCodeDataURI(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.TwoFactorEnrollmentDto{
+		Secret:     secret,
+		OTPAuthURI: uri,
+		QRCode:     qrCode,
+	}, nil
+}
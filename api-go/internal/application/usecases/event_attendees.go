@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+// findOrganizedEventForUpdate locks the event for the attendee changes of
+// its organizer.
+func findOrganizedEventForUpdate(ctx context.Context, events repositories.IEventRepository, eventID, organizerID string) (models.Event, error) {
+	event, err := events.FindByIDForUpdate(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.OrganizerID() != organizerID {
+		return nil, exceptions.NewForbiddenException("User is not authorized to manage the attendees of this event")
+	}
+	return event, nil
+}
+
+// addAttendee applies the rules of AddAttendee, without the attendee limit
+// when overLimit is set.
+func addAttendee(event models.Event, userID string, overLimit bool) error {
+	if overLimit {
+		return event.AddAttendeeOverLimit(userID)
+	}
+	return event.AddAttendee(userID)
+}
+
+// attendeeRuleError reports whether err is an event rule refusing a single
+// attendee of a bulk operation, which fails that attendee only.
+func attendeeRuleError(err error) bool {
+	var (
+		validation *exceptions.ValidationException
+		conflict   *exceptions.ConflictException
+	)
+	return errors.As(err, &validation) || errors.As(err, &conflict)
+}
+
+// auditAttendees appends an entry about the attendees an organizer changed,
+// with the IDs or emails involved in details joined by commas.
+func auditAttendees(ctx context.Context, log repositories.AuditLogRepository, action, organizerID, eventID, ip string, details, before, after map[string]string) error {
+	targetType := models.AuditTargetEvent
+	return appendAudit(ctx, log, models.AuditEntryProps{
+		ActorID:    &organizerID,
+		Action:     &action,
+		TargetType: &targetType,
+		TargetID:   &eventID,
+		IP:         &ip,
+		Details:    details,
+		Changes:    diffFields(before, after),
+	})
+}
+
+// setJoined joins values with commas into details[key], leaving the key out
+// when there are none.
+func setJoined(details map[string]string, key string, values []string) {
+	if len(values) > 0 {
+		details[key] = strings.Join(values, ",")
+	}
+}
+
+// acceptEventInvites registers user to the events their address was invited
+// to. Call it once the address is known to belong to them. Invites are
+// accepted even when the event no longer takes the user, e.g. because it
+// filled up, so that they are only tried once.
+func acceptEventInvites(ctx context.Context, repos repositories.Repositories, user models.User, ip string) error {
+	invites, err := repos.EventInvites().FindPendingByEmail(ctx, user.GetEmail())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, invite := range invites {
+		if err := invite.Accept(now); err != nil {
+			return err
+		}
+		if err := repos.EventInvites().Save(ctx, invite); err != nil {
+			return err
+		}
+
+		event, err := repos.Events().FindByIDForUpdate(ctx, invite.GetEventID())
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		before := eventAuditFields(event)
+		if err := addAttendee(event, user.GetID(), invite.IsOverLimit()); err != nil {
+			if !attendeeRuleError(err) {
+				return err
+			}
+			log.Printf("Invite %s to event %s not honored: %v", invite.GetID(), event.ID(), err)
+			continue
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		if err := auditEvent(ctx, repos.AuditLog(), models.AuditActionEventRegistered, user.GetID(), event.ID(), ip, before, eventAuditFields(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package usecases_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// outcomes summarizes bulk results as "who:status" in order.
+func outcomes(results []dtos.AttendeeOutcomeDto) []string {
+	var got []string
+	for _, r := range results {
+		who := r.Email
+		if who == "" {
+			who = r.UserID
+		}
+		got = append(got, who+":"+r.Status)
+	}
+	return got
+}
+
+func (f *fixture) addAttendees(t *testing.T, organizerID, eventID string, overrideLimit bool, emails ...string) *dtos.BulkAttendeesResultDto {
+	t.Helper()
+
+	result, err := usecases.NewAddAttendeesUseCase(f.uow, f.mailer, "https://app.example.com").Execute(dtos.AddAttendeesDto{
+		Ctx: f.ctx, OrganizerID: organizerID, EventID: eventID, Emails: emails, OverrideLimit: overrideLimit,
+	})
+	if err != nil {
+		t.Fatalf("adding attendees: %v", err)
+	}
+	return result
+}
+
+func TestAddAttendees(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	bob := f.addUser(t, "bob@example.com", "secret123")
+	if err := bob.Suspend(time.Now(), "Spam"); err != nil {
+		t.Fatalf("suspending: %v", err)
+	}
+	if err := f.users.Save(f.ctx, bob); err != nil {
+		t.Fatalf("saving user: %v", err)
+	}
+	event := f.addEvent(t, organizer.GetID(), 10)
+
+	result := f.addAttendees(t, organizer.GetID(), event.ID(), false,
+		"ana@example.com", "New@Example.com", "new@example.com", "bob@example.com", "organizer@example.com")
+
+	want := []string{"ana@example.com:added", "New@Example.com:invited", "bob@example.com:failed", "organizer@example.com:failed"}
+	if got := outcomes(result.Results); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if !slices.Equal(f.attendees(t, event.ID()), []string{ana.GetID()}) {
+		t.Fatalf("expected only ana to be registered, got %v", f.attendees(t, event.ID()))
+	}
+
+	sent := f.mailer.Sent()
+	if len(sent) != 1 || sent[0].To != "new@example.com" {
+		t.Fatalf("expected an invite mailed to new@example.com, got %+v", sent)
+	}
+
+	// Adding the address again keeps the pending invite.
+	again := f.addAttendees(t, organizer.GetID(), event.ID(), false, "new@example.com")
+	if again.Results[0].InviteID != result.Results[1].InviteID || len(f.mailer.Sent()) != 1 {
+		t.Fatalf("expected the pending invite to be reused, got %+v", again.Results)
+	}
+	if got := f.auditActions(); !containsAll(got, models.AuditActionAttendeesAdded) {
+		t.Fatalf("expected the additions to be audited, got %v", got)
+	}
+}
+
+func TestAddAttendeesLimitOverride(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	f.addUser(t, "bob@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 1, ana.GetID())
+
+	result := f.addAttendees(t, organizer.GetID(), event.ID(), false, "bob@example.com")
+	if result.Results[0].Status != dtos.AttendeeFailed || result.Results[0].Error != "Event attendee limit reached" {
+		t.Fatalf("expected the limit to be enforced, got %+v", result.Results)
+	}
+
+	result = f.addAttendees(t, organizer.GetID(), event.ID(), true, "bob@example.com")
+	if result.Results[0].Status != dtos.AttendeeAdded || len(f.attendees(t, event.ID())) != 2 {
+		t.Fatalf("expected the override to let bob in, got %+v", result.Results)
+	}
+}
+
+func TestOnlyOrganizersManageAttendees(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10)
+
+	var forbidden *exceptions.ForbiddenException
+	if _, err := usecases.NewAddAttendeesUseCase(f.uow, f.mailer, "https://app.example.com").Execute(dtos.AddAttendeesDto{
+		Ctx: f.ctx, OrganizerID: other.GetID(), EventID: event.ID(), Emails: []string{"ana@example.com"},
+	}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected other users to be refused, got %v", err)
+	}
+	if _, err := usecases.NewRemoveAttendeesUseCase(f.uow).Execute(dtos.RemoveAttendeesDto{
+		Ctx: f.ctx, OrganizerID: other.GetID(), EventID: event.ID(), UserIDs: []string{other.GetID()},
+	}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected other users to be refused, got %v", err)
+	}
+	if _, err := usecases.NewListEventInvitesUseCase(f.uow).Execute(dtos.ListEventInvitesDto{
+		Ctx: f.ctx, OrganizerID: other.GetID(), EventID: event.ID(),
+	}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected other users to be refused, got %v", err)
+	}
+}
+
+func TestInvitesAreAcceptedOnceTheEmailIsVerified(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	full := f.addEvent(t, organizer.GetID(), 1, ana.GetID())
+	open := f.addEvent(t, organizer.GetID(), 10)
+
+	f.addAttendees(t, organizer.GetID(), full.ID(), true, "new@example.com")
+	f.addAttendees(t, organizer.GetID(), open.ID(), false, "new@example.com")
+
+	created, err := usecases.NewCreateUserUseCase(f.uow, f.hasher, f.policy, f.mailer, "https://api.example.com", time.Hour).
+		Execute(dtos.CreateUserDTO{Ctx: f.ctx, Name: "New", Email: "NEW@example.com", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("creating user: %v", err)
+	}
+	if slices.Contains(f.attendees(t, open.ID()), created.ID) {
+		t.Fatalf("expected the invite to wait for the email to be verified")
+	}
+
+	if _, err := usecases.NewVerifyEmailUseCase(f.uow).Execute(dtos.VerifyEmailDto{Ctx: f.ctx, Token: f.lastVerificationToken(t)}); err != nil {
+		t.Fatalf("verifying: %v", err)
+	}
+	for _, event := range []models.Event{full, open} {
+		if !slices.Contains(f.attendees(t, event.ID()), created.ID) {
+			t.Fatalf("expected the invitee to be registered to %s, got %v", event.ID(), f.attendees(t, event.ID()))
+		}
+	}
+
+	invites, err := usecases.NewListEventInvitesUseCase(f.uow).Execute(dtos.ListEventInvitesDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: open.ID()})
+	if err != nil || len(invites) != 0 {
+		t.Fatalf("expected no pending invites left, got %v, %v", invites, err)
+	}
+}
+
+func TestCancelEventInvite(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10)
+	other := f.addEvent(t, organizer.GetID(), 10)
+
+	invite := f.addAttendees(t, organizer.GetID(), event.ID(), false, "new@example.com").Results[0].InviteID
+	list := usecases.NewListEventInvitesUseCase(f.uow)
+	invites, err := list.Execute(dtos.ListEventInvitesDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: event.ID()})
+	if err != nil || len(invites) != 1 || invites[0].ID != invite || invites[0].Email != "new@example.com" {
+		t.Fatalf("expected the pending invite, got %+v, %v", invites, err)
+	}
+
+	cancel := usecases.NewCancelEventInviteUseCase(f.uow)
+	var notFound *exceptions.NotFoundException
+	if _, err := cancel.Execute(dtos.CancelEventInviteDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: other.ID(), InviteID: invite}); !errors.As(err, &notFound) {
+		t.Fatalf("expected invites of other events not to be found, got %v", err)
+	}
+	if _, err := cancel.Execute(dtos.CancelEventInviteDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: event.ID(), InviteID: invite}); err != nil {
+		t.Fatalf("cancelling: %v", err)
+	}
+	if invites, _ := list.Execute(dtos.ListEventInvitesDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: event.ID()}); len(invites) != 0 {
+		t.Fatalf("expected the invite to be gone, got %+v", invites)
+	}
+	if got := f.auditActions(); !containsAll(got, models.AuditActionInviteCancelled) {
+		t.Fatalf("expected the cancellation to be audited, got %v", got)
+	}
+}
+
+func TestRemoveAttendees(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	bob := f.addUser(t, "bob@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10, ana.GetID(), bob.GetID())
+
+	result, err := usecases.NewRemoveAttendeesUseCase(f.uow).Execute(dtos.RemoveAttendeesDto{
+		Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: event.ID(), UserIDs: []string{ana.GetID(), "missing"},
+	})
+	if err != nil {
+		t.Fatalf("removing attendees: %v", err)
+	}
+
+	want := []string{ana.GetID() + ":removed", "missing:failed"}
+	if got := outcomes(result.Results); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if !slices.Equal(f.attendees(t, event.ID()), []string{bob.GetID()}) {
+		t.Fatalf("expected only bob to be left, got %v", f.attendees(t, event.ID()))
+	}
+	if got := f.auditActions(); !containsAll(got, models.AuditActionAttendeesRemoved) {
+		t.Fatalf("expected the removal to be audited, got %v", got)
+	}
+}
+
+func TestMoveAttendees(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	ana := f.addUser(t, "ana@example.com", "secret123")
+	bob := f.addUser(t, "bob@example.com", "secret123")
+	carl := f.addUser(t, "carl@example.com", "secret123")
+	from := f.addEvent(t, organizer.GetID(), 10, ana.GetID(), bob.GetID())
+	to := f.addEvent(t, organizer.GetID(), 1)
+
+	move := usecases.NewMoveAttendeesUseCase(f.uow)
+	result, err := move.Execute(dtos.MoveAttendeesDto{
+		Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: from.ID(), ToEventID: to.ID(), UserIDs: []string{ana.GetID(), bob.GetID(), carl.GetID()},
+	})
+	if err != nil {
+		t.Fatalf("moving attendees: %v", err)
+	}
+
+	want := []string{ana.GetID() + ":moved", bob.GetID() + ":failed", carl.GetID() + ":failed"}
+	if got := outcomes(result.Results); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if !slices.Equal(f.attendees(t, from.ID()), []string{bob.GetID()}) || !slices.Equal(f.attendees(t, to.ID()), []string{ana.GetID()}) {
+		t.Fatalf("expected bob to stay behind the full event, got %v and %v", f.attendees(t, from.ID()), f.attendees(t, to.ID()))
+	}
+
+	if _, err := move.Execute(dtos.MoveAttendeesDto{
+		Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: from.ID(), ToEventID: to.ID(), UserIDs: []string{bob.GetID()}, OverrideLimit: true,
+	}); err != nil {
+		t.Fatalf("moving with override: %v", err)
+	}
+	if len(f.attendees(t, from.ID())) != 0 || len(f.attendees(t, to.ID())) != 2 {
+		t.Fatalf("expected the override to move bob, got %v and %v", f.attendees(t, from.ID()), f.attendees(t, to.ID()))
+	}
+
+	var validation *exceptions.ValidationException
+	if _, err := move.Execute(dtos.MoveAttendeesDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: to.ID(), ToEventID: to.ID(), UserIDs: []string{ana.GetID()}}); !errors.As(err, &validation) {
+		t.Fatalf("expected moving within an event to be refused, got %v", err)
+	}
+
+	someoneElse := f.addUser(t, "someone@example.com", "secret123")
+	theirs := f.addEvent(t, someoneElse.GetID(), 10)
+	var forbidden *exceptions.ForbiddenException
+	if _, err := move.Execute(dtos.MoveAttendeesDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), EventID: to.ID(), ToEventID: theirs.ID(), UserIDs: []string{ana.GetID()}}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected moving to another organizer's event to be refused, got %v", err)
+	}
+}
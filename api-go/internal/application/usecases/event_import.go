@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// maxEventImportRows caps the number of events a single file may hold.
+const maxEventImportRows = 5000
+
+// eventImportColumns are the columns CSV files may have, required ones
+// first.
+var (
+	eventImportColumns         = []string{"name", "location", "date", "category", "timezone", "description", "limit"}
+	requiredEventImportColumns = eventImportColumns[:4]
+)
+
+// eventImportRow is an event read from an imported file, or why it could not
+// be read.
+type eventImportRow struct {
+	event dtos.ImportEventRowDto
+	err   string
+}
+
+// parseEventImport reads the events of a file. Problems with the file as a
+// whole are a ValidationException, those of a single event are left in its
+// row to be reported with the other row errors.
+func parseEventImport(format string, data []byte) ([]eventImportRow, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var (
+		rows []eventImportRow
+		err  error
+	)
+	if format == dtos.EventImportCSV {
+		rows, err = parseEventImportCSV(data)
+	} else {
+		rows, err = parseEventImportJSON(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(rows) == 0:
+		return nil, exceptions.NewValidationException("The file has no events")
+	case len(rows) > maxEventImportRows:
+		return nil, exceptions.NewValidationException(fmt.Sprintf("The file has more than %d events", maxEventImportRows))
+	}
+	return rows, nil
+}
+
+func parseEventImportCSV(data []byte) ([]eventImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, exceptions.NewValidationException(fmt.Sprintf("Invalid CSV: %v", err))
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(eventImportColumns, name) {
+			return nil, exceptions.NewValidationException(fmt.Sprintf("Unknown column %q, expected %s", name, strings.Join(eventImportColumns, ", ")))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, exceptions.NewValidationException(fmt.Sprintf("Duplicate column %q", name))
+		}
+		columns[name] = i
+	}
+	for _, name := range requiredEventImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, exceptions.NewValidationException(fmt.Sprintf("Missing column %q", name))
+		}
+	}
+
+	var rows []eventImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, exceptions.NewValidationException(fmt.Sprintf("Invalid CSV: %v", err))
+		}
+		if len(rows) == maxEventImportRows {
+			// One more than allowed is enough to reject the file.
+			return append(rows, eventImportRow{}), nil
+		}
+
+		if len(record) != len(header) {
+			rows = append(rows, eventImportRow{err: fmt.Sprintf("Expected %d columns, got %d", len(header), len(record))})
+			continue
+		}
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := eventImportRow{event: dtos.ImportEventRowDto{
+			Name:        cell("name"),
+			Location:    cell("location"),
+			Date:        cell("date"),
+			Timezone:    cell("timezone"),
+			Description: cell("description"),
+			Category:    cell("category"),
+		}}
+		if limit := cell("limit"); limit != "" {
+			if row.event.Limit, err = strconv.Atoi(limit); err != nil {
+				row.err = "limit must be a whole number"
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+func parseEventImportJSON(data []byte) ([]eventImportRow, error) {
+	var events []json.RawMessage
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, exceptions.NewValidationException("Invalid JSON, expected an array of events")
+	}
+
+	rows := make([]eventImportRow, 0, min(len(events), maxEventImportRows+1))
+	for _, raw := range events[:min(len(events), maxEventImportRows+1)] {
+		var row eventImportRow
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&row.event); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				row.err = fmt.Sprintf("%s has the wrong type", typeErr.Field)
+			} else {
+				row.err = "Invalid event: " + strings.TrimPrefix(err.Error(), "json: ")
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// newImportedEvent checks an imported event against the rules of the event
+// form before building it. It returns every problem found, separated by
+// semicolons.
+func newImportedEvent(row dtos.ImportEventRowDto, organizerID string, now time.Time) (models.Event, string) {
+	var problems []string
+	for _, field := range []struct {
+		name, value string
+		required    bool
+		max         int
+	}{
+		{"name", row.Name, true, 255},
+		{"location", row.Location, true, 255},
+		{"category", row.Category, true, 255},
+		{"description", row.Description, false, 5000},
+	} {
+		switch {
+		case field.required && field.value == "":
+			problems = append(problems, field.name+" is required")
+		case utf8.RuneCountInString(field.value) > field.max:
+			problems = append(problems, fmt.Sprintf("%s must be at most %d characters", field.name, field.max))
+		}
+	}
+
+	// Like the event form, only UTC and Area/Location names are zones.
+	zone := row.Timezone
+	if zone != "" {
+		_, err := time.LoadLocation(zone)
+		if err != nil || (zone != "UTC" && !strings.Contains(zone, "/")) {
+			problems = append(problems, "timezone must be an IANA time zone, such as America/Sao_Paulo")
+			zone = ""
+		}
+	}
+
+	date, err := utils.ParseEventDate(row.Date, zone)
+	switch {
+	case row.Date == "":
+		problems = append(problems, "date is required")
+	case err != nil:
+		problems = append(problems, "date must be in the format YYYY-MM-DDTHH:MM")
+	case !date.After(now):
+		problems = append(problems, "date must be in the future")
+	}
+
+	if len(problems) > 0 {
+		return nil, strings.Join(problems, "; ")
+	}
+
+	event, err := models.NewEvent(models.EventProps{
+		Name:        &row.Name,
+		Location:    &row.Location,
+		Date:        &date,
+		Description: &row.Description,
+		OrganizerID: &organizerID,
+		Category:    &row.Category,
+		Limit:       &row.Limit,
+	})
+	if err != nil {
+		return nil, err.Error()
+	}
+	return event, ""
+}
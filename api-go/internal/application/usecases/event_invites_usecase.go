@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type listEventInvitesUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewListEventInvitesUseCase(uow repositories.UnitOfWork) *listEventInvitesUseCase {
+	return &listEventInvitesUseCase{uow: uow}
+}
+
+// Execute lists the invites of the event still waiting for an account,
+// oldest first.
+func (uc *listEventInvitesUseCase) Execute(props dtos.ListEventInvitesDto) ([]dtos.EventInviteDto, error) {
+	var invites []models.EventInvite
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		event, err := repos.Events().FindByID(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+		if event.OrganizerID() != props.OrganizerID {
+			return exceptions.NewForbiddenException("User is not authorized to manage the attendees of this event")
+		}
+
+		invites, err = repos.EventInvites().FindPendingByEvent(ctx, event.ID())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.EventInviteDto, 0, len(invites))
+	for _, invite := range invites {
+		result = append(result, dtos.EventInviteDto{
+			ID:        invite.GetID(),
+			Email:     invite.GetEmail(),
+			OverLimit: invite.IsOverLimit(),
+			CreatedAt: invite.GetCreatedAt(),
+		})
+	}
+	return result, nil
+}
+
+type cancelEventInviteUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewCancelEventInviteUseCase(uow repositories.UnitOfWork) *cancelEventInviteUseCase {
+	return &cancelEventInviteUseCase{uow: uow}
+}
+
+// Execute reports invites of other events, and accepted ones, as not found.
+func (uc *cancelEventInviteUseCase) Execute(props dtos.CancelEventInviteDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		event, err := findOrganizedEventForUpdate(ctx, repos.Events(), props.EventID, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+
+		invite, err := repos.EventInvites().FindByID(ctx, props.InviteID)
+		if err != nil {
+			return err
+		}
+		if invite.GetEventID() != event.ID() || !invite.IsPending() {
+			return exceptions.NewNotFoundException("Invite not found")
+		}
+		if err := repos.EventInvites().Delete(ctx, invite.GetID()); err != nil {
+			return err
+		}
+		return auditAttendees(ctx, repos.AuditLog(), models.AuditActionInviteCancelled, props.OrganizerID, event.ID(), props.IP,
+			map[string]string{"invite_id": invite.GetID(), "email": invite.GetEmail()}, nil, nil)
+	})
+	return struct{}{}, err
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+// attendeeExportBatch is the number of attendees loaded at a time while an
+// export is streamed.
+const attendeeExportBatch = 500
+
+type exportAttendeesUseCase struct {
+	uow   repositories.UnitOfWork
+	users repositories.UserRepository
+}
+
+func NewExportAttendeesUseCase(uow repositories.UnitOfWork, users repositories.UserRepository) *exportAttendeesUseCase {
+	return &exportAttendeesUseCase{uow: uow, users: users}
+}
+
+// Execute checks that the caller organizes the event and records the export
+// before any attendee is read, so that a refused export writes nothing.
+func (uc *exportAttendeesUseCase) Execute(props dtos.ExportAttendeesDto) (*dtos.AttendeeExportDto, error) {
+	var event models.Event
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		event, err = repos.Events().FindByID(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+		if event.OrganizerID() != props.OrganizerID {
+			return exceptions.NewForbiddenException("User is not authorized to export the attendees of this event")
+		}
+
+		action := models.AuditActionAttendeesExported
+		targetType := models.AuditTargetEvent
+		return appendAudit(ctx, repos.AuditLog(), models.AuditEntryProps{
+			ActorID:    &props.OrganizerID,
+			Action:     &action,
+			TargetType: &targetType,
+			TargetID:   &props.EventID,
+			IP:         &props.IP,
+			Details: map[string]string{
+				"format":          props.Format,
+				"attendees_count": strconv.Itoa(len(event.Attendees())),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.AttendeeExportDto{
+		EventID:        event.ID(),
+		EventName:      event.Name(),
+		AttendeesCount: len(event.Attendees()),
+		Questions:      event.Questions(),
+		Rows:           uc.rows(props.Ctx, event.Attendees(), event.Registrations()),
+	}, nil
+}
+
+// rows yields the attendees in registration order, skipping the accounts
+// that no longer exist.
+func (uc *exportAttendeesUseCase) rows(ctx context.Context, attendeeIDs []string, registrations map[string]models.AttendeeRegistration) iter.Seq2[dtos.AttendeeRowDto, error] {
+	return func(yield func(dtos.AttendeeRowDto, error) bool) {
+		for start := 0; start < len(attendeeIDs); start += attendeeExportBatch {
+			batch := attendeeIDs[start:min(start+attendeeExportBatch, len(attendeeIDs))]
+			users, err := uc.users.FindByIDs(ctx, batch)
+			if err != nil {
+				yield(dtos.AttendeeRowDto{}, err)
+				return
+			}
+
+			byID := make(map[string]models.User, len(users))
+			for _, user := range users {
+				byID[user.GetID()] = user
+			}
+			for _, id := range batch {
+				user, ok := byID[id]
+				if !ok {
+					continue
+				}
+				registration := registrations[id]
+				row := dtos.AttendeeRowDto{
+					Name:         user.GetName(),
+					Email:        user.GetEmail(),
+					RegisteredAt: registration.RegisteredAt,
+					TicketType:   registration.TicketType,
+					Answers:      registration.Answers,
+					CheckedInAt:  registration.CheckedInAt,
+				}
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
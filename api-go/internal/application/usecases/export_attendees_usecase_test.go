@@ -0,0 +1,194 @@
+package usecases_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+func TestExportAttendees(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+
+	// Enough attendees to span several batches, plus an account that no
+	// longer exists.
+	var attendeeIDs []string
+	for i := range 1201 {
+		name := fmt.Sprintf("Attendee %d", i)
+		email := fmt.Sprintf("attendee%d@example.com", i)
+		userType := "participant"
+		user := models.NewUser(models.UserProps{Name: &name, Email: &email, UserType: &userType})
+		if err := f.users.Create(f.ctx, user); err != nil {
+			t.Fatalf("creating user: %v", err)
+		}
+		attendeeIDs = append(attendeeIDs, user.GetID())
+	}
+	attendeeIDs = append(attendeeIDs[:600], append([]string{"missing-user"}, attendeeIDs[600:]...)...)
+	event := f.addEvent(t, organizer.GetID(), 0, attendeeIDs...)
+
+	export, err := usecases.NewExportAttendeesUseCase(f.uow, f.users).Execute(dtos.ExportAttendeesDto{
+		Ctx: f.ctx, EventID: event.ID(), OrganizerID: organizer.GetID(), Format: dtos.AttendeeExportCSV, IP: "10.0.0.1",
+	})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	var rows []dtos.AttendeeRowDto
+	for row, err := range export.Rows {
+		if err != nil {
+			t.Fatalf("reading rows: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1201 {
+		t.Fatalf("expected 1201 rows, got %d", len(rows))
+	}
+	for _, i := range []int{0, 599, 600, 1200} {
+		if want := fmt.Sprintf("attendee%d@example.com", i); rows[i].Email != want {
+			t.Fatalf("expected row %d to be %s, got %s", i, want, rows[i].Email)
+		}
+	}
+
+	entries := f.stores.AuditLog.All()
+	entry := entries[len(entries)-1]
+	if entry.GetAction() != models.AuditActionAttendeesExported || entry.GetDetails()["format"] != "csv" {
+		t.Fatalf("expected the export to be audited, got %s %v", entry.GetAction(), entry.GetDetails())
+	}
+}
+
+func TestExportAttendeesRegistrationDetails(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	earlier := f.addUser(t, "earlier@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+
+	// earlier stands for a registration made before they were recorded.
+	name, location, category, limit := "Go Meetup", "Curitiba", "tech", 0
+	date := time.Now().Add(7 * 24 * time.Hour)
+	organizerID := organizer.GetID()
+	event, err := models.NewEvent(models.EventProps{
+		Name: &name, Location: &location, Date: &date, OrganizerID: &organizerID, Category: &category, Limit: &limit,
+		Attendees: []string{earlier.GetID()}, TicketTypes: []string{"Standard", "VIP"}, Questions: []string{"T-shirt size", "Dietary needs"},
+	})
+	if err != nil {
+		t.Fatalf("creating event: %v", err)
+	}
+	if err := f.events.Save(f.ctx, event); err != nil {
+		t.Fatalf("saving event: %v", err)
+	}
+
+	register := usecases.NewRegisterToEventUseCase(f.uow, usecases.EmailVerificationPolicy{})
+	refused := []struct {
+		name       string
+		ticketType string
+		answers    map[string]string
+		wantErr    string
+	}{
+		{name: "unknown ticket type", ticketType: "Backstage", answers: map[string]string{"T-shirt size": "M", "Dietary needs": "None"}, wantErr: "Invalid ticket type"},
+		{name: "unanswered question", ticketType: "VIP", answers: map[string]string{"T-shirt size": "M"}, wantErr: `Question "Dietary needs" must be answered`},
+		{name: "unknown question", ticketType: "VIP", answers: map[string]string{"T-shirt size": "M", "Dietary needs": "None", "Age": "30"}, wantErr: `Unknown question "Age"`},
+	}
+	for _, tt := range refused {
+		_, err := register.Execute(usecases.RegisterToEventUseCaseProps{Ctx: f.ctx, UserId: attendee.GetID(), EventId: event.ID(), TicketType: tt.ticketType, Answers: tt.answers})
+		if err == nil || err.Error() != tt.wantErr {
+			t.Fatalf("%s: expected error %q, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+
+	before := time.Now()
+	if _, err := register.Execute(usecases.RegisterToEventUseCaseProps{
+		Ctx: f.ctx, UserId: attendee.GetID(), EventId: event.ID(), TicketType: "VIP",
+		Answers: map[string]string{"T-shirt size": "M", "Dietary needs": "Vegan"},
+	}); err != nil {
+		t.Fatalf("registering: %v", err)
+	}
+
+	checkIn := usecases.NewCheckInAttendeeUseCase(f.uow)
+	var forbidden *exceptions.ForbiddenException
+	if _, err := checkIn.Execute(dtos.CheckInAttendeeDto{Ctx: f.ctx, EventID: event.ID(), OrganizerID: attendee.GetID(), UserID: attendee.GetID()}); !errors.As(err, &forbidden) {
+		t.Fatalf("expected attendees not to check themselves in, got %v", err)
+	}
+	checkInProps := dtos.CheckInAttendeeDto{Ctx: f.ctx, EventID: event.ID(), OrganizerID: organizer.GetID(), UserID: attendee.GetID(), IP: "10.0.0.1"}
+	if _, err := checkIn.Execute(checkInProps); err != nil {
+		t.Fatalf("check-in: %v", err)
+	}
+	var conflict *exceptions.ConflictException
+	if _, err := checkIn.Execute(checkInProps); !errors.As(err, &conflict) {
+		t.Fatalf("expected a second check-in to conflict, got %v", err)
+	}
+	if got := f.auditActions(); !containsAll(got, models.AuditActionAttendeeCheckedIn) {
+		t.Fatalf("expected the check-in to be audited, got %v", got)
+	}
+
+	export, err := usecases.NewExportAttendeesUseCase(f.uow, f.users).Execute(dtos.ExportAttendeesDto{
+		Ctx: f.ctx, EventID: event.ID(), OrganizerID: organizer.GetID(), Format: dtos.AttendeeExportCSV,
+	})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if len(export.Questions) != 2 || export.Questions[0] != "T-shirt size" {
+		t.Fatalf("expected the questions of the event, got %v", export.Questions)
+	}
+
+	var rows []dtos.AttendeeRowDto
+	for row, err := range export.Rows {
+		if err != nil {
+			t.Fatalf("reading rows: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if old := rows[0]; !old.RegisteredAt.IsZero() || old.TicketType != "" || old.Answers != nil || old.CheckedInAt != nil {
+		t.Fatalf("expected no details for %s, got %+v", old.Email, old)
+	}
+	row := rows[1]
+	if row.RegisteredAt.Before(before) || row.RegisteredAt.After(time.Now()) {
+		t.Fatalf("expected %s to be registered during the test, got %v", row.Email, row.RegisteredAt)
+	}
+	if row.TicketType != "VIP" || row.Answers["Dietary needs"] != "Vegan" || row.CheckedInAt == nil {
+		t.Fatalf("expected the ticket type, answers and check-in of %s, got %+v", row.Email, row)
+	}
+}
+
+func TestExportAttendeesRequiresOrganizer(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10, attendee.GetID())
+
+	var (
+		forbidden *exceptions.ForbiddenException
+		notFound  *exceptions.NotFoundException
+	)
+	tests := []struct {
+		name    string
+		eventID string
+		caller  string
+		target  any
+	}{
+		{name: "attendee", eventID: event.ID(), caller: attendee.GetID(), target: &forbidden},
+		{name: "unknown event", eventID: "missing-event", caller: organizer.GetID(), target: &notFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := usecases.NewExportAttendeesUseCase(f.uow, f.users).Execute(dtos.ExportAttendeesDto{
+				Ctx: f.ctx, EventID: tt.eventID, OrganizerID: tt.caller, Format: dtos.AttendeeExportCSV,
+			})
+			if !errors.As(err, tt.target) {
+				t.Fatalf("expected %T, got %v", tt.target, err)
+			}
+		})
+	}
+
+	if got := f.auditActions(); containsAll(got, models.AuditActionAttendeesExported) {
+		t.Fatalf("expected refused exports not to be audited, got %v", got)
+	}
+}
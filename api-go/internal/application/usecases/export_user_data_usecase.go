@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type exportUserDataUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewExportUserDataUseCase(uow repositories.UnitOfWork) *exportUserDataUseCase {
+	return &exportUserDataUseCase{uow: uow}
+}
+
+type ExportUserDataProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+	IP     string
+}
+
+func (uc *exportUserDataUseCase) Execute(props ExportUserDataProps) (*dtos.UserDataExportDto, error) {
+	var export *dtos.UserDataExportDto
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		registrations, err := orNoEvents(repos.Events().FindByAttendee(ctx, user.GetID()))
+		if err != nil {
+			return err
+		}
+		organized, err := orNoEvents(repos.Events().FindByOrganizerID(ctx, user.GetID()))
+		if err != nil {
+			return err
+		}
+
+		export = &dtos.UserDataExportDto{
+			ExportedAt:      time.Now(),
+			Profile:         *profileToDto(user),
+			Registrations:   exportEvents(registrations),
+			OrganizedEvents: exportEvents(organized),
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionDataExported, &props.UserID, props.UserID, props.IP, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// orNoEvents turns the NotFoundException the event queries raise when
+// nothing matches into an empty result.
+func orNoEvents(events []models.Event, err error) ([]models.Event, error) {
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	return events, err
+}
+
+func exportEvents(events []models.Event) []dtos.ExportedEventDto {
+	exported := make([]dtos.ExportedEventDto, 0, len(events))
+	for _, event := range events {
+		exported = append(exported, dtos.ExportedEventDto{
+			ID:             event.ID(),
+			Name:           event.Name(),
+			Location:       event.Location(),
+			Date:           event.Date(),
+			Description:    event.Description(),
+			Category:       event.Category(),
+			Limit:          event.Limit(),
+			AttendeesCount: len(event.Attendees()),
+			CreatedAt:      event.CreatedAt(),
+		})
+	}
+	return exported
+}
@@ -0,0 +1,144 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+)
+
+type fixture struct {
+	ctx    context.Context
+	events *memory.EventRepository
+	users  *memory.UserRepository
+	auths  *memory.AuthRepository
+	tokens *memory.UserTokenRepository
+	stores memory.Stores
+	uow    *memory.UnitOfWork
+	jwt    *memory.JWTService
+	hasher *memory.PasswordHasher
+	policy usecases.PasswordPolicy
+	mailer *memory.Mailer
+	guard  *usecases.LoginGuard
+}
+
+// lenientThrottle never slows down or locks the logins of ordinary tests.
+var lenientThrottle = usecases.LoginThrottlePolicy{
+	FreeAttempts:   1000,
+	IPFreeAttempts: 1000,
+	BaseDelay:      time.Second,
+	MaxDelay:       time.Second,
+	LockThreshold:  1000,
+	LockDuration:   time.Minute,
+	ResetAfter:     time.Minute,
+}
+
+// testPasswordPolicy refuses a known breached password and, as with bcrypt,
+// passwords past 72 bytes.
+var testPasswordPolicy = usecases.PasswordPolicy{
+	MinLength: 8,
+	MaxBytes:  72,
+	Breached:  map[string]struct{}{"password1": {}},
+}
+
+func newFixture() *fixture {
+	stores := memory.NewStores()
+	uow := memory.NewUnitOfWork(stores)
+	mailer := memory.NewMailer()
+
+	return &fixture{
+		ctx:    context.Background(),
+		events: stores.Events,
+		users:  stores.Users,
+		auths:  stores.Auths,
+		tokens: stores.UserTokens,
+		stores: stores,
+		uow:    uow,
+		jwt:    memory.NewJWTService(),
+		hasher: memory.NewPasswordHasher(),
+		policy: testPasswordPolicy,
+		mailer: mailer,
+		guard:  usecases.NewLoginGuard(uow, lenientThrottle, mailer, "https://api.example.com", time.Hour),
+	}
+}
+
+func (f *fixture) addUser(t *testing.T, email, password string) models.User {
+	t.Helper()
+
+	name := "User " + email
+	userType := "participant"
+	user := models.NewUser(models.UserProps{
+		Name:     &name,
+		Email:    &email,
+		UserType: &userType,
+	})
+	if err := f.users.Create(f.ctx, user); err != nil {
+		t.Fatalf("creating user: %v", err)
+	}
+	hash, algorithm, err := f.hasher.Hash(password)
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+	userID := user.GetID()
+	auth, err := models.NewAuth(models.AuthProps{UserID: &userID, PasswordHash: &hash, Algorithm: &algorithm})
+	if err != nil {
+		t.Fatalf("creating credentials: %v", err)
+	}
+	if err := f.auths.Create(f.ctx, auth); err != nil {
+		t.Fatalf("storing credentials: %v", err)
+	}
+
+	return user
+}
+
+// auth returns the stored credentials of the user.
+func (f *fixture) auth(t *testing.T, userID string) models.Auth {
+	t.Helper()
+
+	auth, err := f.auths.FindByUserID(f.ctx, userID)
+	if err != nil {
+		t.Fatalf("finding credentials: %v", err)
+	}
+	return auth
+}
+
+func (f *fixture) addEvent(t *testing.T, organizerID string, limit int, attendees ...string) models.Event {
+	t.Helper()
+
+	name := "Go Meetup"
+	location := "Curitiba"
+	description := "Monthly meetup"
+	category := "tech"
+	date := time.Now().Add(7 * 24 * time.Hour)
+	event, err := models.NewEvent(models.EventProps{
+		Name:        &name,
+		Location:    &location,
+		Date:        &date,
+		Description: &description,
+		OrganizerID: &organizerID,
+		Category:    &category,
+		Limit:       &limit,
+		Attendees:   attendees,
+	})
+	if err != nil {
+		t.Fatalf("creating event: %v", err)
+	}
+	if err := f.events.Save(f.ctx, event); err != nil {
+		t.Fatalf("saving event: %v", err)
+	}
+
+	return event
+}
+
+func (f *fixture) attendees(t *testing.T, eventID string) []string {
+	t.Helper()
+
+	event, err := f.events.FindByID(f.ctx, eventID)
+	if err != nil {
+		t.Fatalf("finding event: %v", err)
+	}
+	return event.Attendees()
+}
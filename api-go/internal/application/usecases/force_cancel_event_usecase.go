@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type forceCancelEventUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewForceCancelEventUseCase(uow repositories.UnitOfWork) *forceCancelEventUseCase {
+	return &forceCancelEventUseCase{uow: uow}
+}
+
+// Execute deletes the event whoever organizes it, as its organizer would.
+func (uc *forceCancelEventUseCase) Execute(props dtos.ModerateEventDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		event, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+		if err := repos.Events().Delete(ctx, event.ID()); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), models.AuditActionEventCancelled, props.AdminID, models.AuditTargetEvent, event.ID(), props.IP, props.Reason, nil, eventAuditFields(event), nil)
+	})
+	return struct{}{}, err
+}
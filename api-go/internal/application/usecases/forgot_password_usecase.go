@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type forgotPasswordUseCase struct {
+	uow         repositories.UnitOfWork
+	mailer      services.IMailer
+	frontendURL string
+	ttl         time.Duration
+}
+
+func NewForgotPasswordUseCase(uow repositories.UnitOfWork, mailer services.IMailer, frontendURL string, ttl time.Duration) *forgotPasswordUseCase {
+	return &forgotPasswordUseCase{uow: uow, mailer: mailer, frontendURL: frontendURL, ttl: ttl}
+}
+
+// Execute succeeds for unknown emails too, so the response never reveals
+// whether an account exists.
+func (uc *forgotPasswordUseCase) Execute(props dtos.ForgotPasswordDto) (struct{}, error) {
+	var (
+		user     models.User
+		rawToken string
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		user, err = repos.Users().FindByEmail(ctx, props.Email)
+		if err != nil {
+			return err
+		}
+
+		// Só o link mais recente continua válido
+		rawToken, err = issueUserToken(ctx, repos.UserTokens(), user.GetID(), models.TokenPurposePasswordReset, uc.ttl)
+		return err
+	})
+
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return struct{}{}, nil
+	}
+	if err != nil {
+		return struct{}{}, err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", uc.frontendURL, url.QueryEscape(rawToken))
+	return struct{}{}, uc.mailer.Send(props.Ctx, services.Mail{
+		To:      user.GetEmail(),
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf("Olá, %s!\n\nRecebemos um pedido para redefinir a sua senha. Use o link abaixo em até %s:\n\n%s\n\nSe você não fez este pedido, ignore este e-mail.",
+			user.GetName(), uc.ttl, link),
+	})
+}
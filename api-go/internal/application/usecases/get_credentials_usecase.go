@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type getCredentialsUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewGetCredentialsUseCase(uow repositories.UnitOfWork) *getCredentialsUseCase {
+	return &getCredentialsUseCase{uow: uow}
+}
+
+type GetCredentialsProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+// Execute describes how the user can sign in: password, second factor and
+// linked identity providers.
+func (uc *getCredentialsUseCase) Execute(props GetCredentialsProps) (*dtos.CredentialsDto, error) {
+	var credentials *dtos.CredentialsDto
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		auth, err := repos.Auths().FindByUserID(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		credentials = &dtos.CredentialsDto{
+			HasPassword:       auth.HasPassword(),
+			PasswordChangedAt: auth.GetPasswordChangedAt(),
+			Identities:        []dtos.LinkedIdentityDto{},
+		}
+
+		twoFactor, err := repos.TwoFactors().FindByUserID(ctx, props.UserID)
+		var notFound *exceptions.NotFoundException
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+		credentials.TwoFactorEnabled = err == nil && twoFactor.IsEnabled()
+
+		identities, err := repos.ExternalIdentities().FindByUserID(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		for _, identity := range identities {
+			credentials.Identities = append(credentials.Identities, dtos.LinkedIdentityDto{
+				Provider: identity.GetProvider(),
+				Email:    identity.GetEmail(),
+				LinkedAt: identity.GetCreatedAt(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
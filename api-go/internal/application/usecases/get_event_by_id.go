@@ -1,9 +1,13 @@
 package usecases
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -21,15 +25,20 @@ func NewGetEventByIdUseCase(eventRepo repositories.IEventRepository, userRepo re
 }
 
 type GetEventByIdUseCaseProps struct {
+	Ctx     context.Context `json:"-"`
 	EventID string
 	UserID  string
 }
 
 func (uc *getEventByIdUseCase) Execute(props GetEventByIdUseCaseProps) (dtos.EventWithAttendeesDto, error) {
-	event, err := uc.eventRepo.FindByID(props.EventID)
+	event, err := uc.eventRepo.FindByID(props.Ctx, props.EventID)
 	if err != nil {
 		return dtos.EventWithAttendeesDto{}, err
 	}
+	// Unpublished events stay visible to their organizer and attendees only.
+	if !event.IsPublished() && event.OrganizerID() != props.UserID && !slices.Contains(event.Attendees(), props.UserID) {
+		return dtos.EventWithAttendeesDto{}, exceptions.NewNotFoundException(fmt.Sprintf("event with ID %s not found", props.EventID))
+	}
 
 	eventDto := dtos.EventWithAttendeesDto{
 		ID:             event.ID(),
@@ -42,6 +51,9 @@ func (uc *getEventByIdUseCase) Execute(props GetEventByIdUseCaseProps) (dtos.Eve
 		CreatedAt:      event.CreatedAt(),
 		Category:       event.Category(),
 		Limit:          event.Limit(),
+		Published:      event.IsPublished(),
+		TicketTypes:    event.TicketTypes(),
+		Questions:      event.Questions(),
 	}
 
 	// Só retornar dados detalhados dos participantes se for o organizador
@@ -57,7 +69,7 @@ func (uc *getEventByIdUseCase) Execute(props GetEventByIdUseCaseProps) (dtos.Eve
 		go func(attendeeId string) {
 			defer wg.Done()
 
-			user, err := uc.userRepo.FindById(attendeeId)
+			user, err := uc.userRepo.FindById(props.Ctx, attendeeId)
 			if err != nil {
 				usersChan <- nil
 				return
@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"context"
 	"sync"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
@@ -21,12 +22,13 @@ func NewGetEventByOrganizerUseCase(eventRepo repositories.IEventRepository, user
 }
 
 type GetEventByOrganizerUseCaseProps struct {
+	Ctx         context.Context `json:"-"`
 	OrganizerId string
 	EventId     string
 }
 
 func (uc *getEventByOrganizerUseCase) Execute(props GetEventByOrganizerUseCaseProps) (dtos.EventWithAttendeesDto, error) {
-	event, err := uc.eventRepo.FindEventByOrganizerID(props.EventId, props.OrganizerId)
+	event, err := uc.eventRepo.FindEventByOrganizerID(props.Ctx, props.EventId, props.OrganizerId)
 	if err != nil {
 		return dtos.EventWithAttendeesDto{}, err
 	}
@@ -39,7 +41,7 @@ func (uc *getEventByOrganizerUseCase) Execute(props GetEventByOrganizerUseCasePr
 		go func(attendeeId string) {
 			defer wg.Done()
 
-			user, err := uc.userRepo.FindById(attendeeId)
+			user, err := uc.userRepo.FindById(props.Ctx, attendeeId)
 			if err != nil {
 				usersChan <- nil
 				return
@@ -77,6 +79,8 @@ func (uc *getEventByOrganizerUseCase) Execute(props GetEventByOrganizerUseCasePr
 		CreatedAt: event.CreatedAt(),
 		Category: 	 event.Category(),
 		Limit:       event.Limit(),
+		TicketTypes: event.TicketTypes(),
+		Questions:   event.Questions(),
 	}
 	
 	return eventDto, nil
@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type getEventImportUseCase struct {
+	imports repositories.EventImportRepository
+}
+
+func NewGetEventImportUseCase(imports repositories.EventImportRepository) *getEventImportUseCase {
+	return &getEventImportUseCase{imports: imports}
+}
+
+// Execute reports other organizers' imports as not found.
+func (uc *getEventImportUseCase) Execute(props dtos.GetEventImportDto) (*dtos.EventImportDto, error) {
+	eventImport, err := uc.imports.FindByID(props.Ctx, props.ImportID)
+	if err != nil {
+		return nil, err
+	}
+	if eventImport.GetOrganizerID() != props.OrganizerID {
+		return nil, exceptions.NewNotFoundException("Import not found")
+	}
+	return eventImportToDto(eventImport), nil
+}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -15,8 +17,13 @@ func NewGetEventsByCategoryUseCase(eventRepo repositories.IEventRepository) *get
     }
 }
 
-func (uc *getEventsByCategoryUseCase) Execute(category string) ([]dtos.EventDto, error) {
-    events, err := uc.eventRepo.FindByCategory(category)
+type GetEventsByCategoryProps struct {
+    Ctx      context.Context `json:"-"`
+    Category string
+}
+
+func (uc *getEventsByCategoryUseCase) Execute(props GetEventsByCategoryProps) ([]dtos.EventDto, error) {
+    events, err := uc.eventRepo.FindByCategory(props.Ctx, props.Category)
     if err != nil {
         return nil, err
     }
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -15,8 +17,13 @@ func NewGetEventsByOrganizerUseCase(eventRepo repositories.IEventRepository) *Ge
 	}
 }
 
-func (uc *GetEventsByOrganizerUseCase) Execute(organizerId string) ([]dtos.EventDto, error) {
-	events, err := uc.eventRepo.FindByOrganizerID(organizerId)
+type GetEventsByOrganizerProps struct {
+	Ctx         context.Context `json:"-"`
+	OrganizerID string
+}
+
+func (uc *GetEventsByOrganizerUseCase) Execute(props GetEventsByOrganizerProps) ([]dtos.EventDto, error) {
+	events, err := uc.eventRepo.FindByOrganizerID(props.Ctx, props.OrganizerID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -15,8 +17,13 @@ func NewGetEventsByTermUseCase(eventRepo repositories.IEventRepository) *getEven
     }
 }
 
-func (uc *getEventsByTermUseCase) Execute(term string) ([]dtos.EventDto, error) {
-    events, err := uc.eventRepo.FindByTerm(term)
+type GetEventsByTermProps struct {
+    Ctx  context.Context `json:"-"`
+    Term string
+}
+
+func (uc *getEventsByTermUseCase) Execute(props GetEventsByTermProps) ([]dtos.EventDto, error) {
+    events, err := uc.eventRepo.FindByTerm(props.Ctx, props.Term)
     if err != nil {
         return nil, err
     }
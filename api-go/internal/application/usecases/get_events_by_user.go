@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -17,13 +19,18 @@ func NewGetEventsByUserUseCase(userRepo repositories.UserRepository, eventRepo r
 	}
 }
 
-func (uc *getEventsByUserUseCase) Execute(userId string) ([]dtos.EventDto, error) {
-	user, err := uc.userRepo.FindById(userId)
+type GetEventsByUserProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+func (uc *getEventsByUserUseCase) Execute(props GetEventsByUserProps) ([]dtos.EventDto, error) {
+	user, err := uc.userRepo.FindById(props.Ctx, props.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	events, err := uc.eventRepo.FindByAttendee(user.GetID())
+	events, err := uc.eventRepo.FindByAttendee(props.Ctx, user.GetID())
 	if err != nil {
 		return nil, err
 	}
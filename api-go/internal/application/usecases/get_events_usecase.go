@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
@@ -17,8 +18,12 @@ func NewGetEventsUseCase(eventRepository repositories.IEventRepository) *getEven
 	}
 }
 
-func (uc *getEventsUseCase) Execute() ([]dtos.EventDto, error) {
-	events, err := uc.eventRepository.FindAll()
+type GetEventsProps struct {
+	Ctx context.Context `json:"-"`
+}
+
+func (uc *getEventsUseCase) Execute(props GetEventsProps) ([]dtos.EventDto, error) {
+	events, err := uc.eventRepository.FindAll(props.Ctx)
 	if err != nil {
 		fmt.Println("GetEventsUseCase: Retrieved events:", err)
 		return nil, err
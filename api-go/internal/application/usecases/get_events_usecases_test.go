@@ -0,0 +1,108 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+func TestEventListingUseCases(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	f.addEvent(t, organizer.GetID(), 10, attendee.GetID())
+	f.addEvent(t, organizer.GetID(), 10)
+
+	tests := []struct {
+		name    string
+		run     func() (int, error)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "all events",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsUseCase(f.events).Execute(usecases.GetEventsProps{Ctx: f.ctx})
+				return len(events), err
+			},
+			want: 2,
+		},
+		{
+			name: "by organizer",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsByOrganizerUseCase(f.events).Execute(usecases.GetEventsByOrganizerProps{Ctx: f.ctx, OrganizerID: organizer.GetID()})
+				return len(events), err
+			},
+			want: 2,
+		},
+		{
+			name: "by attendee",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsByUserUseCase(f.users, f.events).Execute(usecases.GetEventsByUserProps{Ctx: f.ctx, UserID: attendee.GetID()})
+				return len(events), err
+			},
+			want: 1,
+		},
+		{
+			name: "by category",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsByCategoryUseCase(f.events).Execute(usecases.GetEventsByCategoryProps{Ctx: f.ctx, Category: "tech"})
+				return len(events), err
+			},
+			want: 2,
+		},
+		{
+			name: "unknown category",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsByCategoryUseCase(f.events).Execute(usecases.GetEventsByCategoryProps{Ctx: f.ctx, Category: "music"})
+				return len(events), err
+			},
+			wantErr: true,
+		},
+		{
+			name: "by term",
+			run: func() (int, error) {
+				events, err := usecases.NewGetEventsByTermUseCase(f.events).Execute(usecases.GetEventsByTermProps{Ctx: f.ctx, Term: "Meetup"})
+				return len(events), err
+			},
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.run()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Fatalf("expected %d events, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUserQueryUseCases(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	f.addUser(t, "other@example.com", "secret123")
+
+	users, err := usecases.NewGetUsersUseCase(f.users).Execute(usecases.GetUsersProps{Ctx: f.ctx})
+	if err != nil || len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d (%v)", len(users), err)
+	}
+
+	found, err := usecases.NewGetUserUseCase(f.users).Execute(usecases.GetUserProps{Ctx: f.ctx, ID: user.GetID()})
+	if err != nil || found.Email != user.GetEmail() {
+		t.Fatalf("expected %s, got %+v (%v)", user.GetEmail(), found, err)
+	}
+
+	if _, err := usecases.NewGetUserUseCase(f.users).Execute(usecases.GetUserProps{Ctx: f.ctx, ID: "missing"}); err == nil {
+		t.Fatalf("expected an error for an unknown user")
+	}
+
+	credentials, err := usecases.NewGetCredentialsUseCase(f.uow).Execute(usecases.GetCredentialsProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil || !credentials.HasPassword || credentials.TwoFactorEnabled || len(credentials.Identities) != 0 {
+		t.Fatalf("expected a password only, got %+v (%v)", credentials, err)
+	}
+}
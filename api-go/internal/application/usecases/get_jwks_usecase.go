@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type getJWKSUseCase struct {
+	jwtService services.IJWTService
+}
+
+func NewGetJWKSUseCase(jwtService services.IJWTService) *getJWKSUseCase {
+	return &getJWKSUseCase{jwtService: jwtService}
+}
+
+type GetJWKSProps struct {
+	Ctx context.Context `json:"-"`
+}
+
+// Execute returns the public keys access tokens are currently verified with.
+func (uc *getJWKSUseCase) Execute(props GetJWKSProps) (services.JSONWebKeySet, error) {
+	return uc.jwtService.PublicKeys(), nil
+}
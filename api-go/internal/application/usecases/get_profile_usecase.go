@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type getProfileUseCase struct {
+	repo repositories.UserRepository
+}
+
+func NewGetProfileUseCase(repo repositories.UserRepository) *getProfileUseCase {
+	return &getProfileUseCase{repo: repo}
+}
+
+type GetProfileProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+func (uc *getProfileUseCase) Execute(props GetProfileProps) (*dtos.ProfileDto, error) {
+	user, err := uc.repo.FindById(props.Ctx, props.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profileToDto(user), nil
+}
+
+func profileToDto(user models.User) *dtos.ProfileDto {
+	profile := user.GetProfile()
+	return &dtos.ProfileDto{
+		UserResponseDTO: dtos.UserResponseDTO{
+			ID:        user.GetID(),
+			Name:      user.GetName(),
+			Email:     user.GetEmail(),
+			UserType:  user.GetUserType(),
+			CreatedAt: user.GetCreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		},
+		EmailVerified: user.IsEmailVerified(),
+		PendingEmail:  user.GetPendingEmail(),
+		AvatarURL:     profile.AvatarURL,
+		Bio:           profile.Bio,
+		Phone:         profile.Phone,
+		Company:       profile.Company,
+	}
+}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -13,8 +15,13 @@ func NewGetUserUseCase(repo repositories.UserRepository) *getUserUseCase {
 	return &getUserUseCase{repo: repo}
 }
 
-func (uc *getUserUseCase) Execute(id string) (dtos.UserResponseDTO, error) {
-	user, err := uc.repo.FindById(id)
+type GetUserProps struct {
+	Ctx context.Context `json:"-"`
+	ID  string
+}
+
+func (uc *getUserUseCase) Execute(props GetUserProps) (dtos.UserResponseDTO, error) {
+	user, err := uc.repo.FindById(props.Ctx, props.ID)
 	if err != nil {
 		return dtos.UserResponseDTO{}, err
 	}
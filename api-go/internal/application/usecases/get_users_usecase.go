@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
@@ -13,13 +15,20 @@ func NewGetUsersUseCase(repo repositories.UserRepository) *getUsersUseCase {
 	return &getUsersUseCase{repo: repo}
 }
 
-func (uc *getUsersUseCase) Execute() ([]dtos.UserResponseDTO, error) {
-	users, err := uc.repo.FindAll()
+type GetUsersProps struct {
+	Ctx context.Context `json:"-"`
+}
+
+func (uc *getUsersUseCase) Execute(props GetUsersProps) ([]dtos.UserResponseDTO, error) {
+	users, err := uc.repo.FindAll(props.Ctx)
 	if err != nil {
 		return nil, err
 	}
 	var dtosUsers []dtos.UserResponseDTO
 	for _, user := range users {
+		if user.GetDeletedAt() != nil {
+			continue
+		}
 		dtosUsers = append(dtosUsers, dtos.UserResponseDTO{
 			ID:        user.GetID(),
 			Name:      user.GetName(),
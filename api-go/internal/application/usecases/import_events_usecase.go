@@ -0,0 +1,270 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+// eventImportBatch is the number of rows checked, and in best-effort
+// imports saved, between two progress updates.
+const eventImportBatch = 100
+
+// eventImportRetryAfter is when clients are told to try again while every
+// background import slot is taken.
+const eventImportRetryAfter = 30 * time.Second
+
+type importEventsUseCase struct {
+	uow     repositories.UnitOfWork
+	imports repositories.EventImportRepository
+	policy  EmailVerificationPolicy
+	// slots holds a token per asynchronous import running, so that at most
+	// its capacity of files are held in memory at once.
+	slots chan struct{}
+}
+
+// NewImportEventsUseCase runs up to maxAsync asynchronous imports at once
+// and refuses the others until one finishes.
+func NewImportEventsUseCase(uow repositories.UnitOfWork, imports repositories.EventImportRepository, policy EmailVerificationPolicy, maxAsync int) *importEventsUseCase {
+	return &importEventsUseCase{uow: uow, imports: imports, policy: policy, slots: make(chan struct{}, maxAsync)}
+}
+
+// Execute rejects files that cannot be read before creating the import.
+// Asynchronous imports are returned pending and run in the background;
+// the others are returned once finished.
+func (uc *importEventsUseCase) Execute(props dtos.ImportEventsDto) (*dtos.EventImportDto, error) {
+	rows, err := parseEventImport(props.Format, props.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		organizer, err := repos.Users().FindById(ctx, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+		return uc.policy.Check(organizer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if props.Async {
+		return uc.startAsync(props, rows)
+	}
+
+	eventImport, err := uc.create(props)
+	if err != nil {
+		return nil, err
+	}
+	uc.run(props.Ctx, eventImport, rows, props.IP)
+	return eventImportToDto(eventImport), nil
+}
+
+// startAsync returns the import pending and runs it in the background, if
+// a slot is free.
+func (uc *importEventsUseCase) startAsync(props dtos.ImportEventsDto, rows []eventImportRow) (*dtos.EventImportDto, error) {
+	select {
+	case uc.slots <- struct{}{}:
+	default:
+		return nil, exceptions.NewTooManyRequestsException("Too many imports running, try again later", eventImportRetryAfter)
+	}
+
+	eventImport, err := uc.create(props)
+	if err != nil {
+		<-uc.slots
+		return nil, err
+	}
+
+	pending := eventImportToDto(eventImport)
+	// The import outlives the request, but keeps its ID for the audit log.
+	// Only the parsed rows are kept, not the raw file in props.
+	ctx, ip := context.WithoutCancel(props.Ctx), props.IP
+	go func() {
+		defer func() { <-uc.slots }()
+		uc.run(ctx, eventImport, rows, ip)
+	}()
+	return pending, nil
+}
+
+func (uc *importEventsUseCase) create(props dtos.ImportEventsDto) (models.EventImport, error) {
+	eventImport, err := models.NewEventImport(models.EventImportProps{
+		OrganizerID: &props.OrganizerID,
+		Format:      &props.Format,
+		Mode:        &props.Mode,
+		DryRun:      props.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.imports.Create(props.Ctx, eventImport); err != nil {
+		return nil, err
+	}
+	return eventImport, nil
+}
+
+// run records the outcome of the import in eventImport rather than
+// returning it, as nobody waits for asynchronous imports.
+func (uc *importEventsUseCase) run(ctx context.Context, eventImport models.EventImport, rows []eventImportRow, ip string) {
+	if err := uc.processRecovering(ctx, eventImport, rows, ip); err != nil {
+		log.Printf("Event import %s failed: %v", eventImport.GetID(), err)
+		failure := "Import stopped, the rows after the last processed one were not imported"
+		if eventImport.GetMode() == models.EventImportAllOrNothing {
+			failure = "Import stopped, no event was imported"
+		}
+		eventImport.Fail(time.Now(), failure)
+	} else {
+		eventImport.Complete(time.Now())
+	}
+
+	// The outcome is saved even when ctx, the request of a synchronous
+	// import, timed out, so that the import does not stay running.
+	if err := uc.imports.Save(context.WithoutCancel(ctx), eventImport); err != nil {
+		log.Printf("Saving event import %s: %v", eventImport.GetID(), err)
+	}
+}
+
+// processRecovering turns a panic while processing into an error, so that
+// it fails the import instead of taking the API down.
+func (uc *importEventsUseCase) processRecovering(ctx context.Context, eventImport models.EventImport, rows []eventImportRow, ip string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return uc.process(ctx, eventImport, rows, ip)
+}
+
+// process checks the rows in batches. Best-effort imports save the valid
+// events of each batch as it is checked; all-or-nothing imports save them
+// together once every row is known to be valid.
+func (uc *importEventsUseCase) process(ctx context.Context, eventImport models.EventImport, rows []eventImportRow, ip string) error {
+	if err := eventImport.Start(len(rows)); err != nil {
+		return err
+	}
+	if err := uc.imports.Save(ctx, eventImport); err != nil {
+		return err
+	}
+
+	bestEffort := eventImport.GetMode() == models.EventImportBestEffort
+	var pending []models.Event
+	for start := 0; start < len(rows); start += eventImportBatch {
+		batch := rows[start:min(start+eventImportBatch, len(rows))]
+
+		var (
+			events []models.Event
+			errs   []models.EventImportRowError
+		)
+		now := time.Now()
+		for i, row := range batch {
+			problem := row.err
+			var event models.Event
+			if problem == "" {
+				event, problem = newImportedEvent(row.event, eventImport.GetOrganizerID(), now)
+			}
+			if problem != "" {
+				errs = append(errs, models.EventImportRowError{Row: start + i + 1, Message: problem})
+				continue
+			}
+			events = append(events, event)
+		}
+
+		imported := 0
+		switch {
+		case eventImport.IsDryRun():
+		case bestEffort:
+			if err := uc.save(ctx, eventImport.GetOrganizerID(), ip, events); err != nil {
+				return err
+			}
+			imported = len(events)
+		default:
+			pending = append(pending, events...)
+		}
+
+		eventImport.Advance(len(batch), imported, errs)
+		if err := uc.imports.Save(ctx, eventImport); err != nil {
+			return err
+		}
+	}
+
+	if bestEffort || eventImport.IsDryRun() || len(eventImport.GetErrors()) > 0 {
+		return nil
+	}
+	if err := uc.save(ctx, eventImport.GetOrganizerID(), ip, pending); err != nil {
+		return err
+	}
+	eventImport.Advance(0, len(pending), nil)
+	return nil
+}
+
+func (uc *importEventsUseCase) save(ctx context.Context, organizerID, ip string, events []models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return uc.uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		for _, event := range events {
+			if err := repos.Events().Save(ctx, event); err != nil {
+				return err
+			}
+			if err := auditEvent(ctx, repos.AuditLog(), models.AuditActionEventCreated, organizerID, event.ID(), ip, nil, eventAuditFields(event)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func eventImportToDto(eventImport models.EventImport) *dtos.EventImportDto {
+	errs := make([]dtos.EventImportRowErrorDto, 0, len(eventImport.GetErrors()))
+	for _, rowErr := range eventImport.GetErrors() {
+		errs = append(errs, dtos.EventImportRowErrorDto{Row: rowErr.Row, Message: rowErr.Message})
+	}
+
+	return &dtos.EventImportDto{
+		ID:         eventImport.GetID(),
+		Format:     eventImport.GetFormat(),
+		Mode:       eventImport.GetMode(),
+		DryRun:     eventImport.IsDryRun(),
+		Status:     eventImport.GetStatus(),
+		Total:      eventImport.GetTotal(),
+		Processed:  eventImport.GetProcessed(),
+		Imported:   eventImport.GetImported(),
+		Errors:     errs,
+		Failure:    eventImport.GetFailure(),
+		CreatedAt:  eventImport.GetCreatedAt(),
+		FinishedAt: eventImport.GetFinishedAt(),
+	}
+}
+
+// FailInterruptedEventImports fails the imports left pending or running by
+// a previous run of the API, as nothing will resume them. Call it at
+// startup, before any import can begin.
+func FailInterruptedEventImports(ctx context.Context, imports repositories.EventImportRepository) error {
+	unfinished, err := imports.FindUnfinished(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, eventImport := range unfinished {
+		failure := "Import interrupted by a restart of the API, the rows after the last processed one were not imported"
+		if eventImport.GetMode() == models.EventImportAllOrNothing {
+			failure = "Import interrupted by a restart of the API, no event was imported"
+		}
+		eventImport.Fail(now, failure)
+		if err := imports.Save(ctx, eventImport); err != nil {
+			return err
+		}
+	}
+	if len(unfinished) > 0 {
+		log.Printf("Failed %d event imports interrupted by the last shutdown", len(unfinished))
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+)
+
+// eventImportCSV has two valid events, one in the past and one with a
+// malformed limit.
+const eventImportCSV = `name,location,date,timezone,category,limit
+Go Meetup,Curitiba,2030-03-01T19:00,America/Sao_Paulo,tech,50
+Rust Meetup,Online,2030-03-08T19:00,,tech,
+Past Meetup,Online,2020-03-08T19:00,,tech,10
+Odd Meetup,Online,2030-03-15T19:00,,tech,ten
+`
+
+func (f *fixture) eventCount(t *testing.T) int {
+	t.Helper()
+
+	events, err := f.events.FindAll(f.ctx)
+	var notFound *exceptions.NotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		t.Fatalf("listing events: %v", err)
+	}
+	return len(events)
+}
+
+func TestImportEvents(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       string
+		data         string
+		mode         string
+		dryRun       bool
+		wantStatus   string
+		wantImported int
+		wantErrRows  []int
+	}{
+		{name: "all or nothing rejects the file", format: "csv", data: eventImportCSV, mode: models.EventImportAllOrNothing, wantStatus: models.EventImportFailed, wantErrRows: []int{3, 4}},
+		{name: "best effort skips invalid rows", format: "csv", data: eventImportCSV, mode: models.EventImportBestEffort, wantStatus: models.EventImportCompleted, wantImported: 2, wantErrRows: []int{3, 4}},
+		{name: "dry run imports nothing", format: "csv", data: eventImportCSV, mode: models.EventImportBestEffort, dryRun: true, wantStatus: models.EventImportCompleted, wantErrRows: []int{3, 4}},
+		{
+			name:         "valid json",
+			format:       "json",
+			data:         `[{"name": "Go Meetup", "location": "Curitiba", "date": "2030-03-01T19:00", "category": "tech", "limit": 50}, {"name": "Rust Meetup", "location": "Online", "date": "2030-03-08T19:00", "category": "tech"}]`,
+			wantStatus:   models.EventImportCompleted,
+			wantImported: 2,
+		},
+		{
+			name:        "json row errors",
+			format:      "json",
+			data:        `[{"name": "Go Meetup", "location": "Curitiba", "date": "2030-03-01T19:00", "category": "tech", "limit": "50"}, {"name": "", "location": "Online", "date": "2030-03-08", "category": "tech", "venue": "Hall"}, {"location": "Online", "date": "2030-03-08", "category": "tech", "timezone": "EST"}]`,
+			mode:        models.EventImportBestEffort,
+			wantStatus:  models.EventImportCompleted,
+			wantErrRows: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+
+			result, err := usecases.NewImportEventsUseCase(f.uow, memory.NewEventImportRepository(), usecases.EmailVerificationPolicy{}, 1).Execute(dtos.ImportEventsDto{
+				Ctx: f.ctx, OrganizerID: organizer.GetID(), Format: tt.format, Mode: tt.mode, DryRun: tt.dryRun, Data: []byte(tt.data),
+			})
+			if err != nil {
+				t.Fatalf("import: %v", err)
+			}
+
+			if result.Status != tt.wantStatus || result.Imported != tt.wantImported || result.Processed != result.Total {
+				t.Fatalf("expected %s with %d imported, got %s with %d imported and %d/%d processed", tt.wantStatus, tt.wantImported, result.Status, result.Imported, result.Processed, result.Total)
+			}
+			var rows []int
+			for _, rowErr := range result.Errors {
+				rows = append(rows, rowErr.Row)
+			}
+			if fmt.Sprint(rows) != fmt.Sprint(tt.wantErrRows) {
+				t.Fatalf("expected errors on rows %v, got %+v", tt.wantErrRows, result.Errors)
+			}
+			if got := f.eventCount(t); got != tt.wantImported {
+				t.Fatalf("expected %d stored events, got %d", tt.wantImported, got)
+			}
+
+			created := 0
+			for _, action := range f.auditActions() {
+				if action == models.AuditActionEventCreated {
+					created++
+				}
+			}
+			if created != tt.wantImported {
+				t.Fatalf("expected %d audited events, got %d", tt.wantImported, created)
+			}
+		})
+	}
+}
+
+func TestImportEventsRejectsUnreadableFiles(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	imports := memory.NewEventImportRepository()
+
+	tests := []struct {
+		name   string
+		format string
+		data   string
+	}{
+		{name: "unknown column", format: "csv", data: "name,location,date,category,venue\n"},
+		{name: "missing column", format: "csv", data: "name,location,date\nGo,Online,2030-03-01T19:00\n"},
+		{name: "no events", format: "csv", data: "name,location,date,category\n"},
+		{name: "json object", format: "json", data: `{"name": "Go Meetup"}`},
+		{name: "too many events", format: "json", data: "[" + strings.Repeat("{},", 5000) + "{}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := usecases.NewImportEventsUseCase(f.uow, imports, usecases.EmailVerificationPolicy{}, 1).Execute(dtos.ImportEventsDto{
+				Ctx: f.ctx, OrganizerID: organizer.GetID(), Format: tt.format, Data: []byte(tt.data),
+			})
+			var validation *exceptions.ValidationException
+			if !errors.As(err, &validation) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestImportEventsAsync(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	imports := memory.NewEventImportRepository()
+
+	var b strings.Builder
+	b.WriteString("name,location,date,category\n")
+	for i := range 250 {
+		fmt.Fprintf(&b, "Meetup %d,Online,2030-03-01T19:00,tech\n", i)
+	}
+
+	pending, err := usecases.NewImportEventsUseCase(f.uow, imports, usecases.EmailVerificationPolicy{}, 1).Execute(dtos.ImportEventsDto{
+		Ctx: f.ctx, OrganizerID: organizer.GetID(), Format: "csv", Mode: models.EventImportBestEffort, Async: true, Data: []byte(b.String()),
+	})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if pending.Status != models.EventImportPending {
+		t.Fatalf("expected a pending import, got %s", pending.Status)
+	}
+
+	get := usecases.NewGetEventImportUseCase(imports)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		result, err := get.Execute(dtos.GetEventImportDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), ImportID: pending.ID})
+		if err != nil {
+			t.Fatalf("get import: %v", err)
+		}
+		if result.FinishedAt != nil {
+			if result.Status != models.EventImportCompleted || result.Imported != 250 {
+				t.Fatalf("expected 250 imported events, got %+v", result)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("import did not finish, last seen %+v", result)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var notFound *exceptions.NotFoundException
+	if _, err := get.Execute(dtos.GetEventImportDto{Ctx: f.ctx, OrganizerID: other.GetID(), ImportID: pending.ID}); !errors.As(err, &notFound) {
+		t.Fatalf("expected other organizers not to see the import, got %v", err)
+	}
+}
+
+type stallKey struct{}
+
+// stallingUnitOfWork blocks the second unit of work run with stallKey in the
+// context, the import's save, until release is closed and then panics.
+type stallingUnitOfWork struct {
+	repositories.UnitOfWork
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (u *stallingUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos repositories.Repositories) error) error {
+	if ctx.Value(stallKey{}) != nil && u.calls.Add(1) == 2 {
+		<-u.release
+		panic("boom")
+	}
+	return u.UnitOfWork.Do(ctx, fn)
+}
+
+func TestAsyncImportsAreCappedAndSurvivePanics(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	imports := memory.NewEventImportRepository()
+	uow := &stallingUnitOfWork{UnitOfWork: f.uow, release: make(chan struct{})}
+	uc := usecases.NewImportEventsUseCase(uow, imports, usecases.EmailVerificationPolicy{}, 1)
+
+	props := dtos.ImportEventsDto{
+		Ctx: f.ctx, OrganizerID: organizer.GetID(), Format: "csv", Mode: models.EventImportBestEffort, Async: true,
+		Data: []byte("name,location,date,category\nGo Meetup,Online,2030-03-01T19:00,tech\n"),
+	}
+	stalled := props
+	stalled.Ctx = context.WithValue(f.ctx, stallKey{}, true)
+	pending, err := uc.Execute(stalled)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	var tooMany *exceptions.TooManyRequestsException
+	if _, err := uc.Execute(props); !errors.As(err, &tooMany) {
+		t.Fatalf("expected the second import to wait for a free slot, got %v", err)
+	}
+
+	close(uow.release)
+	get := usecases.NewGetEventImportUseCase(imports)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		result, err := get.Execute(dtos.GetEventImportDto{Ctx: f.ctx, OrganizerID: organizer.GetID(), ImportID: pending.ID})
+		if err != nil {
+			t.Fatalf("get import: %v", err)
+		}
+		if result.FinishedAt != nil {
+			if result.Status != models.EventImportFailed || result.Failure == "" {
+				t.Fatalf("expected the panic to fail the import, got %+v", result)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("import did not finish, last seen %+v", result)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// cancellingUnitOfWork cancels the request once the import saves its first
+// events, as a request deadline would.
+type cancellingUnitOfWork struct {
+	repositories.UnitOfWork
+	calls  atomic.Int32
+	cancel context.CancelFunc
+}
+
+func (u *cancellingUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos repositories.Repositories) error) error {
+	if u.calls.Add(1) == 2 {
+		u.cancel()
+	}
+	return u.UnitOfWork.Do(ctx, fn)
+}
+
+func TestImportEventsRecordsFailuresAfterTheRequestEnds(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	imports := memory.NewEventImportRepository()
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+	uow := &cancellingUnitOfWork{UnitOfWork: f.uow, cancel: cancel}
+
+	result, err := usecases.NewImportEventsUseCase(uow, imports, usecases.EmailVerificationPolicy{}, 1).Execute(dtos.ImportEventsDto{
+		Ctx: ctx, OrganizerID: organizer.GetID(), Format: "csv", Mode: models.EventImportBestEffort, Data: []byte(eventImportCSV),
+	})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	stored, err := imports.FindByID(f.ctx, result.ID)
+	if err != nil {
+		t.Fatalf("finding import: %v", err)
+	}
+	if stored.GetStatus() != models.EventImportFailed || stored.GetFinishedAt() == nil {
+		t.Fatalf("expected the import to be saved as failed, got %s", stored.GetStatus())
+	}
+}
+
+func TestFailInterruptedEventImports(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	imports := memory.NewEventImportRepository()
+
+	organizerID, format, mode := organizer.GetID(), "csv", models.EventImportBestEffort
+	newImport := func(status string) string {
+		t.Helper()
+		eventImport, err := models.NewEventImport(models.EventImportProps{OrganizerID: &organizerID, Format: &format, Mode: &mode})
+		if err != nil {
+			t.Fatalf("creating import: %v", err)
+		}
+		switch status {
+		case models.EventImportRunning:
+			eventImport.Start(10)
+		case models.EventImportCompleted:
+			eventImport.Start(10)
+			eventImport.Complete(time.Now())
+		}
+		if err := imports.Create(f.ctx, eventImport); err != nil {
+			t.Fatalf("storing import: %v", err)
+		}
+		return eventImport.GetID()
+	}
+	want := map[string]string{
+		newImport(models.EventImportPending):   models.EventImportFailed,
+		newImport(models.EventImportRunning):   models.EventImportFailed,
+		newImport(models.EventImportCompleted): models.EventImportCompleted,
+	}
+
+	if err := usecases.FailInterruptedEventImports(f.ctx, imports); err != nil {
+		t.Fatalf("failing interrupted imports: %v", err)
+	}
+	for id, status := range want {
+		eventImport, err := imports.FindByID(f.ctx, id)
+		if err != nil {
+			t.Fatalf("finding import: %v", err)
+		}
+		if eventImport.GetStatus() != status || eventImport.GetFinishedAt() == nil {
+			t.Fatalf("expected import %s to be %s and finished, got %s", id, status, eventImport.GetStatus())
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type listAPIKeysUseCase struct {
+	repo repositories.APIKeyRepository
+}
+
+func NewListAPIKeysUseCase(repo repositories.APIKeyRepository) *listAPIKeysUseCase {
+	return &listAPIKeysUseCase{repo: repo}
+}
+
+type ListAPIKeysProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+func (uc *listAPIKeysUseCase) Execute(props ListAPIKeysProps) ([]dtos.APIKeyDto, error) {
+	keys, err := uc.repo.FindByUserID(props.Ctx, props.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.APIKeyDto, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, apiKeyToDto(key))
+	}
+	return result, nil
+}
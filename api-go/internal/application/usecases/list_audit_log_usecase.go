@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+const defaultAuditLogLimit = 100
+
+type listAuditLogUseCase struct {
+	users    repositories.UserRepository
+	auditLog repositories.AuditLogRepository
+}
+
+func NewListAuditLogUseCase(users repositories.UserRepository, auditLog repositories.AuditLogRepository) *listAuditLogUseCase {
+	return &listAuditLogUseCase{users: users, auditLog: auditLog}
+}
+
+// Execute lists the entries matching the query, newest first, to admins
+// only.
+func (uc *listAuditLogUseCase) Execute(props dtos.AuditLogQueryDto) ([]dtos.AuditEntryDto, error) {
+	if err := requireAdmin(props.Ctx, uc.users, props.AdminID); err != nil {
+		return nil, err
+	}
+
+	filter := repositories.AuditLogFilter{
+		ActorID:    props.ActorID,
+		TargetType: props.TargetType,
+		TargetID:   props.TargetID,
+		Action:     props.Action,
+		Limit:      props.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditLogLimit
+	}
+	if !props.From.IsZero() {
+		filter.From = &props.From
+	}
+	if !props.To.IsZero() {
+		filter.To = &props.To
+	}
+
+	entries, err := uc.auditLog.Find(props.Ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.AuditEntryDto, 0, len(entries))
+	for _, entry := range entries {
+		dto := dtos.AuditEntryDto{
+			ID:         entry.GetID(),
+			ActorID:    entry.GetActorID(),
+			Action:     entry.GetAction(),
+			TargetType: entry.GetTargetType(),
+			TargetID:   entry.GetTargetID(),
+			IP:         entry.GetIP(),
+			RequestID:  entry.GetRequestID(),
+			Details:    entry.GetDetails(),
+			CreatedAt:  entry.GetCreatedAt(),
+		}
+		if len(entry.GetChanges()) > 0 {
+			dto.Changes = map[string]dtos.AuditChangeDto{}
+			for field, change := range entry.GetChanges() {
+				dto.Changes[field] = dtos.AuditChangeDto{Before: change.Before, After: change.After}
+			}
+		}
+		result = append(result, dto)
+	}
+	return result, nil
+}
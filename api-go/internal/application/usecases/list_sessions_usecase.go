@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type listSessionsUseCase struct {
+	repo repositories.SessionRepository
+}
+
+func NewListSessionsUseCase(repo repositories.SessionRepository) *listSessionsUseCase {
+	return &listSessionsUseCase{repo: repo}
+}
+
+type ListSessionsProps struct {
+	Ctx              context.Context `json:"-"`
+	UserID           string
+	CurrentSessionID string
+}
+
+// Execute lists the sessions the user is still logged in with.
+func (uc *listSessionsUseCase) Execute(props ListSessionsProps) ([]dtos.SessionDto, error) {
+	sessions, err := uc.repo.FindActiveByUserID(props.Ctx, props.UserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.SessionDto, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, dtos.SessionDto{
+			ID:         session.GetID(),
+			Device:     session.GetDevice(),
+			UserAgent:  session.GetUserAgent(),
+			IP:         session.GetIP(),
+			CreatedAt:  session.GetCreatedAt(),
+			LastSeenAt: session.GetLastSeenAt(),
+			ExpiresAt:  session.GetExpiresAt(),
+			Current:    session.GetID() == props.CurrentSessionID,
+		})
+	}
+	return result, nil
+}
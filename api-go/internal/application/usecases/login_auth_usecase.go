@@ -1,49 +1,108 @@
 package usecases
 
 import (
+	"context"
 	"errors"
 	"log"
-	
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
-	"github.com/Gabriel-Schiestl/api-go/internal/utils"
 )
 
 type loginUseCase struct {
-	authRepo repositories.AuthRepository
-	userRepo repositories.UserRepository
-	jwtService services.IJWTService
+	authRepo      repositories.AuthRepository
+	userRepo      repositories.UserRepository
+	twoFactorRepo repositories.TwoFactorRepository
+	sessionRepo   repositories.SessionRepository
+	hasher        services.IPasswordHasher
+	jwtService    services.IJWTService
+	guard         *LoginGuard
+	// dummyHash, computed with the current settings, is checked when there
+	// is no password to check, so that unknown emails and accounts without
+	// a password take as long to refuse as wrong passwords.
+	dummyHash, dummyAlgorithm string
 }
 
-func NewLoginUseCase(authRepo repositories.AuthRepository, userRepo repositories.UserRepository, jwtService services.IJWTService) *loginUseCase {
-	return &loginUseCase{authRepo: authRepo, userRepo: userRepo, jwtService: jwtService}
+func NewLoginUseCase(authRepo repositories.AuthRepository, userRepo repositories.UserRepository, twoFactorRepo repositories.TwoFactorRepository, sessionRepo repositories.SessionRepository, hasher services.IPasswordHasher, jwtService services.IJWTService, guard *LoginGuard) *loginUseCase {
+	uc := &loginUseCase{authRepo: authRepo, userRepo: userRepo, twoFactorRepo: twoFactorRepo, sessionRepo: sessionRepo, hasher: hasher, jwtService: jwtService, guard: guard}
+	var err error
+	if uc.dummyHash, uc.dummyAlgorithm, err = hasher.Hash(dummyPassword); err != nil {
+		log.Printf("LoginUseCase - Failed to hash the dummy password: %v", err)
+	}
+	return uc
 }
 
-func (uc *loginUseCase) Execute(props dtos.LoginDto) (*string, error) {
-	log.Printf("LoginUseCase - Attempting login for email: %s", props.Email)
-	
-	user, err := uc.userRepo.FindByEmail(props.Email)
-	if err != nil {
-		log.Printf("LoginUseCase - User not found for email %s: %v", props.Email, err)
+// dummyPassword is hashed into the dummyHash of the login use case.
+const dummyPassword = "not the password of any account"
+
+// Execute checks the password. Users with two-factor authentication get a
+// challenge token to answer at /auth/login/2fa instead of the access token,
+// the others a new session. Failed attempts are throttled by guard, per account and per client IP.
+// Passwords hashed with outdated settings are rehashed with the current ones.
+// Suspended users are refused once their password checks out.
+func (uc *loginUseCase) Execute(props dtos.LoginDto) (*dtos.LoginResultDto, error) {
+	if err := uc.guard.check(props.Ctx, props.Email, props.IP); err != nil {
 		return nil, err
 	}
 
-	log.Printf("LoginUseCase - Found user: ID=%s, Name=%s, Email=%s", user.GetID(), user.GetName(), user.GetEmail())
-
-	// Verificar a senha que está na tabela users
-	if !utils.CheckPasswordHash(props.Password, user.GetPassword()) {
-		log.Printf("LoginUseCase - Invalid password for user %s", user.GetEmail())
-		return nil, errors.New("credenciais inválidas")
+	user, err := uc.userRepo.FindByEmail(props.Ctx, props.Email)
+	if err != nil {
+		var notFound *exceptions.NotFoundException
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+		uc.hasher.Verify(props.Password, uc.dummyHash, uc.dummyAlgorithm)
+		if err := uc.guard.recordFailure(props.Ctx, props.Email, props.IP, nil, loginFailureUnknownEmail); err != nil {
+			return nil, err
+		}
+		return nil, exceptions.NewUnauthorizedException("credenciais inválidas")
 	}
 
-	log.Printf("LoginUseCase - Password verified, generating token for user ID: %s", user.GetID())
-	token, err := uc.jwtService.GenerateToken(user.GetID())
+	auth, err := uc.authRepo.FindByUserID(props.Ctx, user.GetID())
 	if err != nil {
-		log.Printf("LoginUseCase - Error generating token: %v", err)
 		return nil, err
 	}
+	if !auth.HasPassword() {
+		uc.hasher.Verify(props.Password, uc.dummyHash, uc.dummyAlgorithm)
+	}
+	if !checkPassword(uc.hasher, auth, props.Password) {
+		if err := uc.guard.recordFailure(props.Ctx, props.Email, props.IP, user, loginFailureInvalidPassword); err != nil {
+			return nil, err
+		}
+		return nil, exceptions.NewUnauthorizedException("credenciais inválidas")
+	}
+	uc.rehash(props.Ctx, auth, props.Password)
+	if user.IsSuspended() {
+		return nil, errAccountSuspended()
+	}
+
+	result, err := issueLoginResult(props.Ctx, uc.twoFactorRepo, uc.sessionRepo, uc.jwtService, user.GetID(), props.IP, props.UserAgent)
+	if err != nil || result.TwoFactorRequired {
+		return result, err
+	}
+
+	if err := uc.guard.recordSuccess(props.Ctx, user, props.IP); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rehash upgrades the stored hash while the plain password is at hand. The
+// login goes on when it fails, as the old hash still verifies.
+func (uc *loginUseCase) rehash(ctx context.Context, auth models.Auth, password string) {
+	if !uc.hasher.NeedsRehash(auth.GetPasswordHash(), auth.GetAlgorithm()) {
+		return
+	}
 
-	log.Printf("LoginUseCase - Token generated successfully for user %s", user.GetID())
-	return token, nil
+	hash, algorithm, err := uc.hasher.Hash(password)
+	if err == nil {
+		auth.Rehash(hash, algorithm)
+		err = uc.authRepo.Save(ctx, auth)
+	}
+	if err != nil {
+		log.Printf("LoginUseCase - Failed to rehash the password of user %s: %v", auth.GetUserID(), err)
+	}
 }
@@ -0,0 +1,103 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+)
+
+func TestLoginUseCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		password  string
+		jwtErr    error
+		wantToken bool
+		wantErr   string
+	}{
+		{name: "valid credentials", email: "user@example.com", password: "secret123", wantToken: true},
+		{name: "wrong password", email: "user@example.com", password: "wrong", wantErr: "credenciais inválidas"},
+		{name: "unknown email", email: "nobody@example.com", password: "secret123", wantErr: "credenciais inválidas"},
+		{name: "token generation fails", email: "user@example.com", password: "secret123", jwtErr: errors.New("signing failed"), wantErr: "signing failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			user := f.addUser(t, "user@example.com", "secret123")
+			f.jwt.Err = tt.jwtErr
+
+			uc := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard)
+			result, err := uc.Execute(dtos.LoginDto{Ctx: f.ctx, Email: tt.email, Password: tt.password})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			claims, err := f.jwt.ExtractClaims(result.Token)
+			if err != nil || claims["sub"] != user.GetID() {
+				t.Fatalf("expected a token for %s, got %v (%v)", user.GetID(), claims, err)
+			}
+		})
+	}
+}
+
+// countingHasher counts the hashes verified, the slow part of a login.
+type countingHasher struct {
+	*memory.PasswordHasher
+	verified int
+}
+
+func (h *countingHasher) Verify(password, hash, algorithm string) bool {
+	h.verified++
+	return h.PasswordHasher.Verify(password, hash, algorithm)
+}
+
+func TestLoginVerifiesAHashForUnknownEmails(t *testing.T) {
+	f := newFixture()
+	f.addUser(t, "user@example.com", "secret123")
+	hasher := &countingHasher{PasswordHasher: f.hasher}
+	uc := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, hasher, f.jwt, f.guard)
+
+	for _, email := range []string{"user@example.com", "nobody@example.com"} {
+		hasher.verified = 0
+		if _, err := uc.Execute(dtos.LoginDto{Ctx: f.ctx, Email: email, Password: "wrong"}); err == nil {
+			t.Fatalf("expected %s to be refused", email)
+		}
+		if hasher.verified != 1 {
+			t.Fatalf("expected one hash verified for %s, got %d", email, hasher.verified)
+		}
+	}
+}
+
+func TestLoginRehashesOutdatedPasswords(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	session := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	if got := f.auth(t, user.GetID()).GetAlgorithm(); got != "fake" {
+		t.Fatalf("expected a current hash to be kept, got %q", got)
+	}
+
+	f.hasher.Algorithm = "stronger"
+	f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+
+	auth := f.auth(t, user.GetID())
+	if auth.GetAlgorithm() != "stronger" || !f.hasher.Verify("secret123", auth.GetPasswordHash(), auth.GetAlgorithm()) {
+		t.Fatalf("expected the password to be rehashed, got %q", auth.GetPasswordHash())
+	}
+	if auth.GetPasswordChangedAt() != nil {
+		t.Fatalf("expected a rehash not to count as a password change")
+	}
+	if _, err := usecases.NewAuthenticateSessionUseCase(f.uow).Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: session, UserID: user.GetID()}); err != nil {
+		t.Fatalf("expected earlier sessions to stay valid, got %v", err)
+	}
+	f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+}
@@ -0,0 +1,242 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+// Reasons recorded with failed logins in the audit log.
+const (
+	loginFailureUnknownEmail    = "unknown_email"
+	loginFailureInvalidPassword = "invalid_password"
+	loginFailureInvalidCode     = "invalid_code"
+)
+
+// maxDelayDoublings bounds the exponent of the progressive delay so the
+// shift cannot overflow before MaxDelay caps it.
+const maxDelayDoublings = 20
+
+// LoginThrottlePolicy decides how failed logins slow down further attempts.
+type LoginThrottlePolicy struct {
+	// FreeAttempts failures per account and IPFreeAttempts failures per IP
+	// are allowed without delay. Every further failure doubles the wait,
+	// starting at BaseDelay and capped at MaxDelay.
+	FreeAttempts   int
+	IPFreeAttempts int
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	// LockThreshold consecutive failures lock the account for LockDuration.
+	LockThreshold int
+	LockDuration  time.Duration
+	// ResetAfter without failures clears the count.
+	ResetAfter time.Duration
+}
+
+// wait is how long the throttled account or IP must still wait before its
+// next attempt.
+func (p LoginThrottlePolicy) wait(throttle models.LoginThrottle, now time.Time) time.Duration {
+	free := p.FreeAttempts
+	if throttle.GetScope() == models.LoginThrottleScopeIP {
+		free = p.IPFreeAttempts
+	}
+
+	failures := throttle.GetFailures()
+	if failures < free || now.Sub(throttle.GetLastFailureAt()) > p.ResetAfter {
+		return 0
+	}
+
+	delay := p.MaxDelay
+	if doublings := failures - free; doublings < maxDelayDoublings {
+		delay = min(p.BaseDelay<<doublings, p.MaxDelay)
+	}
+	return max(throttle.GetLastFailureAt().Add(delay).Sub(now), 0)
+}
+
+// LoginGuard tracks failed logins per account and per client IP, rejecting
+// attempts with a 429 while a progressive delay runs and with a 423 once the
+// account is locked. Locking mails the user a link to unlock it early.
+type LoginGuard struct {
+	uow       repositories.UnitOfWork
+	policy    LoginThrottlePolicy
+	mailer    services.IMailer
+	apiURL    string
+	unlockTTL time.Duration
+}
+
+func NewLoginGuard(uow repositories.UnitOfWork, policy LoginThrottlePolicy, mailer services.IMailer, apiURL string, unlockTTL time.Duration) *LoginGuard {
+	return &LoginGuard{uow: uow, policy: policy, mailer: mailer, apiURL: apiURL, unlockTTL: unlockTTL}
+}
+
+// accountThrottleKey normalizes the email an account is throttled by.
+func accountThrottleKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// check fails when the account is locked or either the account or the IP
+// must still wait before trying again.
+func (g *LoginGuard) check(ctx context.Context, email, ip string) error {
+	return g.uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		return g.checkIn(ctx, repos, email, ip)
+	})
+}
+
+func (g *LoginGuard) checkIn(ctx context.Context, repos repositories.Repositories, email, ip string) error {
+	now := time.Now()
+
+	account, err := findThrottle(ctx, repos, models.LoginThrottleScopeAccount, accountThrottleKey(email))
+	if err != nil {
+		return err
+	}
+	var wait time.Duration
+	if account != nil {
+		if account.IsLocked(now) {
+			return exceptions.NewLockedException("Account temporarily locked after too many failed logins", account.GetLockedUntil().Sub(now))
+		}
+		wait = g.policy.wait(account, now)
+	}
+
+	if ip != "" {
+		client, err := findThrottle(ctx, repos, models.LoginThrottleScopeIP, ip)
+		if err != nil {
+			return err
+		}
+		if client != nil {
+			wait = max(wait, g.policy.wait(client, now))
+		}
+	}
+
+	if wait > 0 {
+		return exceptions.NewTooManyRequestsException("Too many failed login attempts, try again later", wait)
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt against the account and the IP and
+// locks the account once it reaches the threshold. user is nil when the
+// email is not registered.
+func (g *LoginGuard) recordFailure(ctx context.Context, email, ip string, user models.User, reason string) error {
+	var userID, rawToken string
+	if user != nil {
+		userID = user.GetID()
+	}
+
+	err := g.uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		now := time.Now()
+
+		account, err := g.countFailure(ctx, repos, models.LoginThrottleScopeAccount, accountThrottleKey(email), now)
+		if err != nil {
+			return err
+		}
+		locked := account.GetFailures() >= g.policy.LockThreshold
+		if locked {
+			account.Lock(now.Add(g.policy.LockDuration))
+		}
+		if err := repos.LoginThrottles().Save(ctx, account); err != nil {
+			return err
+		}
+
+		if ip != "" {
+			client, err := g.countFailure(ctx, repos, models.LoginThrottleScopeIP, ip, now)
+			if err != nil {
+				return err
+			}
+			if err := repos.LoginThrottles().Save(ctx, client); err != nil {
+				return err
+			}
+		}
+
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionLoginFailed, nil, userID, ip, map[string]string{"reason": reason}); err != nil {
+			return err
+		}
+
+		if !locked {
+			return nil
+		}
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionAccountLocked, nil, userID, ip, nil); err != nil {
+			return err
+		}
+		if user == nil {
+			return nil
+		}
+		rawToken, err = issueUserToken(ctx, repos.UserTokens(), userID, models.TokenPurposeAccountUnlock, g.unlockTTL)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if rawToken != "" {
+		if err := sendUnlockEmail(ctx, g.mailer, g.apiURL, user, rawToken, g.unlockTTL); err != nil {
+			log.Printf("LoginGuard - could not send unlock email to user %s: %v", userID, err)
+		}
+	}
+	return nil
+}
+
+// countFailure loads or creates the throttle and records a failure on it,
+// leaving it to the caller to save.
+func (g *LoginGuard) countFailure(ctx context.Context, repos repositories.Repositories, scope, key string, now time.Time) (models.LoginThrottle, error) {
+	throttle, err := repos.LoginThrottles().FindForUpdate(ctx, scope, key)
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		throttle, err = models.NewLoginThrottle(models.LoginThrottleProps{Scope: &scope, Key: &key})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	throttle.RecordFailure(now, g.policy.ResetAfter)
+	return throttle, nil
+}
+
+// recordSuccess clears the account's failures. The IP keeps its count so a
+// single valid account cannot be used to reset it.
+func (g *LoginGuard) recordSuccess(ctx context.Context, user models.User, ip string) error {
+	return g.uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := repos.LoginThrottles().Delete(ctx, models.LoginThrottleScopeAccount, accountThrottleKey(user.GetEmail())); err != nil {
+			return err
+		}
+		userID := user.GetID()
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionLoginSucceeded, &userID, userID, ip, nil)
+	})
+}
+
+// unlockAccount clears the failures of the user's account and revokes
+// pending unlock links. Lifting an active lock is audited, with method
+// telling how the user proved their identity.
+func unlockAccount(ctx context.Context, repos repositories.Repositories, user models.User, ip, method string, now time.Time) error {
+	userID, key := user.GetID(), accountThrottleKey(user.GetEmail())
+	if err := repos.UserTokens().RevokeForUser(ctx, userID, models.TokenPurposeAccountUnlock, now); err != nil {
+		return err
+	}
+
+	account, err := findThrottle(ctx, repos, models.LoginThrottleScopeAccount, key)
+	if err != nil || account == nil {
+		return err
+	}
+	if err := repos.LoginThrottles().Delete(ctx, models.LoginThrottleScopeAccount, key); err != nil {
+		return err
+	}
+	if !account.IsLocked(now) {
+		return nil
+	}
+	return auditUser(ctx, repos.AuditLog(), models.AuditActionAccountUnlocked, &userID, userID, ip, map[string]string{"method": method})
+}
+
+// findThrottle returns nil when no failure was recorded for the key.
+func findThrottle(ctx context.Context, repos repositories.Repositories, scope, key string) (models.LoginThrottle, error) {
+	throttle, err := repos.LoginThrottles().Find(ctx, scope, key)
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	return throttle, err
+}
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// issueLoginResult returns the access token of a user who proved their
+// identity, or a challenge to answer at /auth/login/2fa when they enabled
+// two-factor authentication.
+func issueLoginResult(ctx context.Context, twoFactors repositories.TwoFactorRepository, sessions repositories.SessionRepository, jwtService services.IJWTService, userID, ip, userAgent string) (*dtos.LoginResultDto, error) {
+	twoFactor, err := twoFactors.FindByUserID(ctx, userID)
+	var notFound *exceptions.NotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, err
+	}
+	if err == nil && twoFactor.IsEnabled() {
+		challenge, err := jwtService.GenerateChallengeToken(userID)
+		if err != nil {
+			return nil, err
+		}
+		return &dtos.LoginResultDto{TwoFactorRequired: true, ChallengeToken: *challenge}, nil
+	}
+
+	return startSession(ctx, sessions, jwtService, userID, ip, userAgent)
+}
+
+// startSession records where the user logged in from and issues the access
+// token of that session.
+func startSession(ctx context.Context, sessions repositories.SessionRepository, jwtService services.IJWTService, userID, ip, userAgent string) (*dtos.LoginResultDto, error) {
+	device := utils.DescribeUserAgent(userAgent)
+	session, err := models.NewSession(models.SessionProps{
+		UserID:    &userID,
+		IP:        &ip,
+		UserAgent: &userAgent,
+		Device:    &device,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := sessions.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	token, err := jwtService.GenerateToken(userID, session.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.LoginResultDto{Token: *token}, nil
+}
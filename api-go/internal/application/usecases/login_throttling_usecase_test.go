@@ -0,0 +1,221 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+var unlockLinkPattern = regexp.MustCompile(`https://api\.example\.com/auth/unlock\?token=(\S+)`)
+
+// useThrottle replaces the fixture's lenient guard with one using policy.
+func (f *fixture) useThrottle(policy usecases.LoginThrottlePolicy) {
+	f.guard = usecases.NewLoginGuard(f.uow, policy, f.mailer, "https://api.example.com", time.Hour)
+}
+
+func (f *fixture) login(email, password, ip string) error {
+	_, err := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard).
+		Execute(dtos.LoginDto{Ctx: f.ctx, Email: email, Password: password, IP: ip})
+	return err
+}
+
+func (f *fixture) auditActions() []string {
+	var actions []string
+	for _, entry := range f.stores.AuditLog.All() {
+		actions = append(actions, entry.GetAction())
+	}
+	return actions
+}
+
+func TestLoginDelaysRepeatedFailures(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	policy := lenientThrottle
+	policy.FreeAttempts, policy.BaseDelay, policy.MaxDelay = 2, time.Minute, time.Hour
+	f.useThrottle(policy)
+
+	var unauthorized *exceptions.UnauthorizedException
+	for i := 0; i < 2; i++ {
+		if err := f.login(user.GetEmail(), "wrong", "10.0.0.1"); !errors.As(err, &unauthorized) {
+			t.Fatalf("attempt %d: expected Unauthorized, got %v", i+1, err)
+		}
+	}
+
+	err := f.login(user.GetEmail(), "secret123", "10.0.0.2")
+	var tooMany *exceptions.TooManyRequestsException
+	if !errors.As(err, &tooMany) {
+		t.Fatalf("expected the account to be throttled even with the right password, got %v", err)
+	}
+	if wait := tooMany.RetryAfter(); wait <= 0 || wait > time.Minute {
+		t.Fatalf("expected a wait of at most the base delay, got %s", wait)
+	}
+}
+
+func TestLoginSuccessClearsAccountFailures(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	policy := lenientThrottle
+	policy.FreeAttempts, policy.BaseDelay = 2, time.Minute
+	f.useThrottle(policy)
+
+	var unauthorized *exceptions.UnauthorizedException
+	steps := []struct {
+		password string
+		wantErr  bool
+	}{
+		{"wrong", true},
+		{"secret123", false},
+		{"wrong", true},
+		{"wrong", true},
+	}
+	for i, step := range steps {
+		err := f.login(user.GetEmail(), step.password, "10.0.0.1")
+		if step.wantErr && !errors.As(err, &unauthorized) {
+			t.Fatalf("step %d: expected Unauthorized, got %v", i+1, err)
+		}
+		if !step.wantErr && err != nil {
+			t.Fatalf("step %d: unexpected error: %v", i+1, err)
+		}
+	}
+}
+
+func TestLoginThrottlesClientIPAcrossAccounts(t *testing.T) {
+	f := newFixture()
+	policy := lenientThrottle
+	policy.IPFreeAttempts, policy.BaseDelay = 2, time.Minute
+	f.useThrottle(policy)
+
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		if err := f.login(email, "guess", "10.0.0.1"); err == nil {
+			t.Fatalf("expected %s to fail", email)
+		}
+	}
+
+	var tooMany *exceptions.TooManyRequestsException
+	if err := f.login("c@example.com", "guess", "10.0.0.1"); !errors.As(err, &tooMany) {
+		t.Fatalf("expected the IP to be throttled, got %v", err)
+	}
+
+	var unauthorized *exceptions.UnauthorizedException
+	if err := f.login("c@example.com", "guess", "10.0.0.2"); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected other IPs to be unaffected, got %v", err)
+	}
+}
+
+func TestLoginLocksAccountUntilUnlocked(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	policy := lenientThrottle
+	policy.LockThreshold, policy.LockDuration = 3, 15*time.Minute
+	f.useThrottle(policy)
+
+	for i := 0; i < 3; i++ {
+		if err := f.login(user.GetEmail(), "wrong", "10.0.0.1"); err == nil {
+			t.Fatalf("attempt %d: expected an error", i+1)
+		}
+	}
+
+	var locked *exceptions.LockedException
+	if err := f.login(user.GetEmail(), "secret123", "10.0.0.1"); !errors.As(err, &locked) {
+		t.Fatalf("expected the account to be locked, got %v", err)
+	}
+	if wait := locked.RetryAfter(); wait <= 0 || wait > 15*time.Minute {
+		t.Fatalf("expected the lock to last at most 15m, got %s", wait)
+	}
+
+	sent := f.mailer.Sent()
+	if len(sent) != 1 || sent[0].To != user.GetEmail() {
+		t.Fatalf("expected one unlock mail to the user, got %+v", sent)
+	}
+	match := unlockLinkPattern.FindStringSubmatch(sent[0].Body)
+	if match == nil {
+		t.Fatalf("no unlock link in mail: %q", sent[0].Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("invalid token in link: %v", err)
+	}
+
+	unlock := usecases.NewUnlockAccountUseCase(f.uow)
+	if _, err := unlock.Execute(dtos.UnlockAccountDto{Ctx: f.ctx, Token: token, IP: "10.0.0.9"}); err != nil {
+		t.Fatalf("unlock: %v", err)
+	}
+	if err := f.login(user.GetEmail(), "secret123", "10.0.0.1"); err != nil {
+		t.Fatalf("expected login after unlocking, got %v", err)
+	}
+
+	var validation *exceptions.ValidationException
+	if _, err := unlock.Execute(dtos.UnlockAccountDto{Ctx: f.ctx, Token: token}); !errors.As(err, &validation) {
+		t.Fatalf("expected the unlock link to be single-use, got %v", err)
+	}
+
+	want := []string{
+		models.AuditActionLoginFailed,
+		models.AuditActionLoginFailed,
+		models.AuditActionLoginFailed,
+		models.AuditActionAccountLocked,
+		models.AuditActionAccountUnlocked,
+		models.AuditActionLoginSucceeded,
+	}
+	got := f.auditActions()
+	if len(got) != len(want) {
+		t.Fatalf("expected audit actions %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected audit actions %v, got %v", want, got)
+		}
+	}
+	for _, entry := range f.stores.AuditLog.All() {
+		if entry.GetTargetID() != user.GetID() || entry.GetDetails()["email"] != "" {
+			t.Fatalf("unexpected audit entry %+v", entry)
+		}
+	}
+}
+
+func TestLoginLocksUnknownEmailsWithoutMail(t *testing.T) {
+	f := newFixture()
+	policy := lenientThrottle
+	policy.LockThreshold = 2
+	f.useThrottle(policy)
+
+	for i := 0; i < 2; i++ {
+		f.login("nobody@example.com", "guess", "10.0.0.1")
+	}
+
+	var locked *exceptions.LockedException
+	if err := f.login("Nobody@Example.com", "guess", "10.0.0.1"); !errors.As(err, &locked) {
+		t.Fatalf("expected unknown emails to lock like registered ones, got %v", err)
+	}
+	if sent := f.mailer.Sent(); len(sent) != 0 {
+		t.Fatalf("expected no mail, got %+v", sent)
+	}
+}
+
+func TestPasswordResetUnlocksAccount(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	policy := lenientThrottle
+	policy.LockThreshold = 2
+	f.useThrottle(policy)
+
+	for i := 0; i < 2; i++ {
+		f.login(user.GetEmail(), "wrong", "10.0.0.1")
+	}
+
+	token := f.requestReset(t, user.GetEmail(), time.Hour)
+	if _, err := usecases.NewResetPasswordUseCase(f.uow, f.hasher, f.policy).Execute(dtos.ResetPasswordDto{Ctx: f.ctx, Token: token, Password: "newsecret1"}); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	if err := f.login(user.GetEmail(), "newsecret1", "10.0.0.1"); err != nil {
+		t.Fatalf("expected login after the reset, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type loginTwoFactorUseCase struct {
+	uow        repositories.UnitOfWork
+	jwtService services.IJWTService
+	guard      *LoginGuard
+}
+
+func NewLoginTwoFactorUseCase(uow repositories.UnitOfWork, jwtService services.IJWTService, guard *LoginGuard) *loginTwoFactorUseCase {
+	return &loginTwoFactorUseCase{uow: uow, jwtService: jwtService, guard: guard}
+}
+
+// Execute completes a login started by loginUseCase, exchanging the
+// challenge token and a TOTP or recovery code for the access token of a new
+// session. Wrong codes count as failed logins of the account.
+func (uc *loginTwoFactorUseCase) Execute(props dtos.TwoFactorLoginDto) (*dtos.LoginResultDto, error) {
+	userID, err := uc.jwtService.ExtractChallengeSubject(props.ChallengeToken)
+	if err != nil {
+		return nil, exceptions.NewUnauthorizedException("Invalid or expired challenge")
+	}
+
+	var (
+		user models.User
+		ok   bool
+	)
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		user, err = repos.Users().FindById(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := uc.guard.checkIn(ctx, repos, user.GetEmail(), props.IP); err != nil {
+			return err
+		}
+
+		twoFactor, err := repos.TwoFactors().FindByUserIDForUpdate(ctx, userID)
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) || (err == nil && !twoFactor.IsEnabled()) {
+			return exceptions.NewUnauthorizedException("Invalid or expired challenge")
+		}
+		if err != nil {
+			return err
+		}
+
+		ok, err = checkSecondFactor(ctx, repos, twoFactor, props.Code, true)
+		return err
+	})
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return nil, exceptions.NewUnauthorizedException("Invalid or expired challenge")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		if err := uc.guard.recordFailure(props.Ctx, user.GetEmail(), props.IP, user, loginFailureInvalidCode); err != nil {
+			return nil, err
+		}
+		return nil, exceptions.NewUnauthorizedException("Invalid two-factor code")
+	}
+	if user.IsSuspended() {
+		return nil, errAccountSuspended()
+	}
+
+	var result *dtos.LoginResultDto
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		result, err = startSession(ctx, repos.Sessions(), uc.jwtService, userID, props.IP, props.UserAgent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.guard.recordSuccess(props.Ctx, user, props.IP); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
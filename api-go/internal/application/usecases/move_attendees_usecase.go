@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type moveAttendeesUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewMoveAttendeesUseCase(uow repositories.UnitOfWork) *moveAttendeesUseCase {
+	return &moveAttendeesUseCase{uow: uow}
+}
+
+// Execute registers the users to the target event and cancels their
+// registration to the source one. Users the target refuses, or who are not
+// attendees of the source, fail alone and stay where they were.
+func (uc *moveAttendeesUseCase) Execute(props dtos.MoveAttendeesDto) (*dtos.BulkAttendeesResultDto, error) {
+	if props.EventID == props.ToEventID {
+		return nil, exceptions.NewValidationException("Attendees must be moved to another event")
+	}
+
+	var (
+		from    models.Event
+		results []dtos.AttendeeOutcomeDto
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		// Both events are locked in the same order by every move, so two
+		// moves between them in opposite directions cannot deadlock.
+		ids := []string{props.EventID, props.ToEventID}
+		slices.Sort(ids)
+		locked := map[string]models.Event{}
+		for _, id := range ids {
+			event, err := findOrganizedEventForUpdate(ctx, repos.Events(), id, props.OrganizerID)
+			if err != nil {
+				return err
+			}
+			locked[id] = event
+		}
+		from = locked[props.EventID]
+		to := locked[props.ToEventID]
+
+		var moved []string
+		fromBefore, toBefore := eventAuditFields(from), eventAuditFields(to)
+		for _, userID := range props.UserIDs {
+			outcome := dtos.AttendeeOutcomeDto{UserID: userID, Status: dtos.AttendeeMoved}
+			err := uc.move(from, to, userID, props.OverrideLimit)
+			if err != nil {
+				if !attendeeRuleError(err) {
+					return err
+				}
+				outcome.Status = dtos.AttendeeFailed
+				outcome.Error = err.Error()
+			} else {
+				moved = append(moved, userID)
+			}
+			results = append(results, outcome)
+		}
+
+		if len(moved) == 0 {
+			return nil
+		}
+		for _, event := range []models.Event{from, to} {
+			if err := repos.Events().Save(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		details := map[string]string{"from_event_id": from.ID(), "to_event_id": to.ID()}
+		setJoined(details, "user_ids", moved)
+		if props.OverrideLimit {
+			details["override_limit"] = "true"
+		}
+		if err := auditAttendees(ctx, repos.AuditLog(), models.AuditActionAttendeesMoved, props.OrganizerID, from.ID(), props.IP, details, fromBefore, eventAuditFields(from)); err != nil {
+			return err
+		}
+		return auditAttendees(ctx, repos.AuditLog(), models.AuditActionAttendeesMoved, props.OrganizerID, to.ID(), props.IP, details, toBefore, eventAuditFields(to))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.BulkAttendeesResultDto{Results: results, Attendees: from.Attendees()}, nil
+}
+
+func (uc *moveAttendeesUseCase) move(from, to models.Event, userID string, overLimit bool) error {
+	if !slices.Contains(from.Attendees(), userID) {
+		return exceptions.NewConflictException("Attendee not subscribed to the event")
+	}
+	if err := addAttendee(to, userID, overLimit); err != nil {
+		return err
+	}
+	return from.CancelSubscription(userID)
+}
@@ -0,0 +1,234 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+)
+
+type oidcFixture struct {
+	*fixture
+	idp       *memory.OIDCProvider
+	providers map[string]services.IOIDCProvider
+}
+
+func newOIDCFixture() *oidcFixture {
+	idp := memory.NewOIDCProvider()
+	return &oidcFixture{
+		fixture:   newFixture(),
+		idp:       idp,
+		providers: map[string]services.IOIDCProvider{"google": idp},
+	}
+}
+
+// login runs both legs of a login in which the provider asserts claims; the
+// nonce is filled in from the first leg.
+func (f *oidcFixture) login(t *testing.T, claims services.OIDCClaims) (*dtos.LoginResultDto, error) {
+	t.Helper()
+
+	start, err := usecases.NewStartOIDCLoginUseCase(f.providers).Execute(usecases.StartOIDCLoginProps{Ctx: f.ctx, Provider: "google"})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	claims.Nonce = start.Nonce
+	f.idp.Authorize("code-"+claims.Subject, start.CodeVerifier, claims)
+
+	return usecases.NewCompleteOIDCLoginUseCase(f.providers, f.uow, f.jwt, "participant").Execute(dtos.OIDCCallbackDto{
+		Ctx:           f.ctx,
+		Provider:      "google",
+		Code:          "code-" + claims.Subject,
+		State:         start.State,
+		ExpectedState: start.State,
+		Nonce:         start.Nonce,
+		CodeVerifier:  start.CodeVerifier,
+		IP:            "10.0.0.1",
+	})
+}
+
+func (f *oidcFixture) tokenSubject(t *testing.T, result *dtos.LoginResultDto) string {
+	t.Helper()
+
+	claims, err := f.jwt.ExtractClaims(result.Token)
+	if err != nil {
+		t.Fatalf("invalid token %q: %v", result.Token, err)
+	}
+	return claims["sub"].(string)
+}
+
+func TestStartOIDCLoginBuildsProviderURL(t *testing.T) {
+	f := newOIDCFixture()
+
+	start, err := usecases.NewStartOIDCLoginUseCase(f.providers).Execute(usecases.StartOIDCLoginProps{Ctx: f.ctx, Provider: "google"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authURL, err := url.Parse(start.AuthURL)
+	if err != nil {
+		t.Fatalf("invalid URL %q: %v", start.AuthURL, err)
+	}
+	if authURL.Query().Get("state") != start.State || authURL.Query().Get("nonce") != start.Nonce {
+		t.Fatalf("expected the URL to carry the state and nonce, got %s", start.AuthURL)
+	}
+	if start.State == start.Nonce || start.CodeVerifier == "" {
+		t.Fatalf("expected distinct random values, got %+v", start)
+	}
+
+	_, err = usecases.NewStartOIDCLoginUseCase(f.providers).Execute(usecases.StartOIDCLoginProps{Ctx: f.ctx, Provider: "github"})
+	var notFound *exceptions.NotFoundException
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected unknown providers to be NotFound, got %v", err)
+	}
+}
+
+func TestOIDCLoginProvisionsUnknownUser(t *testing.T) {
+	f := newOIDCFixture()
+
+	result, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "new@example.com", EmailVerified: true, Name: "New User"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	user, err := f.users.FindByEmail(f.ctx, "new@example.com")
+	if err != nil {
+		t.Fatalf("user was not provisioned: %v", err)
+	}
+	if f.tokenSubject(t, result) != user.GetID() {
+		t.Fatalf("expected a token for the new user")
+	}
+	if user.GetName() != "New User" || user.GetUserType() != "participant" || !user.IsEmailVerified() {
+		t.Fatalf("unexpected provisioned user %+v", user)
+	}
+	if f.auth(t, user.GetID()).HasPassword() {
+		t.Fatalf("provisioned users must not be able to log in with an empty password")
+	}
+
+	want := []string{models.AuditActionUserProvisioned, models.AuditActionIdentityLinked, models.AuditActionLoginSucceeded}
+	if got := f.auditActions(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("expected audit actions %v, got %v", want, got)
+	}
+
+	again, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "renamed@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if f.tokenSubject(t, again) != user.GetID() {
+		t.Fatalf("expected the linked identity to be reused despite the new email")
+	}
+}
+
+func TestOIDCLoginLinksVerifiedAccount(t *testing.T) {
+	f := newOIDCFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	user.VerifyEmail(time.Now())
+	if err := f.users.Save(f.ctx, user); err != nil {
+		t.Fatalf("saving user: %v", err)
+	}
+
+	result, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if f.tokenSubject(t, result) != user.GetID() {
+		t.Fatalf("expected a token for the existing user")
+	}
+	if identity, err := f.stores.Identities.FindByProviderSubject(f.ctx, "google", "sub-1"); err != nil || identity.GetUserID() != user.GetID() {
+		t.Fatalf("expected the identity to be linked, got %v (%v)", identity, err)
+	}
+
+	if err := f.fixture.login(user.GetEmail(), "secret123", "10.0.0.1"); err != nil {
+		t.Fatalf("expected the password to keep working, got %v", err)
+	}
+}
+
+func TestOIDCLoginClearsPasswordOfUnverifiedAccount(t *testing.T) {
+	f := newOIDCFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	if _, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "user@example.com", EmailVerified: true}); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	var unauthorized *exceptions.UnauthorizedException
+	if err := f.fixture.login(user.GetEmail(), "secret123", "10.0.0.1"); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the password set by whoever registered the address to stop working, got %v", err)
+	}
+	if stored, _ := f.users.FindById(f.ctx, user.GetID()); !stored.IsEmailVerified() {
+		t.Fatalf("expected the email to be verified by the provider")
+	}
+}
+
+func TestOIDCLoginRequiresVerifiedEmail(t *testing.T) {
+	f := newOIDCFixture()
+	f.addUser(t, "user@example.com", "secret123")
+
+	_, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "user@example.com"})
+	var forbidden *exceptions.ForbiddenException
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("expected Forbidden, got %v", err)
+	}
+	if _, err := f.stores.Identities.FindByProviderSubject(f.ctx, "google", "sub-1"); err == nil {
+		t.Fatalf("expected no identity to be linked")
+	}
+}
+
+func TestOIDCLoginRejectsForgedCallbacks(t *testing.T) {
+	uc := func(f *oidcFixture) func(dtos.OIDCCallbackDto) (*dtos.LoginResultDto, error) {
+		return usecases.NewCompleteOIDCLoginUseCase(f.providers, f.uow, f.jwt, "participant").Execute
+	}
+	claims := services.OIDCClaims{Subject: "sub-1", Email: "user@example.com", EmailVerified: true, Nonce: "nonce"}
+	valid := dtos.OIDCCallbackDto{
+		Provider:      "google",
+		Code:          "code",
+		State:         "state",
+		ExpectedState: "state",
+		Nonce:         "nonce",
+		CodeVerifier:  "verifier",
+	}
+
+	cases := map[string]func(*dtos.OIDCCallbackDto){
+		"state mismatch":   func(p *dtos.OIDCCallbackDto) { p.State = "other" },
+		"missing cookie":   func(p *dtos.OIDCCallbackDto) { p.ExpectedState, p.Nonce, p.CodeVerifier = "", "", "" },
+		"nonce mismatch":   func(p *dtos.OIDCCallbackDto) { p.Nonce = "other" },
+		"wrong verifier":   func(p *dtos.OIDCCallbackDto) { p.CodeVerifier = "other" },
+		"provider refused": func(p *dtos.OIDCCallbackDto) { p.Error = "access_denied" },
+	}
+	for name, tamper := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newOIDCFixture()
+			f.idp.Authorize("code", "verifier", claims)
+			props := valid
+			props.Ctx = f.ctx
+			tamper(&props)
+
+			var unauthorized *exceptions.UnauthorizedException
+			if _, err := uc(f)(props); !errors.As(err, &unauthorized) {
+				t.Fatalf("expected Unauthorized, got %v", err)
+			}
+			if _, err := f.users.FindByEmail(f.ctx, "user@example.com"); err == nil {
+				t.Fatalf("expected no user to be provisioned")
+			}
+		})
+	}
+}
+
+func TestOIDCLoginChallengesTwoFactorUsers(t *testing.T) {
+	f := newOIDCFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	f.enableTwoFactor(t, user.GetID())
+
+	result, err := f.login(t, services.OIDCClaims{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !result.TwoFactorRequired || result.Token != "" || result.ChallengeToken == "" {
+		t.Fatalf("expected only a challenge, got %+v", result)
+	}
+}
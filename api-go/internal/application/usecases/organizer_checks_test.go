@@ -0,0 +1,154 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+func TestUpdateEventUseCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		asOwner  bool
+		date     string
+		limit    int
+		wantErr  string
+		wantName string
+	}{
+		{name: "organizer updates event", asOwner: true, date: "2030-01-02T15:04", limit: 50, wantName: "Renamed"},
+		{name: "other user cannot update", asOwner: false, date: "2030-01-02T15:04", limit: 50, wantErr: "User is not authorized to update this event"},
+		{name: "negative limit", asOwner: true, date: "2030-01-02T15:04", limit: -1, wantErr: "Event limit cannot be negative"},
+		{name: "invalid date", asOwner: true, date: "02/01/2030", limit: 50, wantErr: "Invalid event date, expected format YYYY-MM-DDTHH:MM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			other := f.addUser(t, "other@example.com", "secret123")
+			attendee := f.addUser(t, "attendee@example.com", "secret123")
+			event := f.addEvent(t, organizer.GetID(), 10, attendee.GetID())
+
+			caller := other.GetID()
+			if tt.asOwner {
+				caller = organizer.GetID()
+			}
+
+			updated, err := usecases.NewUpdateEventUseCase(f.uow).Execute(dtos.UpdateEventProps{
+				Ctx:         f.ctx,
+				EventID:     event.ID(),
+				Name:        "Renamed",
+				Location:    "Online",
+				Date:        tt.date,
+				Description: "Updated",
+				OrganizerID: caller,
+				Category:    "tech",
+				Limit:       tt.limit,
+			})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				stored, _ := f.events.FindByID(f.ctx, event.ID())
+				if stored.Name() != event.Name() {
+					t.Fatalf("event changed after a failed update: %q", stored.Name())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated.Name != tt.wantName || len(updated.Attendees) != 1 || !updated.CreatedAt.Equal(event.CreatedAt()) {
+				t.Fatalf("unexpected update result: %+v", updated)
+			}
+		})
+	}
+}
+
+func TestDeleteEventUseCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		asOwner bool
+		eventID string
+		wantErr bool
+	}{
+		{name: "organizer deletes event", asOwner: true},
+		{name: "other user cannot delete", asOwner: false, wantErr: true},
+		{name: "unknown event", asOwner: true, eventID: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			other := f.addUser(t, "other@example.com", "secret123")
+			event := f.addEvent(t, organizer.GetID(), 10)
+
+			props := usecases.DeleteEventProps{Ctx: f.ctx, EventID: event.ID(), OrganizerID: other.GetID()}
+			if tt.asOwner {
+				props.OrganizerID = organizer.GetID()
+			}
+			if tt.eventID != "" {
+				props.EventID = tt.eventID
+			}
+
+			_, err := usecases.NewDeleteEventUseCase(f.uow).Execute(props)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+
+			_, findErr := f.events.FindByID(f.ctx, event.ID())
+			if deleted := findErr != nil; deleted == tt.wantErr {
+				t.Fatalf("expected deleted=%v, got %v", !tt.wantErr, deleted)
+			}
+		})
+	}
+}
+
+func TestGetEventByIdUseCase(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	attendee := f.addUser(t, "attendee@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10, attendee.GetID())
+
+	uc := usecases.NewGetEventByIdUseCase(f.events, f.users)
+
+	asOrganizer, err := uc.Execute(usecases.GetEventByIdUseCaseProps{Ctx: f.ctx, EventID: event.ID(), UserID: organizer.GetID()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(asOrganizer.Attendees) != 1 || asOrganizer.Attendees[0].Email != attendee.GetEmail() {
+		t.Fatalf("organizer should see attendee details, got %+v", asOrganizer.Attendees)
+	}
+
+	asAttendee, err := uc.Execute(usecases.GetEventByIdUseCaseProps{Ctx: f.ctx, EventID: event.ID(), UserID: attendee.GetID()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if asAttendee.Attendees != nil || asAttendee.AttendeesCount != 1 {
+		t.Fatalf("non-organizers should only see the count, got %+v", asAttendee)
+	}
+}
+
+func TestGetEventByOrganizerUseCase(t *testing.T) {
+	f := newFixture()
+	organizer := f.addUser(t, "organizer@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	event := f.addEvent(t, organizer.GetID(), 10, other.GetID())
+
+	uc := usecases.NewGetEventByOrganizerUseCase(f.events, f.users)
+
+	if _, err := uc.Execute(usecases.GetEventByOrganizerUseCaseProps{Ctx: f.ctx, OrganizerId: other.GetID(), EventId: event.ID()}); err == nil {
+		t.Fatalf("expected an error for a user that does not organize the event")
+	}
+
+	result, err := uc.Execute(usecases.GetEventByOrganizerUseCaseProps{Ctx: f.ctx, OrganizerId: organizer.GetID(), EventId: event.ID()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Attendees) != 1 {
+		t.Fatalf("expected 1 attendee, got %+v", result.Attendees)
+	}
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+// PasswordPolicy decides which new passwords are accepted, on top of the
+// letter and digit the request validation already requires.
+type PasswordPolicy struct {
+	MinLength int
+	// MaxBytes, when set, refuses longer passwords instead of letting the
+	// hasher cut or reject them.
+	MaxBytes int
+	// Breached holds lowercased passwords known from data breaches, refused
+	// whatever their length.
+	Breached map[string]struct{}
+}
+
+func (p PasswordPolicy) Check(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return exceptions.NewValidationException(fmt.Sprintf("Password must have at least %d characters", p.MinLength))
+	}
+	if p.MaxBytes > 0 && len(password) > p.MaxBytes {
+		return exceptions.NewValidationException(fmt.Sprintf("Password must have at most %d bytes", p.MaxBytes))
+	}
+	if _, ok := p.Breached[strings.ToLower(password)]; ok {
+		return exceptions.NewValidationException("This password appeared in a data breach, choose another one")
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+var resetLinkPattern = regexp.MustCompile(`https://app\.example\.com/reset-password\?token=(\S+)`)
+
+// requestReset runs the forgot-password flow and returns the raw token from
+// the mail that was sent.
+func (f *fixture) requestReset(t *testing.T, email string, ttl time.Duration) string {
+	t.Helper()
+
+	before := len(f.mailer.Sent())
+	uc := usecases.NewForgotPasswordUseCase(f.uow, f.mailer, "https://app.example.com", ttl)
+	if _, err := uc.Execute(dtos.ForgotPasswordDto{Ctx: f.ctx, Email: email}); err != nil {
+		t.Fatalf("forgot password: %v", err)
+	}
+
+	sent := f.mailer.Sent()
+	if len(sent) != before+1 {
+		t.Fatalf("expected one new mail, got %d", len(sent)-before)
+	}
+	match := resetLinkPattern.FindStringSubmatch(sent[len(sent)-1].Body)
+	if match == nil {
+		t.Fatalf("no reset link in mail: %q", sent[len(sent)-1].Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("invalid token in link: %v", err)
+	}
+	return token
+}
+
+func TestForgotPasswordDoesNotRevealUnknownEmails(t *testing.T) {
+	f := newFixture()
+	uc := usecases.NewForgotPasswordUseCase(f.uow, f.mailer, "https://app.example.com", time.Hour)
+
+	if _, err := uc.Execute(dtos.ForgotPasswordDto{Ctx: f.ctx, Email: "nobody@example.com"}); err != nil {
+		t.Fatalf("expected success for an unknown email, got %v", err)
+	}
+	if sent := f.mailer.Sent(); len(sent) != 0 {
+		t.Fatalf("expected no mail, got %+v", sent)
+	}
+}
+
+func TestForgotPasswordStoresOnlyTheTokenHash(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	token := f.requestReset(t, user.GetEmail(), time.Hour)
+
+	stored := f.tokens.All()
+	if len(stored) != 1 {
+		t.Fatalf("expected one stored token, got %d", len(stored))
+	}
+	if stored[0].GetTokenHash() == token || stored[0].GetTokenHash() != utils.HashToken(token) {
+		t.Fatalf("expected the token to be stored hashed")
+	}
+	if mail := f.mailer.Sent()[0]; mail.To != user.GetEmail() {
+		t.Fatalf("expected the mail to go to %s, got %s", user.GetEmail(), mail.To)
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		prepare func(t *testing.T, f *fixture, token string) string
+		wantErr bool
+	}{
+		{name: "valid token", ttl: time.Hour},
+		{name: "unknown token", ttl: time.Hour, prepare: func(t *testing.T, f *fixture, token string) string { return "not-a-token" }, wantErr: true},
+		{name: "expired token", ttl: time.Nanosecond, wantErr: true},
+		{
+			name: "superseded by a newer request",
+			ttl:  time.Hour,
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				f.requestReset(t, "user@example.com", time.Hour)
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "already used",
+			ttl:  time.Hour,
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				uc := usecases.NewResetPasswordUseCase(f.uow, f.hasher, f.policy)
+				if _, err := uc.Execute(dtos.ResetPasswordDto{Ctx: f.ctx, Token: token, Password: "first-reset1"}); err != nil {
+					t.Fatalf("first reset: %v", err)
+				}
+				return token
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			user := f.addUser(t, "user@example.com", "secret123")
+			token := f.requestReset(t, user.GetEmail(), tt.ttl)
+			if tt.prepare != nil {
+				token = tt.prepare(t, f, token)
+			}
+
+			before := time.Now()
+			uc := usecases.NewResetPasswordUseCase(f.uow, f.hasher, f.policy)
+			_, err := uc.Execute(dtos.ResetPasswordDto{Ctx: f.ctx, Token: token, Password: "new-secret1"})
+
+			stored := f.auth(t, user.GetID())
+
+			if tt.wantErr {
+				var validation *exceptions.ValidationException
+				if !errors.As(err, &validation) {
+					t.Fatalf("expected a ValidationException, got %v", err)
+				}
+				if f.hasher.Verify("new-secret1", stored.GetPasswordHash(), stored.GetAlgorithm()) {
+					t.Fatalf("password must not change on failure")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !f.hasher.Verify("new-secret1", stored.GetPasswordHash(), stored.GetAlgorithm()) {
+				t.Fatalf("expected the new password to be stored")
+			}
+			if changed := stored.GetPasswordChangedAt(); changed == nil || changed.Before(before) {
+				t.Fatalf("expected password_changed_at to be set, got %v", changed)
+			}
+		})
+	}
+}
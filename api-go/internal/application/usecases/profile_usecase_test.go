@@ -0,0 +1,136 @@
+package usecases_test
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+var confirmEmailLinkPattern = regexp.MustCompile(`https://api\.example\.com/auth/confirm-email\?token=(\S+)`)
+
+func strPtr(s string) *string { return &s }
+
+func (f *fixture) updateProfile(input dtos.UpdateProfileDto) (*dtos.ProfileDto, error) {
+	input.Ctx = f.ctx
+	return usecases.NewUpdateProfileUseCase(f.uow, f.hasher, f.mailer, "https://api.example.com", time.Hour).Execute(input)
+}
+
+func TestUpdateProfileOnlyChangesGivenFields(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	if _, err := f.updateProfile(dtos.UpdateProfileDto{UserID: user.GetID(), Bio: strPtr("Organizo meetups"), Phone: strPtr("+5511999999999")}); err != nil {
+		t.Fatalf("first update: %v", err)
+	}
+	profile, err := f.updateProfile(dtos.UpdateProfileDto{UserID: user.GetID(), Name: strPtr("Ana"), Company: strPtr("EventHub"), Phone: strPtr("")})
+	if err != nil {
+		t.Fatalf("second update: %v", err)
+	}
+
+	if profile.Name != "Ana" || profile.Bio != "Organizo meetups" || profile.Company != "EventHub" || profile.Phone != "" {
+		t.Fatalf("unexpected profile %+v", profile)
+	}
+	stored, err := usecases.NewGetProfileUseCase(f.users).Execute(usecases.GetProfileProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if *stored != *profile {
+		t.Fatalf("expected the update to be stored, got %+v", stored)
+	}
+	if sent := f.mailer.Sent(); len(sent) != 0 {
+		t.Fatalf("expected no mail without an email change, got %+v", sent)
+	}
+}
+
+func TestEmailChangeNeedsConfirmation(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	f.addUser(t, "taken@example.com", "secret123")
+
+	var validation *exceptions.ValidationException
+	if _, err := f.updateProfile(dtos.UpdateProfileDto{UserID: user.GetID(), Email: strPtr("new@example.com"), CurrentPassword: "wrong"}); !errors.As(err, &validation) {
+		t.Fatalf("expected the current password to be required, got %v", err)
+	}
+	var conflict *exceptions.ConflictException
+	if _, err := f.updateProfile(dtos.UpdateProfileDto{UserID: user.GetID(), Email: strPtr("taken@example.com"), CurrentPassword: "secret123"}); !errors.As(err, &conflict) {
+		t.Fatalf("expected a registered address to conflict, got %v", err)
+	}
+
+	profile, err := f.updateProfile(dtos.UpdateProfileDto{UserID: user.GetID(), Email: strPtr("new@example.com"), CurrentPassword: "secret123"})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if profile.Email != "user@example.com" || profile.PendingEmail != "new@example.com" {
+		t.Fatalf("expected the change to await confirmation, got %+v", profile)
+	}
+	f.loginSession(t, "user@example.com", "secret123", "10.0.0.1")
+
+	sent := f.mailer.Sent()
+	if len(sent) != 2 || sent[0].To != "new@example.com" || sent[1].To != "user@example.com" {
+		t.Fatalf("expected a link to the new address and a notice to the current one, got %+v", sent)
+	}
+	match := confirmEmailLinkPattern.FindStringSubmatch(sent[0].Body)
+	if match == nil {
+		t.Fatalf("no confirmation link in mail: %q", sent[0].Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("invalid token in link: %v", err)
+	}
+
+	confirm := usecases.NewConfirmEmailChangeUseCase(f.uow)
+	if _, err := confirm.Execute(dtos.ConfirmEmailChangeDto{Ctx: f.ctx, Token: token}); err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	stored, err := f.users.FindById(f.ctx, user.GetID())
+	if err != nil {
+		t.Fatalf("find user: %v", err)
+	}
+	if stored.GetEmail() != "new@example.com" || stored.GetPendingEmail() != "" || !stored.IsEmailVerified() {
+		t.Fatalf("expected the new address to be verified, got %q (pending %q)", stored.GetEmail(), stored.GetPendingEmail())
+	}
+	f.loginSession(t, "new@example.com", "secret123", "10.0.0.1")
+
+	if _, err := confirm.Execute(dtos.ConfirmEmailChangeDto{Ctx: f.ctx, Token: token}); !errors.As(err, &validation) {
+		t.Fatalf("expected the link to be single-use, got %v", err)
+	}
+}
+
+func TestChangePasswordLogsSessionsOut(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	oldSession := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	uc := usecases.NewChangePasswordUseCase(f.uow, f.hasher, f.policy, f.jwt)
+
+	var validation *exceptions.ValidationException
+	_, err := uc.Execute(dtos.ChangePasswordDto{Ctx: f.ctx, UserID: user.GetID(), CurrentPassword: "wrong", NewPassword: "newsecret1"})
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a wrong current password to be rejected, got %v", err)
+	}
+
+	result, err := uc.Execute(dtos.ChangePasswordDto{Ctx: f.ctx, UserID: user.GetID(), CurrentPassword: "secret123", NewPassword: "newsecret1", UserAgent: firefoxOnLinux})
+	if err != nil {
+		t.Fatalf("change password: %v", err)
+	}
+	claims, err := f.jwt.ExtractClaims(result.Token)
+	if err != nil {
+		t.Fatalf("extracting claims: %v", err)
+	}
+
+	authenticate := usecases.NewAuthenticateSessionUseCase(f.uow)
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: oldSession, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the previous session to be logged out, got %v", err)
+	}
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: claims["sid"].(string), UserID: user.GetID()}); err != nil {
+		t.Fatalf("expected the new session to be accepted, got %v", err)
+	}
+
+	f.loginSession(t, user.GetEmail(), "newsecret1", "10.0.0.1")
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type provisionUserUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+	policy PasswordPolicy
+}
+
+// NewProvisionUserUseCase creates accounts on behalf of an operator, of any
+// type, admins included. Their email counts as verified and no mail is sent.
+func NewProvisionUserUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, policy PasswordPolicy) *provisionUserUseCase {
+	return &provisionUserUseCase{uow: uow, hasher: hasher, policy: policy}
+}
+
+func (uc *provisionUserUseCase) Execute(props dtos.ProvisionUserDto) (*dtos.UserResponseDTO, error) {
+	if err := uc.policy.Check(props.Password); err != nil {
+		return nil, err
+	}
+
+	switch props.UserType {
+	case "participant", "organizer", models.UserTypeAdmin:
+	default:
+		return nil, exceptions.NewValidationException(fmt.Sprintf("Unknown user type %q", props.UserType))
+	}
+
+	now := time.Now()
+	user := models.NewUser(models.UserProps{
+		Name:            &props.Name,
+		Email:           &props.Email,
+		UserType:        &props.UserType,
+		EmailVerifiedAt: &now,
+	})
+	auth, err := newPasswordAuth(uc.hasher, user.GetID(), props.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		exists, err := repos.Users().ExistsByEmail(ctx, user.GetEmail())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exceptions.NewConflictException("Email already registered")
+		}
+
+		if err := repos.Users().Create(ctx, user); err != nil {
+			return err
+		}
+		if err := repos.Auths().Create(ctx, auth); err != nil {
+			return err
+		}
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionUserProvisioned, nil, user.GetID(), "",
+			map[string]string{"user_type": props.UserType, "method": props.Method}); err != nil {
+			return err
+		}
+		return acceptEventInvites(ctx, repos, user, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.UserResponseDTO{
+		ID:        user.GetID(),
+		Name:      user.GetName(),
+		Email:     user.GetEmail(),
+		UserType:  user.GetUserType(),
+		CreatedAt: user.GetCreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
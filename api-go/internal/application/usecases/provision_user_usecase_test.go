@@ -0,0 +1,85 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+func TestProvisionUser(t *testing.T) {
+	f := newFixture()
+	provision := usecases.NewProvisionUserUseCase(f.uow, f.hasher, f.policy)
+
+	created, err := provision.Execute(dtos.ProvisionUserDto{
+		Ctx: f.ctx, Name: "Root", Email: "root@example.com", Password: "secret123", UserType: models.UserTypeAdmin, Method: "cli",
+	})
+	if err != nil {
+		t.Fatalf("provision: %v", err)
+	}
+	user, err := f.users.FindById(f.ctx, created.ID)
+	if err != nil {
+		t.Fatalf("finding user: %v", err)
+	}
+	if !user.IsAdmin() || !user.IsEmailVerified() {
+		t.Fatalf("expected a verified admin, got type %q verified %v", user.GetUserType(), user.IsEmailVerified())
+	}
+	if len(f.mailer.Sent()) != 0 {
+		t.Fatalf("expected no mail, got %d", len(f.mailer.Sent()))
+	}
+	f.loginSession(t, "root@example.com", "secret123", "10.0.0.1")
+
+	entries := f.stores.AuditLog.All()
+	if entry := entries[0]; entry.GetAction() != models.AuditActionUserProvisioned || entry.GetActorID() != nil || entry.GetDetails()["method"] != "cli" {
+		t.Fatalf("expected an anonymous provisioning entry, got %s %v %v", entry.GetAction(), entry.GetActorID(), entry.GetDetails())
+	}
+
+	var (
+		conflict   *exceptions.ConflictException
+		validation *exceptions.ValidationException
+	)
+	tests := []struct {
+		name   string
+		props  dtos.ProvisionUserDto
+		target any
+	}{
+		{name: "taken email", props: dtos.ProvisionUserDto{Name: "Root", Email: "root@example.com", Password: "secret123", UserType: "organizer"}, target: &conflict},
+		{name: "unknown type", props: dtos.ProvisionUserDto{Name: "Root", Email: "other@example.com", Password: "secret123", UserType: "superuser"}, target: &validation},
+		{name: "breached password", props: dtos.ProvisionUserDto{Name: "Root", Email: "other@example.com", Password: "password1", UserType: "organizer"}, target: &validation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.props.Ctx = f.ctx
+			_, err := provision.Execute(tt.props)
+			if !errors.As(err, tt.target) {
+				t.Fatalf("expected %T, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestSetPassword(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+
+	if _, err := usecases.NewSetPasswordUseCase(f.uow, f.hasher, f.policy).Execute(dtos.SetPasswordDto{
+		Ctx: f.ctx, Email: user.GetEmail(), Password: "new-secret1", Method: "cli",
+	}); err != nil {
+		t.Fatalf("set password: %v", err)
+	}
+
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := usecases.NewAuthenticateSessionUseCase(f.uow).Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the open session to be logged out, got %v", err)
+	}
+	f.loginSession(t, user.GetEmail(), "new-secret1", "10.0.0.1")
+
+	var notFound *exceptions.NotFoundException
+	if _, err := usecases.NewSetPasswordUseCase(f.uow, f.hasher, f.policy).Execute(dtos.SetPasswordDto{Ctx: f.ctx, Email: "missing@example.com", Password: "new-secret1"}); !errors.As(err, &notFound) {
+		t.Fatalf("expected unknown emails to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type reactivateUserUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewReactivateUserUseCase(uow repositories.UnitOfWork) *reactivateUserUseCase {
+	return &reactivateUserUseCase{uow: uow}
+}
+
+// Execute lifts the suspension of the account, whose owner signs in again
+// to get a new session.
+func (uc *reactivateUserUseCase) Execute(props dtos.ReactivateUserDto) (dtos.AdminUserDto, error) {
+	var user models.User
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		if err := user.Reactivate(); err != nil {
+			return err
+		}
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), models.AuditActionUserReactivated, props.AdminID, models.AuditTargetUser, user.GetID(), props.IP, props.Reason, nil, nil, nil)
+	})
+	if err != nil {
+		return dtos.AdminUserDto{}, err
+	}
+	return newAdminUserDto(user), nil
+}
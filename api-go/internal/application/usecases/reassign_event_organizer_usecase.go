@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type reassignEventOrganizerUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewReassignEventOrganizerUseCase(uow repositories.UnitOfWork) *reassignEventOrganizerUseCase {
+	return &reassignEventOrganizerUseCase{uow: uow}
+}
+
+// Execute hands the event over to another user, who must have an active
+// account.
+func (uc *reassignEventOrganizerUseCase) Execute(props dtos.ReassignOrganizerDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		organizer, err := repos.Users().FindById(ctx, props.OrganizerID)
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) || (err == nil && (organizer.GetDeletedAt() != nil || organizer.IsSuspended())) {
+			return exceptions.NewValidationException(fmt.Sprintf("No active user with ID %s", props.OrganizerID))
+		}
+		if err != nil {
+			return err
+		}
+
+		event, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+		if event.OrganizerID() == organizer.GetID() {
+			return exceptions.NewConflictException("User already organizes this event")
+		}
+
+		before := eventAuditFields(event)
+		if err := event.TransferTo(organizer.GetID()); err != nil {
+			return err
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), models.AuditActionEventReassigned, props.AdminID, models.AuditTargetEvent, event.ID(), props.IP, props.Reason, nil, before, eventAuditFields(event))
+	})
+	return struct{}{}, err
+}
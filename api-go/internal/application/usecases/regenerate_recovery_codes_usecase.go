@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type regenerateRecoveryCodesUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewRegenerateRecoveryCodesUseCase(uow repositories.UnitOfWork) *regenerateRecoveryCodesUseCase {
+	return &regenerateRecoveryCodesUseCase{uow: uow}
+}
+
+// Execute replaces every recovery code, used or not, after checking a
+// current TOTP code.
+func (uc *regenerateRecoveryCodesUseCase) Execute(props dtos.RegenerateRecoveryCodesDto) (*dtos.RecoveryCodesDto, error) {
+	var codes []string
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		twoFactor, err := repos.TwoFactors().FindByUserIDForUpdate(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		if !twoFactor.IsEnabled() {
+			return exceptions.NewConflictException("Two-factor authentication is not enabled")
+		}
+
+		ok, err := checkSecondFactor(ctx, repos, twoFactor, props.Code, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return exceptions.NewValidationException("Invalid two-factor code")
+		}
+
+		codes, err = replaceRecoveryCodes(ctx, repos, props.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.RecoveryCodesDto{Codes: codes}, nil
+}
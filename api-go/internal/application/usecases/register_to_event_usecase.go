@@ -1,41 +1,65 @@
 package usecases
 
 import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 )
 
 type RegisterToEventUseCase struct {
-	userRepo  repositories.UserRepository
-	eventRepo repositories.IEventRepository
+	uow    repositories.UnitOfWork
+	policy EmailVerificationPolicy
 }
 
-func NewRegisterToEventUseCase(userRepo repositories.UserRepository, eventRepo repositories.IEventRepository) *RegisterToEventUseCase {
+func NewRegisterToEventUseCase(uow repositories.UnitOfWork, policy EmailVerificationPolicy) *RegisterToEventUseCase {
 	return &RegisterToEventUseCase{
-		userRepo:  userRepo,
-		eventRepo: eventRepo,
+		uow:    uow,
+		policy: policy,
 	}
 }
 
 type RegisterToEventUseCaseProps struct {
+	Ctx    context.Context `json:"-"`
 	UserId string
 	EventId string
+	// TicketType and Answers are checked against the ticket types and
+	// questions of the event.
+	TicketType string
+	Answers    map[string]string
+	IP         string
 }
 
 func (uc *RegisterToEventUseCase) Execute(input RegisterToEventUseCaseProps) ([]string, error) {
-	event, err := uc.eventRepo.FindByID(input.EventId)
-	if err != nil {
-		return nil, err
-	}
-	user, err := uc.userRepo.FindById(input.UserId)
-	if err != nil {
-		return nil, err
-	}
+	var event models.Event
 
-	if err := event.AddAttendee(user.GetID()); err != nil {
-		return nil, err
-	}
+	// The event row stays locked until commit so concurrent registrations
+	// cannot both pass the attendee limit check.
+	err := uc.uow.Do(input.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		event, err = repos.Events().FindByIDForUpdate(ctx, input.EventId)
+		if err != nil {
+			return err
+		}
+		user, err := repos.Users().FindById(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if err := uc.policy.Check(user); err != nil {
+			return err
+		}
 
-	if err := uc.eventRepo.Save(event); err != nil {
+		before := eventAuditFields(event)
+		if err := event.Register(user.GetID(), input.TicketType, input.Answers); err != nil {
+			return err
+		}
+
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditEvent(ctx, repos.AuditLog(), models.AuditActionEventRegistered, user.GetID(), event.ID(), input.IP, before, eventAuditFields(event))
+	})
+	if err != nil {
 		return nil, err
 	}
 	
@@ -0,0 +1,95 @@
+package usecases_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+)
+
+func TestRegisterToEventUseCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		attendees int
+		asOwner   bool
+		twice     bool
+		eventID   string
+		userID    string
+		wantErr   string
+	}{
+		{name: "registers attendee", limit: 2},
+		{name: "unlimited event accepts attendees", limit: 0, attendees: 5},
+		{name: "last free spot", limit: 3, attendees: 2},
+		{name: "attendee limit reached", limit: 2, attendees: 2, wantErr: "Event attendee limit reached"},
+		{name: "organizer cannot register", limit: 2, asOwner: true, wantErr: "Organizer cannot be an attendee"},
+		{name: "already registered", limit: 5, twice: true, wantErr: "Attendee already exists"},
+		{name: "unknown event", limit: 2, eventID: "missing", wantErr: "event with ID missing not found"},
+		{name: "unknown user", limit: 2, userID: "missing", wantErr: "user with ID missing not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			organizer := f.addUser(t, "organizer@example.com", "secret123")
+			user := f.addUser(t, "user@example.com", "secret123")
+
+			var existing []string
+			for i := 0; i < tt.attendees; i++ {
+				other := f.addUser(t, "other"+string(rune('a'+i))+"@example.com", "secret123")
+				existing = append(existing, other.GetID())
+			}
+			event := f.addEvent(t, organizer.GetID(), tt.limit, existing...)
+
+			props := usecases.RegisterToEventUseCaseProps{
+				Ctx:     f.ctx,
+				UserId:  user.GetID(),
+				EventId: event.ID(),
+			}
+			if tt.asOwner {
+				props.UserId = organizer.GetID()
+			}
+			if tt.eventID != "" {
+				props.EventId = tt.eventID
+			}
+			if tt.userID != "" {
+				props.UserId = tt.userID
+			}
+
+			uc := usecases.NewRegisterToEventUseCase(f.uow, usecases.EmailVerificationPolicy{})
+			if tt.twice {
+				if _, err := uc.Execute(props); err != nil {
+					t.Fatalf("first registration: %v", err)
+				}
+			}
+
+			attendees, err := uc.Execute(props)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				if tt.eventID == "" && len(f.attendees(t, event.ID())) != tt.attendees+btoi(tt.twice) {
+					t.Fatalf("attendees changed after a failed registration: %v", f.attendees(t, event.ID()))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Contains(attendees, user.GetID()) {
+				t.Fatalf("expected %s in attendees %v", user.GetID(), attendees)
+			}
+			if stored := f.attendees(t, event.ID()); len(stored) != tt.attendees+1 {
+				t.Fatalf("expected %d stored attendees, got %v", tt.attendees+1, stored)
+			}
+		})
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type removeAttendeeUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewRemoveAttendeeUseCase(uow repositories.UnitOfWork) *removeAttendeeUseCase {
+	return &removeAttendeeUseCase{uow: uow}
+}
+
+// Execute cancels the registration of an attendee on their behalf.
+func (uc *removeAttendeeUseCase) Execute(props dtos.RemoveAttendeeDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		event, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+		before := eventAuditFields(event)
+		if err := event.CancelSubscription(props.UserID); err != nil {
+			return err
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), models.AuditActionAttendeeRemoved, props.AdminID, models.AuditTargetEvent, event.ID(), props.IP, props.Reason,
+			map[string]string{"user_id": props.UserID}, before, eventAuditFields(event))
+	})
+	return struct{}{}, err
+}
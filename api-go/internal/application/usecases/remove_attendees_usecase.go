@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type removeAttendeesUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewRemoveAttendeesUseCase(uow repositories.UnitOfWork) *removeAttendeesUseCase {
+	return &removeAttendeesUseCase{uow: uow}
+}
+
+// Execute cancels the registrations of the users on their behalf. Users
+// who are not attendees fail alone, the others are still removed.
+func (uc *removeAttendeesUseCase) Execute(props dtos.RemoveAttendeesDto) (*dtos.BulkAttendeesResultDto, error) {
+	var (
+		event   models.Event
+		results []dtos.AttendeeOutcomeDto
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		event, err = findOrganizedEventForUpdate(ctx, repos.Events(), props.EventID, props.OrganizerID)
+		if err != nil {
+			return err
+		}
+
+		var removed []string
+		before := eventAuditFields(event)
+		for _, userID := range props.UserIDs {
+			outcome := dtos.AttendeeOutcomeDto{UserID: userID, Status: dtos.AttendeeRemoved}
+			if err := event.CancelSubscription(userID); err != nil {
+				if !attendeeRuleError(err) {
+					return err
+				}
+				outcome.Status = dtos.AttendeeFailed
+				outcome.Error = err.Error()
+			} else {
+				removed = append(removed, userID)
+			}
+			results = append(results, outcome)
+		}
+
+		if len(removed) == 0 {
+			return nil
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		details := map[string]string{}
+		setJoined(details, "user_ids", removed)
+		return auditAttendees(ctx, repos.AuditLog(), models.AuditActionAttendeesRemoved, props.OrganizerID, event.ID(), props.IP, details, before, eventAuditFields(event))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.BulkAttendeesResultDto{Results: results, Attendees: event.Attendees()}, nil
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type resendVerificationUseCase struct {
+	uow    repositories.UnitOfWork
+	mailer services.IMailer
+	apiURL string
+	ttl    time.Duration
+	window time.Duration
+}
+
+// NewResendVerificationUseCase sends at most one verification email per
+// user every `window`.
+func NewResendVerificationUseCase(uow repositories.UnitOfWork, mailer services.IMailer, apiURL string, ttl, window time.Duration) *resendVerificationUseCase {
+	return &resendVerificationUseCase{uow: uow, mailer: mailer, apiURL: apiURL, ttl: ttl, window: window}
+}
+
+type ResendVerificationProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+}
+
+func (uc *resendVerificationUseCase) Execute(props ResendVerificationProps) (struct{}, error) {
+	var (
+		user     models.User
+		rawToken string
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		user, err = repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		if user.IsEmailVerified() {
+			return exceptions.NewConflictException("Email already verified")
+		}
+
+		latest, err := repos.UserTokens().FindLatestForUser(ctx, user.GetID(), models.TokenPurposeEmailVerification)
+		var notFound *exceptions.NotFoundException
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+		if err == nil {
+			if wait := uc.window - time.Since(latest.GetCreatedAt()); wait > 0 {
+				return exceptions.NewTooManyRequestsException("A verification email was sent recently, please wait before requesting another", wait)
+			}
+		}
+
+		rawToken, err = issueUserToken(ctx, repos.UserTokens(), user.GetID(), models.TokenPurposeEmailVerification, uc.ttl)
+		return err
+	})
+	if err != nil {
+		return struct{}{}, err
+	}
+
+	return struct{}{}, sendVerificationEmail(props.Ctx, uc.mailer, uc.apiURL, user, rawToken, uc.ttl)
+}
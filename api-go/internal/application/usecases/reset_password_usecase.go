@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type resetPasswordUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+	policy PasswordPolicy
+}
+
+func NewResetPasswordUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, policy PasswordPolicy) *resetPasswordUseCase {
+	return &resetPasswordUseCase{uow: uow, hasher: hasher, policy: policy}
+}
+
+// Execute consumes the reset token and replaces the password. Changing the
+// password also logs every session out and unlocks the account if failed
+// logins locked it.
+func (uc *resetPasswordUseCase) Execute(props dtos.ResetPasswordDto) (struct{}, error) {
+	if err := uc.policy.Check(props.Password); err != nil {
+		return struct{}{}, err
+	}
+	hash, algorithm, err := uc.hasher.Hash(props.Password)
+	if err != nil {
+		return struct{}{}, err
+	}
+
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		token, err := repos.UserTokens().FindByHashForUpdate(ctx, models.TokenPurposePasswordReset, utils.HashToken(props.Token))
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			return exceptions.NewValidationException("Invalid or expired token")
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := token.Use(now); err != nil {
+			return err
+		}
+
+		user, err := repos.Users().FindById(ctx, token.GetUserID())
+		if err != nil {
+			return err
+		}
+		auth, err := repos.Auths().FindByUserID(ctx, user.GetID())
+		if err != nil {
+			return err
+		}
+		auth.SetPassword(hash, algorithm, now)
+
+		if err := repos.Auths().Save(ctx, auth); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().Save(ctx, token); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().RevokeForUser(ctx, user.GetID(), models.TokenPurposePasswordReset, now); err != nil {
+			return err
+		}
+		if err := repos.Sessions().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+		return unlockAccount(ctx, repos, user, props.IP, "password_reset", now)
+	})
+
+	return struct{}{}, err
+}
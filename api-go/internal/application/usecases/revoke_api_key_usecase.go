@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type revokeAPIKeyUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewRevokeAPIKeyUseCase(uow repositories.UnitOfWork) *revokeAPIKeyUseCase {
+	return &revokeAPIKeyUseCase{uow: uow}
+}
+
+type RevokeAPIKeyProps struct {
+	Ctx    context.Context `json:"-"`
+	UserID string
+	KeyID  string
+	IP     string
+}
+
+// Execute revokes one of the user's keys; keys of other users are reported
+// as not found.
+func (uc *revokeAPIKeyUseCase) Execute(props RevokeAPIKeyProps) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		key, err := repos.APIKeys().FindByID(ctx, props.KeyID)
+		if err != nil {
+			return err
+		}
+		if key.GetUserID() != props.UserID {
+			return exceptions.NewNotFoundException("API key not found")
+		}
+
+		if err := key.Revoke(time.Now()); err != nil {
+			return err
+		}
+		if err := repos.APIKeys().Save(ctx, key); err != nil {
+			return err
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionAPIKeyRevoked, &props.UserID, props.UserID, props.IP,
+			map[string]string{"api_key_id": key.GetID(), "name": key.GetName()})
+	})
+	return struct{}{}, err
+}
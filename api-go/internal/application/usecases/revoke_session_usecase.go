@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type revokeSessionUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewRevokeSessionUseCase(uow repositories.UnitOfWork) *revokeSessionUseCase {
+	return &revokeSessionUseCase{uow: uow}
+}
+
+type RevokeSessionProps struct {
+	Ctx       context.Context `json:"-"`
+	UserID    string
+	SessionID string
+	IP        string
+}
+
+// Execute logs one of the user's sessions out, which may be the current one;
+// sessions of other users are reported as not found. Requests authenticated
+// by an API key have no session, so they are rejected rather than reported
+// as logged out.
+func (uc *revokeSessionUseCase) Execute(props RevokeSessionProps) (struct{}, error) {
+	if props.SessionID == "" {
+		return struct{}{}, exceptions.NewValidationException("The request has no session to log out; API keys are revoked from /users/me/api-keys")
+	}
+
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		session, err := repos.Sessions().FindByID(ctx, props.SessionID)
+		if err != nil {
+			return err
+		}
+		if session.GetUserID() != props.UserID {
+			return exceptions.NewNotFoundException("Session not found")
+		}
+
+		if err := session.Revoke(time.Now()); err != nil {
+			return err
+		}
+		if err := repos.Sessions().Save(ctx, session); err != nil {
+			return err
+		}
+		return auditUser(ctx, repos.AuditLog(), models.AuditActionSessionRevoked, &props.UserID, props.UserID, props.IP,
+			map[string]string{"session_id": session.GetID(), "device": session.GetDevice()})
+	})
+	return struct{}{}, err
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+const defaultUserSearchLimit = 100
+
+type searchUsersUseCase struct {
+	users repositories.UserRepository
+}
+
+func NewSearchUsersUseCase(users repositories.UserRepository) *searchUsersUseCase {
+	return &searchUsersUseCase{users: users}
+}
+
+// Execute lists the users matching the search, newest first, to admins
+// only.
+func (uc *searchUsersUseCase) Execute(props dtos.UserSearchDto) ([]dtos.AdminUserDto, error) {
+	if err := requireAdmin(props.Ctx, uc.users, props.AdminID); err != nil {
+		return nil, err
+	}
+
+	filter := repositories.UserFilter{
+		Term:     props.Term,
+		UserType: props.UserType,
+		Status:   props.Status,
+		Limit:    props.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultUserSearchLimit
+	}
+
+	users, err := uc.users.Search(props.Ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.AdminUserDto, 0, len(users))
+	for _, user := range users {
+		result = append(result, newAdminUserDto(user))
+	}
+	return result, nil
+}
@@ -0,0 +1,147 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+const firefoxOnLinux = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"
+
+// loginSession logs the user in and returns the session of the token.
+func (f *fixture) loginSession(t *testing.T, email, password, ip string) string {
+	t.Helper()
+
+	result, err := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard).
+		Execute(dtos.LoginDto{Ctx: f.ctx, Email: email, Password: password, IP: ip, UserAgent: firefoxOnLinux})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	claims, err := f.jwt.ExtractClaims(result.Token)
+	if err != nil {
+		t.Fatalf("extracting claims: %v", err)
+	}
+	sessionID, _ := claims["sid"].(string)
+	if sessionID == "" {
+		t.Fatalf("expected a sid claim, got %v", claims)
+	}
+	return sessionID
+}
+
+func TestLoginOpensSession(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	first := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	second := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.2")
+	if first == second {
+		t.Fatalf("expected each login to open its own session")
+	}
+
+	sessions, err := usecases.NewListSessionsUseCase(f.stores.Sessions).Execute(usecases.ListSessionsProps{
+		Ctx:              f.ctx,
+		UserID:           user.GetID(),
+		CurrentSessionID: second,
+	})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected two sessions, got %+v", sessions)
+	}
+	for _, session := range sessions {
+		if session.Device != "Firefox on Linux" || session.UserAgent != firefoxOnLinux {
+			t.Fatalf("unexpected device of %+v", session)
+		}
+		if session.Current != (session.ID == second) {
+			t.Fatalf("expected only %s to be current, got %+v", second, session)
+		}
+	}
+}
+
+func TestRevokedSessionIsRejected(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	other := f.addUser(t, "other@example.com", "secret123")
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+
+	authenticate := usecases.NewAuthenticateSessionUseCase(f.uow)
+	props := usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID(), IP: "10.0.0.3"}
+	if _, err := authenticate.Execute(props); err != nil {
+		t.Fatalf("expected the session to be accepted, got %v", err)
+	}
+	if stored, _ := f.stores.Sessions.FindByID(f.ctx, sessionID); stored.GetIP() != "10.0.0.3" {
+		t.Fatalf("expected the new address to be recorded, got %q", stored.GetIP())
+	}
+
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: other.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected a session of another user to be rejected, got %v", err)
+	}
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected tokens without a session to be rejected, got %v", err)
+	}
+
+	revoke := usecases.NewRevokeSessionUseCase(f.uow)
+	var validation *exceptions.ValidationException
+	if _, err := revoke.Execute(usecases.RevokeSessionProps{Ctx: f.ctx, UserID: user.GetID()}); !errors.As(err, &validation) {
+		t.Fatalf("expected requests without a session not to log out, got %v", err)
+	}
+	var notFound *exceptions.NotFoundException
+	if _, err := revoke.Execute(usecases.RevokeSessionProps{Ctx: f.ctx, UserID: other.GetID(), SessionID: sessionID}); !errors.As(err, &notFound) {
+		t.Fatalf("expected sessions of other users to be hidden, got %v", err)
+	}
+	if _, err := revoke.Execute(usecases.RevokeSessionProps{Ctx: f.ctx, UserID: user.GetID(), SessionID: sessionID, IP: "10.0.0.1"}); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+
+	if _, err := authenticate.Execute(props); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the revoked session to be rejected, got %v", err)
+	}
+	sessions, err := usecases.NewListSessionsUseCase(f.stores.Sessions).Execute(usecases.ListSessionsProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil || len(sessions) != 0 {
+		t.Fatalf("expected no sessions left, got %+v (%v)", sessions, err)
+	}
+
+	var conflict *exceptions.ConflictException
+	if _, err := revoke.Execute(usecases.RevokeSessionProps{Ctx: f.ctx, UserID: user.GetID(), SessionID: sessionID}); !errors.As(err, &conflict) {
+		t.Fatalf("expected revoking twice to conflict, got %v", err)
+	}
+
+	actions := f.auditActions()
+	if len(actions) == 0 || actions[len(actions)-1] != models.AuditActionSessionRevoked {
+		t.Fatalf("expected the revocation to be audited, got %v", actions)
+	}
+}
+
+func TestSessionsStartedBeforeAPasswordChangeAreRejected(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	sessionID := f.loginSession(t, user.GetEmail(), "secret123", "10.0.0.1")
+	// The change lands in the same second as the login, which whole-second
+	// iat claims cannot tell apart.
+	auth := f.auth(t, user.GetID())
+	hash, algorithm, err := f.hasher.Hash("newsecret1")
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+	auth.SetPassword(hash, algorithm, time.Now())
+	if err := f.auths.Save(f.ctx, auth); err != nil {
+		t.Fatalf("saving credentials: %v", err)
+	}
+
+	authenticate := usecases.NewAuthenticateSessionUseCase(f.uow)
+	var unauthorized *exceptions.UnauthorizedException
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: sessionID, UserID: user.GetID()}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected the session to end with the password change, got %v", err)
+	}
+	newSessionID := f.loginSession(t, user.GetEmail(), "newsecret1", "10.0.0.1")
+	if _, err := authenticate.Execute(usecases.AuthenticateSessionProps{Ctx: f.ctx, SessionID: newSessionID, UserID: user.GetID()}); err != nil {
+		t.Fatalf("expected a login after the change to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type setEventPublishedUseCase struct {
+	uow       repositories.UnitOfWork
+	published bool
+}
+
+// NewSetEventPublishedUseCase republishes events when published is true and
+// unpublishes them otherwise.
+func NewSetEventPublishedUseCase(uow repositories.UnitOfWork, published bool) *setEventPublishedUseCase {
+	return &setEventPublishedUseCase{uow: uow, published: published}
+}
+
+func (uc *setEventPublishedUseCase) Execute(props dtos.ModerateEventDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		event, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
+
+		action := models.AuditActionEventRepublished
+		if uc.published {
+			err = event.Republish()
+		} else {
+			action = models.AuditActionEventUnpublished
+			err = event.Unpublish(time.Now())
+		}
+		if err != nil {
+			return err
+		}
+		if err := repos.Events().Save(ctx, event); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), action, props.AdminID, models.AuditTargetEvent, event.ID(), props.IP, props.Reason, nil, nil, nil)
+	})
+	return struct{}{}, err
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type setPasswordUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+	policy PasswordPolicy
+}
+
+func NewSetPasswordUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, policy PasswordPolicy) *setPasswordUseCase {
+	return &setPasswordUseCase{uow: uow, hasher: hasher, policy: policy}
+}
+
+// Execute replaces the password like a reset through the emailed link
+// would: every session is logged out, pending reset links stop working and
+// a locked account is unlocked.
+func (uc *setPasswordUseCase) Execute(props dtos.SetPasswordDto) (struct{}, error) {
+	if err := uc.policy.Check(props.Password); err != nil {
+		return struct{}{}, err
+	}
+	hash, algorithm, err := uc.hasher.Hash(props.Password)
+	if err != nil {
+		return struct{}{}, err
+	}
+
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		user, err := repos.Users().FindByEmail(ctx, props.Email)
+		if err != nil {
+			return err
+		}
+		auth, err := repos.Auths().FindByUserID(ctx, user.GetID())
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		auth.SetPassword(hash, algorithm, now)
+		if err := repos.Auths().Save(ctx, auth); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().RevokeForUser(ctx, user.GetID(), models.TokenPurposePasswordReset, now); err != nil {
+			return err
+		}
+		if err := repos.Sessions().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+		if err := auditUser(ctx, repos.AuditLog(), models.AuditActionPasswordChanged, nil, user.GetID(), "", map[string]string{"method": props.Method}); err != nil {
+			return err
+		}
+		return unlockAccount(ctx, repos, user, "", props.Method, now)
+	})
+	return struct{}{}, err
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type startOIDCLoginUseCase struct {
+	providers map[string]services.IOIDCProvider
+}
+
+// NewStartOIDCLoginUseCase serves the providers configured by name.
+func NewStartOIDCLoginUseCase(providers map[string]services.IOIDCProvider) *startOIDCLoginUseCase {
+	return &startOIDCLoginUseCase{providers: providers}
+}
+
+type StartOIDCLoginProps struct {
+	Ctx      context.Context `json:"-"`
+	Provider string
+}
+
+// Execute generates the state, nonce and PKCE verifier of a new login and
+// the provider URL carrying them.
+func (uc *startOIDCLoginUseCase) Execute(props StartOIDCLoginProps) (*dtos.OIDCLoginStartDto, error) {
+	provider, ok := uc.providers[props.Provider]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Unknown identity provider")
+	}
+
+	// Opaque tokens are 43 URL-safe characters, a valid PKCE verifier.
+	values := make([]string, 3)
+	for i := range values {
+		value, err := utils.NewOpaqueToken()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	authURL, err := provider.AuthCodeURL(props.Ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.OIDCLoginStartDto{
+		AuthURL:      authURL,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+	}, nil
+}
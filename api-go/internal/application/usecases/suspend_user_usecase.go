@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+type suspendUserUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewSuspendUserUseCase(uow repositories.UnitOfWork) *suspendUserUseCase {
+	return &suspendUserUseCase{uow: uow}
+}
+
+// Execute suspends the account and signs it out everywhere. Its API keys
+// are refused while the suspension lasts.
+func (uc *suspendUserUseCase) Execute(props dtos.SuspendUserDto) (dtos.AdminUserDto, error) {
+	if props.UserID == props.AdminID {
+		return dtos.AdminUserDto{}, exceptions.NewValidationException("You cannot suspend your own account")
+	}
+
+	var user models.User
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := requireAdmin(ctx, repos.Users(), props.AdminID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if err := user.Suspend(now, props.Reason); err != nil {
+			return err
+		}
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return err
+		}
+		if err := repos.Sessions().RevokeForUser(ctx, user.GetID(), now); err != nil {
+			return err
+		}
+		return auditModeration(ctx, repos.AuditLog(), models.AuditActionUserSuspended, props.AdminID, models.AuditTargetUser, user.GetID(), props.IP, props.Reason, nil, nil, nil)
+	})
+	if err != nil {
+		return dtos.AdminUserDto{}, err
+	}
+	return newAdminUserDto(user), nil
+}
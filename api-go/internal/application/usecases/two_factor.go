@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+const recoveryCodeCount = 10
+
+// checkSecondFactor accepts the current TOTP code or, when allowRecovery is
+// set, an unused recovery code, and records its use. It returns false for a
+// wrong, replayed or already used code.
+func checkSecondFactor(ctx context.Context, repos repositories.Repositories, twoFactor models.TwoFactor, code string, allowRecovery bool) (bool, error) {
+	now := time.Now()
+
+	if step, ok := utils.MatchTOTP(twoFactor.GetSecret(), code, now); ok {
+		if err := twoFactor.UseStep(step); err != nil {
+			return false, nil
+		}
+		return true, repos.TwoFactors().Save(ctx, twoFactor)
+	}
+
+	if !allowRecovery {
+		return false, nil
+	}
+
+	codes, err := repos.RecoveryCodes().FindUnusedByUser(ctx, twoFactor.GetUserID())
+	if err != nil {
+		return false, err
+	}
+	hash := utils.HashToken(normalizeRecoveryCode(code))
+	for _, recovery := range codes {
+		if subtle.ConstantTimeCompare([]byte(recovery.GetCodeHash()), []byte(hash)) != 1 {
+			continue
+		}
+		if err := recovery.Use(now); err != nil {
+			return false, nil
+		}
+		return true, repos.RecoveryCodes().Save(ctx, recovery)
+	}
+
+	return false, nil
+}
+
+// replaceRecoveryCodes invalidates the user's recovery codes and returns a
+// new set in the "XXXXX-XXXXX" format shown to the user.
+func replaceRecoveryCodes(ctx context.Context, repos repositories.Repositories, userID string) ([]string, error) {
+	raw := make([]string, 0, recoveryCodeCount)
+	codes := make([]models.RecoveryCode, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		encoded := base32.StdEncoding.EncodeToString(b)[:10]
+
+		hash := utils.HashToken(encoded)
+		code, err := models.NewRecoveryCode(models.RecoveryCodeProps{UserID: &userID, CodeHash: &hash})
+		if err != nil {
+			return nil, err
+		}
+
+		raw = append(raw, encoded[:5]+"-"+encoded[5:])
+		codes = append(codes, code)
+	}
+
+	if err := repos.RecoveryCodes().ReplaceForUser(ctx, userID, codes); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
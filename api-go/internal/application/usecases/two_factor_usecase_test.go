@@ -0,0 +1,170 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// totpCode returns the code for the current step shifted by offset steps.
+func totpCode(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(time.Now())+offset)
+	if err != nil {
+		t.Fatalf("generating code: %v", err)
+	}
+	return code
+}
+
+// enableTwoFactor enrolls and confirms the user, returning the secret and the
+// recovery codes.
+func (f *fixture) enableTwoFactor(t *testing.T, userID string) (string, []string) {
+	t.Helper()
+
+	enrollment, err := usecases.NewEnrollTwoFactorUseCase(f.uow, "EventHub").Execute(usecases.EnrollTwoFactorProps{Ctx: f.ctx, UserID: userID})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+
+	codes, err := usecases.NewConfirmTwoFactorUseCase(f.uow).Execute(dtos.ConfirmTwoFactorDto{
+		Ctx:    f.ctx,
+		UserID: userID,
+		Code:   totpCode(t, enrollment.Secret, 0),
+	})
+	if err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	return enrollment.Secret, codes.Codes
+}
+
+func TestEnrollTwoFactorReturnsProvisioningData(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	enrollment, err := usecases.NewEnrollTwoFactorUseCase(f.uow, "EventHub").Execute(usecases.EnrollTwoFactorProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if enrollment.Secret == "" || enrollment.QRCode == "" {
+		t.Fatalf("expected a secret and a QR code, got %+v", enrollment)
+	}
+	if want := utils.TOTPURI("EventHub", user.GetEmail(), enrollment.Secret); enrollment.OTPAuthURI != want {
+		t.Fatalf("expected URI %q, got %q", want, enrollment.OTPAuthURI)
+	}
+
+	stored, err := f.stores.TwoFactors.FindByUserID(f.ctx, user.GetID())
+	if err != nil {
+		t.Fatalf("enrollment was not stored: %v", err)
+	}
+	if stored.IsEnabled() {
+		t.Fatalf("enrollment must stay disabled until confirmed")
+	}
+}
+
+func TestConfirmTwoFactorRejectsWrongCode(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+
+	enrollment, err := usecases.NewEnrollTwoFactorUseCase(f.uow, "EventHub").Execute(usecases.EnrollTwoFactorProps{Ctx: f.ctx, UserID: user.GetID()})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+
+	_, err = usecases.NewConfirmTwoFactorUseCase(f.uow).Execute(dtos.ConfirmTwoFactorDto{
+		Ctx:    f.ctx,
+		UserID: user.GetID(),
+		Code:   totpCode(t, enrollment.Secret, 5),
+	})
+	var validation *exceptions.ValidationException
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestEnrollTwoFactorConflictsWhenEnabled(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	f.enableTwoFactor(t, user.GetID())
+
+	_, err := usecases.NewEnrollTwoFactorUseCase(f.uow, "EventHub").Execute(usecases.EnrollTwoFactorProps{Ctx: f.ctx, UserID: user.GetID()})
+	var conflict *exceptions.ConflictException
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+}
+
+func TestLoginWithTwoFactor(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	secret, recoveryCodes := f.enableTwoFactor(t, user.GetID())
+
+	result, err := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard).Execute(dtos.LoginDto{Ctx: f.ctx, Email: user.GetEmail(), Password: "secret123"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !result.TwoFactorRequired || result.Token != "" || result.ChallengeToken == "" {
+		t.Fatalf("expected only a challenge, got %+v", result)
+	}
+
+	secondStep := usecases.NewLoginTwoFactorUseCase(f.uow, f.jwt, f.guard)
+	login := func(code string) (*dtos.LoginResultDto, error) {
+		return secondStep.Execute(dtos.TwoFactorLoginDto{Ctx: f.ctx, ChallengeToken: result.ChallengeToken, Code: code})
+	}
+	var unauthorized *exceptions.UnauthorizedException
+
+	// The confirmation used the current step, so the next one is still fresh.
+	code := totpCode(t, secret, 1)
+	completed, err := login(code)
+	if err != nil {
+		t.Fatalf("login with TOTP: %v", err)
+	}
+	if claims, err := f.jwt.ExtractClaims(completed.Token); err != nil || claims["sub"] != user.GetID() || claims["sid"] == "" {
+		t.Fatalf("expected a token for %s, got %v (%v)", user.GetID(), claims, err)
+	}
+
+	if _, err := login(code); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected a replayed code to be rejected, got %v", err)
+	}
+
+	if _, err := login(recoveryCodes[0]); err != nil {
+		t.Fatalf("login with recovery code: %v", err)
+	}
+	if _, err := login(recoveryCodes[0]); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected a used recovery code to be rejected, got %v", err)
+	}
+
+	if _, err := secondStep.Execute(dtos.TwoFactorLoginDto{Ctx: f.ctx, ChallengeToken: "fake-token:" + user.GetID(), Code: recoveryCodes[1]}); !errors.As(err, &unauthorized) {
+		t.Fatalf("expected an access token to be refused as challenge, got %v", err)
+	}
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	f := newFixture()
+	user := f.addUser(t, "user@example.com", "secret123")
+	_, recoveryCodes := f.enableTwoFactor(t, user.GetID())
+	uc := usecases.NewDisableTwoFactorUseCase(f.uow, f.hasher)
+
+	var validation *exceptions.ValidationException
+	_, err := uc.Execute(dtos.DisableTwoFactorDto{Ctx: f.ctx, UserID: user.GetID(), Password: "wrong", Code: recoveryCodes[0]})
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a wrong password to be rejected, got %v", err)
+	}
+
+	if _, err := uc.Execute(dtos.DisableTwoFactorDto{Ctx: f.ctx, UserID: user.GetID(), Password: "secret123", Code: recoveryCodes[0]}); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+
+	result, err := usecases.NewLoginUseCase(f.auths, f.users, f.stores.TwoFactors, f.stores.Sessions, f.hasher, f.jwt, f.guard).Execute(dtos.LoginDto{Ctx: f.ctx, Email: user.GetEmail(), Password: "secret123"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if result.TwoFactorRequired || result.Token == "" {
+		t.Fatalf("expected a plain login after disabling, got %+v", result)
+	}
+}
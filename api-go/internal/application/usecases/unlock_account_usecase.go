@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type unlockAccountUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewUnlockAccountUseCase(uow repositories.UnitOfWork) *unlockAccountUseCase {
+	return &unlockAccountUseCase{uow: uow}
+}
+
+// Execute consumes the link mailed when the account was locked and lifts
+// the lock before it expires.
+func (uc *unlockAccountUseCase) Execute(props dtos.UnlockAccountDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		token, err := repos.UserTokens().FindByHashForUpdate(ctx, models.TokenPurposeAccountUnlock, utils.HashToken(props.Token))
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			return exceptions.NewValidationException("Invalid or expired token")
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := token.Use(now); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().Save(ctx, token); err != nil {
+			return err
+		}
+
+		user, err := repos.Users().FindById(ctx, token.GetUserID())
+		if err != nil {
+			return err
+		}
+		return unlockAccount(ctx, repos, user, props.IP, "email_link", now)
+	})
+
+	return struct{}{}, err
+}
@@ -1,63 +1,83 @@
 package usecases
 
 import (
-	"time"
+	"context"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
-	"github.com/Gabriel-Schiestl/go-clarch/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
 )
 
 type updateEventUseCase struct {
-	eventRepository repositories.IEventRepository
+	uow repositories.UnitOfWork
 }
 
-func NewUpdateEventUseCase(eventRepository repositories.IEventRepository) *updateEventUseCase {
+func NewUpdateEventUseCase(uow repositories.UnitOfWork) *updateEventUseCase {
 	return &updateEventUseCase{
-		eventRepository: eventRepository,
+		uow: uow,
 	}
 }
 
 func (uc *updateEventUseCase) Execute(props dtos.UpdateEventProps) (*dtos.EventDto, error) {
-	// Verifica se o evento existe
-	existingEvent, err := uc.eventRepository.FindByID(props.EventID)
+	// Parse da data
+	parsedDate, err := utils.ParseEventDate(props.Date, props.Timezone)
 	if err != nil {
-		return nil, err
+		return nil, exceptions.NewValidationException("Invalid event date, expected format YYYY-MM-DDTHH:MM")
 	}
 
-	// Verifica se o usuário é o organizador do evento
-	if existingEvent.OrganizerID() != props.OrganizerID {
-		return nil, exceptions.NewBusinessException("User is not authorized to update this event")
-	}
+	var updatedEvent models.Event
+	err = uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		// Verifica se o evento existe
+		existingEvent, err := repos.Events().FindByIDForUpdate(ctx, props.EventID)
+		if err != nil {
+			return err
+		}
 
-	// Parse da data
-	parsedDate, err := time.Parse("2006-01-02T15:04", props.Date)
-	if err != nil {
-		return nil, err
-	}
+		// Verifica se o usuário é o organizador do evento
+		if existingEvent.OrganizerID() != props.OrganizerID {
+			return exceptions.NewForbiddenException("User is not authorized to update this event")
+		}
 
-	// Cria o evento atualizado mantendo ID, attendees e createdAt originais
-	originalCreatedAt := existingEvent.CreatedAt()
-	updatedEvent, businessErr := models.NewEvent(models.EventProps{
-		ID:          &props.EventID,
-		Name:        &props.Name,
-		Location:    &props.Location,
-		Date:        &parsedDate,
-		Description: &props.Description,
-		OrganizerID: &props.OrganizerID,
-		Category:    &props.Category,
-		Limit:       &props.Limit,
-		Attendees:   existingEvent.Attendees(),
-		CreatedAt:   &originalCreatedAt,
-	})
-	if businessErr != nil {
-		return nil, businessErr
-	}
+		// Cria o evento atualizado mantendo ID, attendees, createdAt e a
+		// despublicação originais
+		originalCreatedAt := existingEvent.CreatedAt()
+		ticketTypes, questions := props.TicketTypes, props.Questions
+		if ticketTypes == nil {
+			ticketTypes = existingEvent.TicketTypes()
+		}
+		if questions == nil {
+			questions = existingEvent.Questions()
+		}
+		var businessErr error
+		updatedEvent, businessErr = models.NewEvent(models.EventProps{
+			ID:            &props.EventID,
+			Name:          &props.Name,
+			Location:      &props.Location,
+			Date:          &parsedDate,
+			Description:   &props.Description,
+			OrganizerID:   &props.OrganizerID,
+			Category:      &props.Category,
+			Limit:         &props.Limit,
+			Attendees:     existingEvent.Attendees(),
+			Registrations: existingEvent.Registrations(),
+			CreatedAt:     &originalCreatedAt,
+			UnpublishedAt: existingEvent.UnpublishedAt(),
+			TicketTypes:   ticketTypes,
+			Questions:     questions,
+		})
+		if businessErr != nil {
+			return businessErr
+		}
 
-	saveErr := uc.eventRepository.Save(updatedEvent)
-	if saveErr != nil {
-		return nil, saveErr
+		if err := repos.Events().Save(ctx, updatedEvent); err != nil {
+			return err
+		}
+		return auditEvent(ctx, repos.AuditLog(), models.AuditActionEventUpdated, props.OrganizerID, props.EventID, props.IP, eventAuditFields(existingEvent), eventAuditFields(updatedEvent))
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &dtos.EventDto{
@@ -71,5 +91,7 @@ func (uc *updateEventUseCase) Execute(props dtos.UpdateEventProps) (*dtos.EventD
 		CreatedAt:   updatedEvent.CreatedAt(),
 		Category:    updatedEvent.Category(),
 		Limit:       updatedEvent.Limit(),
+		TicketTypes: updatedEvent.TicketTypes(),
+		Questions:   updatedEvent.Questions(),
 	}, nil
 }
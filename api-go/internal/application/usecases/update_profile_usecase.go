@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+type updateProfileUseCase struct {
+	uow    repositories.UnitOfWork
+	hasher services.IPasswordHasher
+	mailer services.IMailer
+	apiURL string
+	ttl    time.Duration
+}
+
+// NewUpdateProfileUseCase mails new email addresses a confirmation link
+// valid for ttl.
+func NewUpdateProfileUseCase(uow repositories.UnitOfWork, hasher services.IPasswordHasher, mailer services.IMailer, apiURL string, ttl time.Duration) *updateProfileUseCase {
+	return &updateProfileUseCase{uow: uow, hasher: hasher, mailer: mailer, apiURL: apiURL, ttl: ttl}
+}
+
+func (uc *updateProfileUseCase) Execute(props dtos.UpdateProfileDto) (*dtos.ProfileDto, error) {
+	var (
+		user     models.User
+		rawToken string
+	)
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		var err error
+		user, err = repos.Users().FindById(ctx, props.UserID)
+		if err != nil {
+			return err
+		}
+
+		if props.Name != nil {
+			user.Rename(*props.Name)
+		}
+		profile := user.GetProfile()
+		if props.AvatarURL != nil {
+			profile.AvatarURL = *props.AvatarURL
+		}
+		if props.Bio != nil {
+			profile.Bio = *props.Bio
+		}
+		if props.Phone != nil {
+			profile.Phone = *props.Phone
+		}
+		if props.Company != nil {
+			profile.Company = *props.Company
+		}
+		user.UpdateProfile(profile)
+
+		if props.Email != nil {
+			if rawToken, err = uc.changeEmail(ctx, repos, user, *props.Email, props.CurrentPassword, props.IP); err != nil {
+				return err
+			}
+		}
+
+		return repos.Users().Save(ctx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The change is saved; asking again mails a new link if this one fails.
+	if rawToken != "" {
+		if err := sendEmailChangeEmails(props.Ctx, uc.mailer, uc.apiURL, user, rawToken, uc.ttl); err != nil {
+			log.Printf("UpdateProfileUseCase - Error sending email change confirmation to user %s: %v", user.GetID(), err)
+		}
+	}
+
+	return profileToDto(user), nil
+}
+
+// changeEmail records email as pending and returns the token confirming it.
+// Asking for the current address cancels a pending change.
+func (uc *updateProfileUseCase) changeEmail(ctx context.Context, repos repositories.Repositories, user models.User, email, password, ip string) (string, error) {
+	if email == user.GetEmail() {
+		if user.GetPendingEmail() != "" {
+			user.RequestEmailChange("")
+			return "", repos.UserTokens().RevokeForUser(ctx, user.GetID(), models.TokenPurposeEmailChange, time.Now())
+		}
+		return "", nil
+	}
+
+	if _, err := verifyPassword(ctx, uc.hasher, repos.Auths(), user.GetID(), password); err != nil {
+		return "", err
+	}
+	exists, err := repos.Users().ExistsByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", exceptions.NewConflictException("Email already registered")
+	}
+
+	user.RequestEmailChange(email)
+	rawToken, err := issueUserToken(ctx, repos.UserTokens(), user.GetID(), models.TokenPurposeEmailChange, uc.ttl)
+	if err != nil {
+		return "", err
+	}
+
+	userID := user.GetID()
+	return rawToken, auditUser(ctx, repos.AuditLog(), models.AuditActionEmailChangeRequested, &userID, userID, ip, nil)
+}
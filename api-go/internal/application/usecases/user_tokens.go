@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// issueUserToken revokes the user's outstanding tokens of the same purpose,
+// stores the hash of a new one and returns the raw token to be mailed.
+func issueUserToken(ctx context.Context, tokens repositories.UserTokenRepository, userID, purpose string, ttl time.Duration) (string, error) {
+	rawToken, err := utils.NewOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if err := tokens.RevokeForUser(ctx, userID, purpose, now); err != nil {
+		return "", err
+	}
+
+	hash, expiresAt := utils.HashToken(rawToken), now.Add(ttl)
+	token, err := models.NewUserToken(models.UserTokenProps{
+		UserID:    &userID,
+		Purpose:   &purpose,
+		TokenHash: &hash,
+		ExpiresAt: &expiresAt,
+		CreatedAt: &now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := tokens.Create(ctx, token); err != nil {
+		return "", err
+	}
+	return rawToken, nil
+}
+
+func sendVerificationEmail(ctx context.Context, mailer services.IMailer, apiURL string, user models.User, rawToken string, ttl time.Duration) error {
+	link := fmt.Sprintf("%s/auth/verify?token=%s", apiURL, url.QueryEscape(rawToken))
+	return mailer.Send(ctx, services.Mail{
+		To:      user.GetEmail(),
+		Subject: "Confirme o seu e-mail",
+		Body: fmt.Sprintf("Olá, %s!\n\nConfirme o seu endereço de e-mail em até %s pelo link abaixo:\n\n%s",
+			user.GetName(), ttl, link),
+	})
+}
+
+func sendUnlockEmail(ctx context.Context, mailer services.IMailer, apiURL string, user models.User, rawToken string, ttl time.Duration) error {
+	link := fmt.Sprintf("%s/auth/unlock?token=%s", apiURL, url.QueryEscape(rawToken))
+	return mailer.Send(ctx, services.Mail{
+		To:      user.GetEmail(),
+		Subject: "Sua conta foi bloqueada temporariamente",
+		Body: fmt.Sprintf("Olá, %s!\n\nDetectamos várias tentativas de login malsucedidas e bloqueamos sua conta temporariamente. "+
+			"Se foi você, desbloqueie a conta em até %s pelo link abaixo. Caso contrário, recomendamos redefinir sua senha.\n\n%s",
+			user.GetName(), ttl, link),
+	})
+}
+
+// sendEmailChangeEmails mails the confirmation link to the new address and
+// warns the current one, in case someone else asked for the change.
+func sendEmailChangeEmails(ctx context.Context, mailer services.IMailer, apiURL string, user models.User, rawToken string, ttl time.Duration) error {
+	link := fmt.Sprintf("%s/auth/confirm-email?token=%s", apiURL, url.QueryEscape(rawToken))
+	err := mailer.Send(ctx, services.Mail{
+		To:      user.GetPendingEmail(),
+		Subject: "Confirme o seu novo e-mail",
+		Body: fmt.Sprintf("Olá, %s!\n\nConfirme em até %s que este é o novo e-mail da sua conta pelo link abaixo:\n\n%s",
+			user.GetName(), ttl, link),
+	})
+	if err != nil {
+		return err
+	}
+
+	return mailer.Send(ctx, services.Mail{
+		To:      user.GetEmail(),
+		Subject: "Pedido de alteração de e-mail",
+		Body: fmt.Sprintf("Olá, %s!\n\nRecebemos um pedido para trocar o e-mail da sua conta para %s. "+
+			"Se não foi você, redefina sua senha.",
+			user.GetName(), user.GetPendingEmail()),
+	})
+}
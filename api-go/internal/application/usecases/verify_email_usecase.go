@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type verifyEmailUseCase struct {
+	uow repositories.UnitOfWork
+}
+
+func NewVerifyEmailUseCase(uow repositories.UnitOfWork) *verifyEmailUseCase {
+	return &verifyEmailUseCase{uow: uow}
+}
+
+func (uc *verifyEmailUseCase) Execute(props dtos.VerifyEmailDto) (struct{}, error) {
+	err := uc.uow.Do(props.Ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		token, err := repos.UserTokens().FindByHashForUpdate(ctx, models.TokenPurposeEmailVerification, utils.HashToken(props.Token))
+		var notFound *exceptions.NotFoundException
+		if errors.As(err, &notFound) {
+			return exceptions.NewValidationException("Invalid or expired token")
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := token.Use(now); err != nil {
+			return err
+		}
+
+		user, err := repos.Users().FindById(ctx, token.GetUserID())
+		if err != nil {
+			return err
+		}
+		user.VerifyEmail(now)
+
+		if err := repos.Users().Save(ctx, user); err != nil {
+			return err
+		}
+		if err := repos.UserTokens().Save(ctx, token); err != nil {
+			return err
+		}
+		return acceptEventInvites(ctx, repos, user, "")
+	})
+
+	return struct{}{}, err
+}
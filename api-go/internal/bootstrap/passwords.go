@@ -0,0 +1,39 @@
+// Package bootstrap builds the services shared by the API server and
+// eventhubctl from their configuration.
+package bootstrap
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/ports"
+)
+
+// bcrypt only hashes the first 72 bytes of a password.
+const bcryptMaxPasswordBytes = 72
+
+// NewPasswords returns the hasher and the policy passwords are set with.
+func NewPasswords(cfg *config.PasswordConfig) (services.IPasswordHasher, usecases.PasswordPolicy, error) {
+	hasher, err := ports.NewPasswordHasher(ports.PasswordHasherSettings{
+		Algorithm:  cfg.Algorithm,
+		BcryptCost: cfg.BcryptCost,
+		Argon2: ports.Argon2Params{
+			Memory:      uint32(cfg.Argon2Memory),
+			Iterations:  uint32(cfg.Argon2Iterations),
+			Parallelism: uint8(cfg.Argon2Parallelism),
+		},
+	})
+	if err != nil {
+		return nil, usecases.PasswordPolicy{}, err
+	}
+
+	breached, err := ports.LoadBreachedPasswords(cfg.BreachedListFile)
+	if err != nil {
+		return nil, usecases.PasswordPolicy{}, err
+	}
+	policy := usecases.PasswordPolicy{MinLength: cfg.MinLength, Breached: breached}
+	if cfg.Algorithm == "bcrypt" {
+		policy.MaxBytes = bcryptMaxPasswordBytes
+	}
+	return hasher, policy, nil
+}
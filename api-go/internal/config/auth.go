@@ -0,0 +1,149 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultFrontendURL              = "http://localhost:5173"
+	defaultAPIURL                   = "http://localhost:8080"
+	defaultPasswordResetTTL         = time.Hour
+	defaultEmailVerificationTTL     = 48 * time.Hour
+	defaultVerificationResendWindow = time.Minute
+	defaultTOTPIssuer               = "EventHub"
+	defaultLoginFreeAttempts        = 5
+	defaultLoginIPFreeAttempts      = 20
+	defaultLoginLockThreshold       = 10
+	defaultLoginLockDuration        = 15 * time.Minute
+	defaultAccountUnlockTTL         = 24 * time.Hour
+)
+
+// AuthConfig holds the settings of the account flows that send links to
+// users by email.
+type AuthConfig struct {
+	// FrontendURL and APIURL are the bases of the links sent by email,
+	// without a trailing slash.
+	FrontendURL          string
+	APIURL               string
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	// VerificationResendWindow is the minimum time between two verification
+	// emails sent to the same user.
+	VerificationResendWindow time.Duration
+	// RequireVerifiedEmail blocks unverified users from creating events and
+	// registering to them.
+	RequireVerifiedEmail bool
+	// TOTPIssuer is the name authenticator apps show next to the account.
+	TOTPIssuer string
+	// LoginFreeAttempts failed logins per account, and LoginIPFreeAttempts
+	// per client IP, are allowed before progressive delays start.
+	LoginFreeAttempts   int
+	LoginIPFreeAttempts int
+	// LoginLockThreshold consecutive failures lock the account for
+	// LoginLockDuration, or until the user follows the link mailed to them
+	// within AccountUnlockTTL.
+	LoginLockThreshold int
+	LoginLockDuration  time.Duration
+	AccountUnlockTTL   time.Duration
+}
+
+// NewAuthConfig reads FRONTEND_URL, API_URL, REQUIRE_VERIFIED_EMAIL,
+// TOTP_ISSUER, the durations (such as "30m") PASSWORD_RESET_TTL,
+// EMAIL_VERIFICATION_TTL, VERIFICATION_RESEND_WINDOW, LOGIN_LOCK_DURATION and
+// ACCOUNT_UNLOCK_TTL, and the counts LOGIN_FREE_ATTEMPTS,
+// LOGIN_IP_FREE_ATTEMPTS and LOGIN_LOCK_THRESHOLD through getenv. Empty
+// values fall back to the defaults.
+func NewAuthConfig(getenv func(string) string) (*AuthConfig, error) {
+	cfg := &AuthConfig{
+		FrontendURL:              defaultFrontendURL,
+		APIURL:                   defaultAPIURL,
+		PasswordResetTTL:         defaultPasswordResetTTL,
+		EmailVerificationTTL:     defaultEmailVerificationTTL,
+		VerificationResendWindow: defaultVerificationResendWindow,
+		TOTPIssuer:               defaultTOTPIssuer,
+		LoginFreeAttempts:        defaultLoginFreeAttempts,
+		LoginIPFreeAttempts:      defaultLoginIPFreeAttempts,
+		LoginLockThreshold:       defaultLoginLockThreshold,
+		LoginLockDuration:        defaultLoginLockDuration,
+		AccountUnlockTTL:         defaultAccountUnlockTTL,
+	}
+
+	if v := getenv("FRONTEND_URL"); v != "" {
+		cfg.FrontendURL = strings.TrimRight(v, "/")
+	}
+	if v := getenv("API_URL"); v != "" {
+		cfg.APIURL = strings.TrimRight(v, "/")
+	}
+	if v := getenv("TOTP_ISSUER"); v != "" {
+		cfg.TOTPIssuer = v
+	}
+
+	durations := []struct {
+		name   string
+		target *time.Duration
+	}{
+		{"PASSWORD_RESET_TTL", &cfg.PasswordResetTTL},
+		{"EMAIL_VERIFICATION_TTL", &cfg.EmailVerificationTTL},
+		{"VERIFICATION_RESEND_WINDOW", &cfg.VerificationResendWindow},
+		{"LOGIN_LOCK_DURATION", &cfg.LoginLockDuration},
+		{"ACCOUNT_UNLOCK_TTL", &cfg.AccountUnlockTTL},
+	}
+	for _, d := range durations {
+		if err := parsePositiveDuration(getenv(d.name), d.name, d.target); err != nil {
+			return nil, err
+		}
+	}
+
+	counts := []struct {
+		name   string
+		target *int
+	}{
+		{"LOGIN_FREE_ATTEMPTS", &cfg.LoginFreeAttempts},
+		{"LOGIN_IP_FREE_ATTEMPTS", &cfg.LoginIPFreeAttempts},
+		{"LOGIN_LOCK_THRESHOLD", &cfg.LoginLockThreshold},
+	}
+	for _, c := range counts {
+		if err := parsePositiveInt(getenv(c.name), c.name, c.target); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := getenv("REQUIRE_VERIFIED_EMAIL"); v != "" {
+		required, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REQUIRE_VERIFIED_EMAIL %q: %w", v, err)
+		}
+		cfg.RequireVerifiedEmail = required
+	}
+
+	return cfg, nil
+}
+
+func parsePositiveDuration(value, name string, target *time.Duration) error {
+	if value == "" {
+		return nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("invalid %s %q", name, value)
+	}
+	*target = d
+	return nil
+}
+
+func parsePositiveInt(value, name string, target *int) error {
+	if value == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return fmt.Errorf("invalid %s %q", name, value)
+	}
+	*target = n
+	return nil
+}
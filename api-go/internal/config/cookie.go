@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CookieConfig holds the attributes of the session cookies.
+type CookieConfig struct {
+	// Secure restricts the cookies to HTTPS.
+	Secure   bool
+	SameSite http.SameSite
+	// Domain is empty for cookies sent to the API host only.
+	Domain string
+}
+
+// NewCookieConfig reads COOKIE_SECURE, which defaults to whether apiURL uses
+// HTTPS, COOKIE_SAMESITE (lax, strict or none; lax by default) and
+// COOKIE_DOMAIN through getenv.
+func NewCookieConfig(getenv func(string) string, apiURL string) (*CookieConfig, error) {
+	cfg := &CookieConfig{
+		Secure:   strings.HasPrefix(apiURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+		Domain:   getenv("COOKIE_DOMAIN"),
+	}
+
+	if v := getenv("COOKIE_SECURE"); v != "" {
+		secure, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid COOKIE_SECURE %q: %w", v, err)
+		}
+		cfg.Secure = secure
+	}
+
+	switch v := strings.ToLower(getenv("COOKIE_SAMESITE")); v {
+	case "", "lax":
+	case "strict":
+		cfg.SameSite = http.SameSiteStrictMode
+	case "none":
+		// Browsers drop SameSite=None cookies that are not Secure.
+		if !cfg.Secure {
+			return nil, fmt.Errorf("COOKIE_SAMESITE=none requires secure cookies")
+		}
+		cfg.SameSite = http.SameSiteNoneMode
+	default:
+		return nil, fmt.Errorf("invalid COOKIE_SAMESITE %q", v)
+	}
+
+	return cfg, nil
+}
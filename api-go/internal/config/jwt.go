@@ -0,0 +1,31 @@
+package config
+
+// JWTConfig holds the settings of the access tokens.
+type JWTConfig struct {
+	// Issuer and Audience are set in every token and checked when verifying
+	// one.
+	Issuer   string
+	Audience string
+	// KeysFile is the JSON manifest of the signing keys. Without it a key is
+	// generated at startup, so tokens do not survive restarts.
+	KeysFile string
+}
+
+// NewJWTConfig reads JWT_ISSUER and JWT_AUDIENCE, which default to apiURL,
+// and JWT_KEYS_FILE through getenv.
+func NewJWTConfig(getenv func(string) string, apiURL string) *JWTConfig {
+	cfg := &JWTConfig{
+		Issuer:   apiURL,
+		Audience: apiURL,
+		KeysFile: getenv("JWT_KEYS_FILE"),
+	}
+
+	if v := getenv("JWT_ISSUER"); v != "" {
+		cfg.Issuer = v
+	}
+	if v := getenv("JWT_AUDIENCE"); v != "" {
+		cfg.Audience = v
+	}
+
+	return cfg
+}
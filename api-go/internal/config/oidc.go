@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const defaultOIDCUserType = "participant"
+
+var oidcProviderName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// OIDCProviderConfig is a client registered at an OpenID Connect provider.
+type OIDCProviderConfig struct {
+	// Name identifies the provider in /auth/oidc/:provider routes.
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type OIDCConfig struct {
+	Providers []OIDCProviderConfig
+	// DefaultUserType is given to users created on their first SSO login.
+	DefaultUserType string
+}
+
+// NewOIDCConfig reads the comma-separated provider names in OIDC_PROVIDERS
+// and, for each name such as "corp", OIDC_CORP_ISSUER, OIDC_CORP_CLIENT_ID,
+// OIDC_CORP_CLIENT_SECRET and optionally OIDC_CORP_SCOPES and
+// OIDC_CORP_REDIRECT_URL, which defaults to
+// <apiURL>/auth/oidc/corp/callback. OIDC_DEFAULT_USER_TYPE sets the type of
+// provisioned users.
+func NewOIDCConfig(getenv func(string) string, apiURL string) (*OIDCConfig, error) {
+	cfg := &OIDCConfig{DefaultUserType: defaultOIDCUserType}
+
+	if v := getenv("OIDC_DEFAULT_USER_TYPE"); v != "" {
+		if v != "participant" && v != "organizer" {
+			return nil, fmt.Errorf("invalid OIDC_DEFAULT_USER_TYPE %q", v)
+		}
+		cfg.DefaultUserType = v
+	}
+
+	for _, name := range strings.Split(getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !oidcProviderName.MatchString(name) {
+			return nil, fmt.Errorf("invalid OIDC provider name %q", name)
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider := OIDCProviderConfig{
+			Name:         name,
+			Issuer:       getenv(prefix + "ISSUER"),
+			ClientID:     getenv(prefix + "CLIENT_ID"),
+			ClientSecret: getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.FieldsFunc(getenv(prefix+"SCOPES"), func(r rune) bool { return r == ',' || r == ' ' }),
+		}
+		if provider.Issuer == "" || provider.ClientID == "" {
+			return nil, fmt.Errorf("OIDC provider %q needs %sISSUER and %sCLIENT_ID", name, prefix, prefix)
+		}
+		if provider.RedirectURL == "" {
+			provider.RedirectURL = fmt.Sprintf("%s/auth/oidc/%s/callback", apiURL, name)
+		}
+		cfg.Providers = append(cfg.Providers, provider)
+	}
+
+	return cfg, nil
+}
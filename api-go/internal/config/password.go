@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	defaultPasswordAlgorithm = "argon2id"
+	defaultBcryptCost        = 10
+	// The second recommended option of RFC 9106, for machines that cannot
+	// spare 2 GiB per hash.
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 4
+	defaultPasswordMinLength = 8
+	// Kept within the 72 bytes bcrypt hashes, so switching algorithms never
+	// leaves the minimum out of reach.
+	maxPasswordMinLength = 72
+)
+
+// PasswordConfig holds how passwords are hashed and which ones are accepted.
+type PasswordConfig struct {
+	// Algorithm, "argon2id" or "bcrypt", hashes new passwords. Hashes of the
+	// other one keep working and are upgraded on the next login.
+	Algorithm  string
+	BcryptCost int
+	// Argon2Memory is in KiB.
+	Argon2Memory      int
+	Argon2Iterations  int
+	Argon2Parallelism int
+	MinLength         int
+	// BreachedListFile lists passwords to refuse, one per line. Without it
+	// the list shipped with the API is used.
+	BreachedListFile string
+}
+
+// NewPasswordConfig reads PASSWORD_HASH_ALGORITHM, PASSWORD_BREACHED_LIST and
+// the counts BCRYPT_COST, ARGON2_MEMORY_KIB, ARGON2_ITERATIONS,
+// ARGON2_PARALLELISM and PASSWORD_MIN_LENGTH through getenv. Empty values
+// fall back to the defaults.
+func NewPasswordConfig(getenv func(string) string) (*PasswordConfig, error) {
+	cfg := &PasswordConfig{
+		Algorithm:         defaultPasswordAlgorithm,
+		BcryptCost:        defaultBcryptCost,
+		Argon2Memory:      defaultArgon2Memory,
+		Argon2Iterations:  defaultArgon2Iterations,
+		Argon2Parallelism: defaultArgon2Parallelism,
+		MinLength:         defaultPasswordMinLength,
+		BreachedListFile:  getenv("PASSWORD_BREACHED_LIST"),
+	}
+
+	switch v := getenv("PASSWORD_HASH_ALGORITHM"); v {
+	case "":
+	case "argon2id", "bcrypt":
+		cfg.Algorithm = v
+	default:
+		return nil, fmt.Errorf("invalid PASSWORD_HASH_ALGORITHM %q, expected argon2id or bcrypt", v)
+	}
+
+	counts := []struct {
+		name   string
+		target *int
+	}{
+		{"BCRYPT_COST", &cfg.BcryptCost},
+		{"ARGON2_MEMORY_KIB", &cfg.Argon2Memory},
+		{"ARGON2_ITERATIONS", &cfg.Argon2Iterations},
+		{"ARGON2_PARALLELISM", &cfg.Argon2Parallelism},
+		{"PASSWORD_MIN_LENGTH", &cfg.MinLength},
+	}
+	for _, c := range counts {
+		if err := parsePositiveInt(getenv(c.name), c.name, c.target); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.MinLength > maxPasswordMinLength {
+		return nil, fmt.Errorf("invalid PASSWORD_MIN_LENGTH %d, expected at most %d", cfg.MinLength, maxPasswordMinLength)
+	}
+	if cfg.Argon2Memory > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid ARGON2_MEMORY_KIB %d", cfg.Argon2Memory)
+	}
+	if cfg.Argon2Parallelism > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid ARGON2_PARALLELISM %d, expected at most %d", cfg.Argon2Parallelism, math.MaxUint8)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,16 @@
+package config
+
+import "strings"
+
+// ParseTrustedProxies splits TRUSTED_PROXIES, a comma-separated list of IPs
+// or CIDRs whose X-Forwarded-For header is believed. Empty means none, so
+// the client IP is always the address of the TCP peer.
+func ParseTrustedProxies(value string) []string {
+	var proxies []string
+	for _, proxy := range strings.Split(value, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
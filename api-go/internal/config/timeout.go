@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultRequestTimeout = 10 * time.Second
+
+// defaultRouteTimeouts apply unless ROUTE_TIMEOUTS sets the route. Exports
+// stream the file while they read it, so the deadline covers the download.
+var defaultRouteTimeouts = map[string]time.Duration{
+	"GET /events/:eventID/attendees/export": 10 * time.Minute,
+}
+
+// TimeoutConfig holds the deadline applied to each request context. Routes
+// are keyed by "METHOD /full/path" as registered in gin, e.g.
+// "GET /events/:eventID".
+type TimeoutConfig struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+// NewTimeoutConfig parses REQUEST_TIMEOUT (a duration such as "5s") and
+// ROUTE_TIMEOUTS, a comma separated list of "METHOD /path=duration" entries.
+// A zero duration disables the deadline for that route.
+func NewTimeoutConfig(defaultTimeout, routeTimeouts string) (*TimeoutConfig, error) {
+	cfg := &TimeoutConfig{
+		Default: defaultRequestTimeout,
+		Routes:  map[string]time.Duration{},
+	}
+	for route, d := range defaultRouteTimeouts {
+		cfg.Routes[route] = d
+	}
+
+	if defaultTimeout != "" {
+		d, err := time.ParseDuration(defaultTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REQUEST_TIMEOUT %q: %w", defaultTimeout, err)
+		}
+		cfg.Default = d
+	}
+
+	for _, entry := range strings.Split(routeTimeouts, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid ROUTE_TIMEOUTS entry %q: expected \"METHOD /path=duration\"", entry)
+		}
+
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ROUTE_TIMEOUTS entry %q: %w", entry, err)
+		}
+
+		method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid ROUTE_TIMEOUTS entry %q: expected \"METHOD /path=duration\"", entry)
+		}
+
+		cfg.Routes[routeKey(method, path)] = d
+	}
+
+	return cfg, nil
+}
+
+func (c *TimeoutConfig) For(method, path string) time.Duration {
+	if d, ok := c.Routes[routeKey(method, path)]; ok {
+		return d
+	}
+	return c.Default
+}
+
+func routeKey(method, path string) string {
+	return strings.ToUpper(strings.TrimSpace(method)) + " " + strings.TrimSpace(path)
+}
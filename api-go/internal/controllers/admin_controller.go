@@ -0,0 +1,252 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminController serves the administration API. The use cases check that
+// the caller is an admin.
+type AdminController struct {
+	listAuditLogUseCase      usecase.UseCaseWithProps[dtos.AuditLogQueryDto, []dtos.AuditEntryDto]
+	searchUsersUseCase       usecase.UseCaseWithProps[dtos.UserSearchDto, []dtos.AdminUserDto]
+	suspendUserUseCase       usecase.UseCaseWithProps[dtos.SuspendUserDto, dtos.AdminUserDto]
+	reactivateUserUseCase    usecase.UseCaseWithProps[dtos.ReactivateUserDto, dtos.AdminUserDto]
+	cancelEventUseCase       usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}]
+	unpublishEventUseCase    usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}]
+	republishEventUseCase    usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}]
+	reassignOrganizerUseCase usecase.UseCaseWithProps[dtos.ReassignOrganizerDto, struct{}]
+	removeAttendeeUseCase    usecase.UseCaseWithProps[dtos.RemoveAttendeeDto, struct{}]
+}
+
+func NewAdminController(
+	listAuditLogUC usecase.UseCaseWithProps[dtos.AuditLogQueryDto, []dtos.AuditEntryDto],
+	searchUsersUC usecase.UseCaseWithProps[dtos.UserSearchDto, []dtos.AdminUserDto],
+	suspendUserUC usecase.UseCaseWithProps[dtos.SuspendUserDto, dtos.AdminUserDto],
+	reactivateUserUC usecase.UseCaseWithProps[dtos.ReactivateUserDto, dtos.AdminUserDto],
+	cancelEventUC usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}],
+	unpublishEventUC usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}],
+	republishEventUC usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}],
+	reassignOrganizerUC usecase.UseCaseWithProps[dtos.ReassignOrganizerDto, struct{}],
+	removeAttendeeUC usecase.UseCaseWithProps[dtos.RemoveAttendeeDto, struct{}],
+) *AdminController {
+	return &AdminController{
+		listAuditLogUseCase:      listAuditLogUC,
+		searchUsersUseCase:       searchUsersUC,
+		suspendUserUseCase:       suspendUserUC,
+		reactivateUserUseCase:    reactivateUserUC,
+		cancelEventUseCase:       cancelEventUC,
+		unpublishEventUseCase:    unpublishEventUC,
+		republishEventUseCase:    republishEventUC,
+		reassignOrganizerUseCase: reassignOrganizerUC,
+		removeAttendeeUseCase:    removeAttendeeUC,
+	}
+}
+
+func (c *AdminController) ListAuditLog(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.AuditLogQueryDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+
+	entries, err := c.listAuditLogUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+func (c *AdminController) SearchUsers(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.UserSearchDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+
+	users, err := c.searchUsersUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, users)
+}
+
+func (c *AdminController) SuspendUser(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.SuspendUserDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+	input.UserID = ctx.Param("userID")
+	input.IP = ctx.ClientIP()
+
+	user, err := c.suspendUserUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+func (c *AdminController) ReactivateUser(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.ReactivateUserDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+	input.UserID = ctx.Param("userID")
+	input.IP = ctx.ClientIP()
+
+	user, err := c.reactivateUserUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+func (c *AdminController) CancelEvent(ctx *gin.Context) {
+	c.moderateEvent(ctx, c.cancelEventUseCase)
+}
+
+func (c *AdminController) UnpublishEvent(ctx *gin.Context) {
+	c.moderateEvent(ctx, c.unpublishEventUseCase)
+}
+
+func (c *AdminController) RepublishEvent(ctx *gin.Context) {
+	c.moderateEvent(ctx, c.republishEventUseCase)
+}
+
+func (c *AdminController) moderateEvent(ctx *gin.Context, uc usecase.UseCaseWithProps[dtos.ModerateEventDto, struct{}]) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.ModerateEventDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+	input.EventID = ctx.Param("eventID")
+	input.IP = ctx.ClientIP()
+
+	if _, err := uc.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AdminController) ReassignOrganizer(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.ReassignOrganizerDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+	input.EventID = ctx.Param("eventID")
+	input.IP = ctx.ClientIP()
+
+	if _, err := c.reassignOrganizerUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AdminController) RemoveAttendee(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.RemoveAttendeeDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.AdminID = userID.(string)
+	input.EventID = ctx.Param("eventID")
+	input.UserID = ctx.Param("userID")
+	input.IP = ctx.ClientIP()
+
+	if _, err := c.removeAttendeeUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AdminController) SetupRoutes() {
+	group := r.Router.Group("/admin")
+
+	group.GET("/audit-log", c.ListAuditLog)
+
+	group.GET("/users", c.SearchUsers)
+	group.POST("/users/:userID/suspend", c.SuspendUser)
+	group.POST("/users/:userID/reactivate", c.ReactivateUser)
+
+	group.DELETE("/events/:eventID", c.CancelEvent)
+	group.POST("/events/:eventID/unpublish", c.UnpublishEvent)
+	group.POST("/events/:eventID/publish", c.RepublishEvent)
+	group.PUT("/events/:eventID/organizer", c.ReassignOrganizer)
+	group.DELETE("/events/:eventID/attendees/:userID", c.RemoveAttendee)
+}
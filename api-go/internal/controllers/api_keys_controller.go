@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeysController manages the API keys of the authenticated user. The
+// create use case is not decorated because its result carries the key.
+type APIKeysController struct {
+	createUseCase usecase.UseCaseWithProps[dtos.CreateAPIKeyDto, *dtos.CreatedAPIKeyDto]
+	listUseCase   usecase.UseCaseWithProps[usecases.ListAPIKeysProps, []dtos.APIKeyDto]
+	revokeUseCase usecase.UseCaseWithProps[usecases.RevokeAPIKeyProps, struct{}]
+}
+
+func NewAPIKeysController(
+	createUC usecase.UseCaseWithProps[dtos.CreateAPIKeyDto, *dtos.CreatedAPIKeyDto],
+	listUC usecase.UseCaseWithProps[usecases.ListAPIKeysProps, []dtos.APIKeyDto],
+	revokeUC usecase.UseCaseWithProps[usecases.RevokeAPIKeyProps, struct{}],
+) *APIKeysController {
+	return &APIKeysController{
+		createUseCase: createUC,
+		listUseCase:   listUC,
+		revokeUseCase: revokeUC,
+	}
+}
+
+func (c *APIKeysController) Create(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.CreateAPIKeyDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+	input.IP = ctx.ClientIP()
+
+	key, err := c.createUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, key)
+}
+
+func (c *APIKeysController) List(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	keys, err := c.listUseCase.Execute(usecases.ListAPIKeysProps{
+		Ctx:    ctx.Request.Context(),
+		UserID: userID.(string),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, keys)
+}
+
+func (c *APIKeysController) Revoke(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	_, err := c.revokeUseCase.Execute(usecases.RevokeAPIKeyProps{
+		Ctx:    ctx.Request.Context(),
+		UserID: userID.(string),
+		KeyID:  ctx.Param("keyID"),
+		IP:     ctx.ClientIP(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "API key revoked"})
+}
+
+func (c *APIKeysController) SetupRoutes() {
+	group := r.Router.Group("/users/me/api-keys")
+
+	group.GET("", c.List)
+	group.POST("", c.Create)
+	group.DELETE("/:keyID", c.Revoke)
+}
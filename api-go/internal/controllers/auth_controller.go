@@ -4,68 +4,260 @@ import (
 	"net/http"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
 	r "github.com/Gabriel-Schiestl/api-go/internal/server"
 	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
 	"github.com/gin-gonic/gin"
 )
 
 type AuthController struct {
-	getAuthsUseCase   usecase.UseCaseDecorator[[]dtos.AuthResponseDTO]
-	loginUseCase  usecase.UseCaseWithPropsDecorator[dtos.LoginDto, *string]
+	getCredentialsUseCase usecase.UseCaseWithPropsDecorator[usecases.GetCredentialsProps, *dtos.CredentialsDto]
+	// Use cases taking passwords or the tokens of mailed links are not
+	// decorated: the decorator logs props and results.
+	loginUseCase              usecase.UseCaseWithProps[dtos.LoginDto, *dtos.LoginResultDto]
+	loginTwoFactorUseCase     usecase.UseCaseWithProps[dtos.TwoFactorLoginDto, *dtos.LoginResultDto]
+	resetPasswordUseCase      usecase.UseCaseWithProps[dtos.ResetPasswordDto, struct{}]
+	unlockAccountUseCase      usecase.UseCaseWithProps[dtos.UnlockAccountDto, struct{}]
+	verifyEmailUseCase        usecase.UseCaseWithProps[dtos.VerifyEmailDto, struct{}]
+	confirmEmailChangeUseCase usecase.UseCaseWithProps[dtos.ConfirmEmailChangeDto, struct{}]
+	forgotPasswordUseCase     usecase.UseCaseWithPropsDecorator[dtos.ForgotPasswordDto, struct{}]
+	resendVerificationUseCase usecase.UseCaseWithPropsDecorator[usecases.ResendVerificationProps, struct{}]
+	revokeSessionUseCase      usecase.UseCaseWithProps[usecases.RevokeSessionProps, struct{}]
+	cookies                   sessionCookies
 }
 
-func NewAuthController(getUC usecase.UseCaseDecorator[[]dtos.AuthResponseDTO], loginUC usecase.UseCaseWithPropsDecorator[dtos.LoginDto, *string]) *AuthController {
+func NewAuthController(
+	getCredentialsUC usecase.UseCaseWithPropsDecorator[usecases.GetCredentialsProps, *dtos.CredentialsDto],
+	loginUC usecase.UseCaseWithProps[dtos.LoginDto, *dtos.LoginResultDto],
+	loginTwoFactorUC usecase.UseCaseWithProps[dtos.TwoFactorLoginDto, *dtos.LoginResultDto],
+	forgotPasswordUC usecase.UseCaseWithPropsDecorator[dtos.ForgotPasswordDto, struct{}],
+	resetPasswordUC usecase.UseCaseWithProps[dtos.ResetPasswordDto, struct{}],
+	verifyEmailUC usecase.UseCaseWithProps[dtos.VerifyEmailDto, struct{}],
+	resendVerificationUC usecase.UseCaseWithPropsDecorator[usecases.ResendVerificationProps, struct{}],
+	unlockAccountUC usecase.UseCaseWithProps[dtos.UnlockAccountDto, struct{}],
+	confirmEmailChangeUC usecase.UseCaseWithProps[dtos.ConfirmEmailChangeDto, struct{}],
+	revokeSessionUC usecase.UseCaseWithProps[usecases.RevokeSessionProps, struct{}],
+	cookieConfig *config.CookieConfig,
+) *AuthController {
 	return &AuthController{
-		getAuthsUseCase:   getUC,
-		loginUseCase:  loginUC,
+		getCredentialsUseCase:     getCredentialsUC,
+		loginUseCase:              loginUC,
+		loginTwoFactorUseCase:     loginTwoFactorUC,
+		forgotPasswordUseCase:     forgotPasswordUC,
+		resetPasswordUseCase:      resetPasswordUC,
+		verifyEmailUseCase:        verifyEmailUC,
+		resendVerificationUseCase: resendVerificationUC,
+		unlockAccountUseCase:      unlockAccountUC,
+		confirmEmailChangeUseCase: confirmEmailChangeUC,
+		revokeSessionUseCase:      revokeSessionUC,
+		cookies:                   sessionCookies{config: cookieConfig},
 	}
 }
 
-func (c *AuthController) GetAuths(ctx *gin.Context) {
-	dtos, err := c.getAuthsUseCase.Execute()
+// GetCredentials describes how the current user can sign in.
+func (c *AuthController) GetCredentials(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	credentials, err := c.getCredentialsUseCase.Execute(usecases.GetCredentialsProps{Ctx: ctx.Request.Context(), UserID: userID.(string)})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, dtos)
+	ctx.JSON(http.StatusOK, credentials)
 }
 
 func (c *AuthController) Login(ctx *gin.Context) {
 	var input dtos.LoginDto
 	if err := ctx.ShouldBindJSON(&input); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
-	
-	token, err := c.loginUseCase.Execute(input)
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
+	input.UserAgent = ctx.Request.UserAgent()
+
+	result, err := c.loginUseCase.Execute(input)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		ctx.Error(err)
+		return
+	}
+
+	if result.TwoFactorRequired {
+		ctx.JSON(http.StatusOK, result)
+		return
+	}
+
+	c.cookies.respondWithToken(ctx, result.Token)
+}
+
+// LoginTwoFactor completes a login that answered with two_factor_required.
+func (c *AuthController) LoginTwoFactor(ctx *gin.Context) {
+	var input dtos.TwoFactorLoginDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
+	input.UserAgent = ctx.Request.UserAgent()
+
+	result, err := c.loginTwoFactorUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	c.cookies.respondWithToken(ctx, result.Token)
+}
+
+// ForgotPassword always answers 202 so callers cannot probe which emails
+// are registered.
+func (c *AuthController) ForgotPassword(ctx *gin.Context) {
+	var input dtos.ForgotPasswordDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
+	input.Ctx = ctx.Request.Context()
 
-	if token == nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Credenciais inválidas"})
+	if _, err := c.forgotPasswordUseCase.Execute(input); err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	ctx.SetCookie("Authorization", *token, 3600, "/", "", false, true)
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "If the email is registered, a reset link has been sent"})
+}
+
+func (c *AuthController) ResetPassword(ctx *gin.Context) {
+	var input dtos.ResetPasswordDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
 
-	// Para retornar também dados do usuário, vamos buscar
-	// Aqui retornamos apenas o token por simplicidade
-	ctx.JSON(http.StatusOK, gin.H{
-		"token": *token,
+	if _, err := c.resetPasswordUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password updated successfully"})
+}
+
+func (c *AuthController) VerifyEmail(ctx *gin.Context) {
+	var input dtos.VerifyEmailDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+
+	if _, err := c.verifyEmailUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Email verified successfully"})
+}
+
+// ConfirmEmailChange follows the link mailed to the new address of a user.
+func (c *AuthController) ConfirmEmailChange(ctx *gin.Context) {
+	var input dtos.ConfirmEmailChangeDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
+
+	if _, err := c.confirmEmailChangeUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Email changed successfully"})
+}
+
+// UnlockAccount follows the link mailed when failed logins locked the account.
+func (c *AuthController) UnlockAccount(ctx *gin.Context) {
+	var input dtos.UnlockAccountDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
+
+	if _, err := c.unlockAccountUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Account unlocked"})
+}
+
+func (c *AuthController) ResendVerification(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	_, err := c.resendVerificationUseCase.Execute(usecases.ResendVerificationProps{
+		Ctx:    ctx.Request.Context(),
+		UserID: userID.(string),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Verification email sent"})
+}
+
+// Logout ends the session of the request, so that its token stops being
+// accepted, and clears the session cookies.
+func (c *AuthController) Logout(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	c.cookies.clear(ctx)
+	_, err := c.revokeSessionUseCase.Execute(usecases.RevokeSessionProps{
+		Ctx:       ctx.Request.Context(),
+		UserID:    userID.(string),
+		SessionID: ctx.GetString("sessionID"),
+		IP:        ctx.ClientIP(),
 	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
 }
 
 func (c *AuthController) SetupRoutes() {
 	group := r.Router.Group("/auth")
 
-	group.GET("/", c.GetAuths)
+	group.GET("/", c.GetCredentials)
 	group.POST("/login", c.Login)
-	group.GET("/logout", func(ctx *gin.Context) {
-		ctx.SetCookie("Authorization", "", -1, "/", "", false, true)
-		ctx.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
-	})
+	group.POST("/login/2fa", c.LoginTwoFactor)
+	group.POST("/forgot-password", c.ForgotPassword)
+	group.POST("/reset-password", c.ResetPassword)
+	group.GET("/verify", c.VerifyEmail)
+	group.POST("/verify/resend", c.ResendVerification)
+	group.GET("/unlock", c.UnlockAccount)
+	group.GET("/confirm-email", c.ConfirmEmailChange)
+	group.POST("/logout", c.Logout)
 	group.GET("/check", func(ctx *gin.Context) {
 		userID, exists := ctx.Get("userID")
 		if !exists {
@@ -1,11 +1,17 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"time"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
 	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
 	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
 	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
 	_ "github.com/Gabriel-Schiestl/go-clarch/presentation/controller"
 	"github.com/gin-gonic/gin"
@@ -17,33 +23,52 @@ const useCaseErrorLog = "UseCase error: %v"
 const eventIDRoute = "/:eventID"
 
 type EventsController struct{
-	getEventsUseCase usecase.UseCaseDecorator[[]dtos.EventDto]
+	getEventsUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsProps, []dtos.EventDto]
 	createEventUseCase usecase.UseCaseWithPropsDecorator[dtos.CreateEventProps, *dtos.EventDto]
 	updateEventUseCase usecase.UseCaseWithPropsDecorator[dtos.UpdateEventProps, *dtos.EventDto]
 	deleteEventUseCase usecase.UseCaseWithPropsDecorator[usecases.DeleteEventProps, struct{}]
-	getEventsByUserUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto]
+	getEventsByUserUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByUserProps, []dtos.EventDto]
 	getEventByIdUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventByIdUseCaseProps, dtos.EventWithAttendeesDto]
-	registerToEventUseCase usecase.UseCaseWithPropsDecorator[usecases.RegisterToEventUseCaseProps, []string]
+	// Not decorated: the decorator would log the answers of the attendees.
+	registerToEventUseCase usecase.UseCaseWithProps[usecases.RegisterToEventUseCaseProps, []string]
 	cancelEventSubscriptionUseCase usecase.UseCaseWithPropsDecorator[usecases.CancelEventSubscriptionUseCaseProps, []string]
 	getEventByOrganizerUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventByOrganizerUseCaseProps, dtos.EventWithAttendeesDto]
-	getEventsByOrganizerUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto]
-	getEventsByCategoryUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto]
-	getEventsByTermUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto]
+	getEventsByOrganizerUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByOrganizerProps, []dtos.EventDto]
+	getEventsByCategoryUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByCategoryProps, []dtos.EventDto]
+	getEventsByTermUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByTermProps, []dtos.EventDto]
+	exportAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.ExportAttendeesDto, *dtos.AttendeeExportDto]
+	importEventsUseCase usecase.UseCaseWithPropsDecorator[dtos.ImportEventsDto, *dtos.EventImportDto]
+	getEventImportUseCase usecase.UseCaseWithPropsDecorator[dtos.GetEventImportDto, *dtos.EventImportDto]
+	addAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.AddAttendeesDto, *dtos.BulkAttendeesResultDto]
+	removeAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.RemoveAttendeesDto, *dtos.BulkAttendeesResultDto]
+	moveAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.MoveAttendeesDto, *dtos.BulkAttendeesResultDto]
+	listEventInvitesUseCase usecase.UseCaseWithPropsDecorator[dtos.ListEventInvitesDto, []dtos.EventInviteDto]
+	cancelEventInviteUseCase usecase.UseCaseWithPropsDecorator[dtos.CancelEventInviteDto, struct{}]
+	checkInAttendeeUseCase usecase.UseCaseWithPropsDecorator[dtos.CheckInAttendeeDto, *dtos.AttendeeCheckInDto]
 }
 
 func NewEventsController(
-	getEventsUseCase usecase.UseCaseDecorator[[]dtos.EventDto],
+	getEventsUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsProps, []dtos.EventDto],
 	createEventUseCase usecase.UseCaseWithPropsDecorator[dtos.CreateEventProps, *dtos.EventDto],
 	updateEventUseCase usecase.UseCaseWithPropsDecorator[dtos.UpdateEventProps, *dtos.EventDto],
 	deleteEventUseCase usecase.UseCaseWithPropsDecorator[usecases.DeleteEventProps, struct{}],
-	getEventsByUserUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto],
+	getEventsByUserUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByUserProps, []dtos.EventDto],
 	getEventByIdUsecase usecase.UseCaseWithPropsDecorator[usecases.GetEventByIdUseCaseProps, dtos.EventWithAttendeesDto],
-	registerToEventUseCase usecase.UseCaseWithPropsDecorator[usecases.RegisterToEventUseCaseProps, []string],
+	registerToEventUseCase usecase.UseCaseWithProps[usecases.RegisterToEventUseCaseProps, []string],
 	cancelEventSubscriptionUseCase usecase.UseCaseWithPropsDecorator[usecases.CancelEventSubscriptionUseCaseProps, []string],
 	getEventByOrganizerUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventByOrganizerUseCaseProps, dtos.EventWithAttendeesDto],
-	getEventsByOrganizerUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto],
-	getEventsByCategoryUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto],
-	getEventsByTermUseCase usecase.UseCaseWithPropsDecorator[string, []dtos.EventDto],
+	getEventsByOrganizerUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByOrganizerProps, []dtos.EventDto],
+	getEventsByCategoryUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByCategoryProps, []dtos.EventDto],
+	getEventsByTermUseCase usecase.UseCaseWithPropsDecorator[usecases.GetEventsByTermProps, []dtos.EventDto],
+	exportAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.ExportAttendeesDto, *dtos.AttendeeExportDto],
+	importEventsUseCase usecase.UseCaseWithPropsDecorator[dtos.ImportEventsDto, *dtos.EventImportDto],
+	getEventImportUseCase usecase.UseCaseWithPropsDecorator[dtos.GetEventImportDto, *dtos.EventImportDto],
+	addAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.AddAttendeesDto, *dtos.BulkAttendeesResultDto],
+	removeAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.RemoveAttendeesDto, *dtos.BulkAttendeesResultDto],
+	moveAttendeesUseCase usecase.UseCaseWithPropsDecorator[dtos.MoveAttendeesDto, *dtos.BulkAttendeesResultDto],
+	listEventInvitesUseCase usecase.UseCaseWithPropsDecorator[dtos.ListEventInvitesDto, []dtos.EventInviteDto],
+	cancelEventInviteUseCase usecase.UseCaseWithPropsDecorator[dtos.CancelEventInviteDto, struct{}],
+	checkInAttendeeUseCase usecase.UseCaseWithPropsDecorator[dtos.CheckInAttendeeDto, *dtos.AttendeeCheckInDto],
 ) *EventsController {
 	return &EventsController{
 		getEventsUseCase: getEventsUseCase,
@@ -58,13 +83,22 @@ func NewEventsController(
 		getEventsByOrganizerUseCase: getEventsByOrganizerUseCase,
 		getEventsByCategoryUseCase: getEventsByCategoryUseCase,
 		getEventsByTermUseCase: getEventsByTermUseCase,
+		exportAttendeesUseCase: exportAttendeesUseCase,
+		importEventsUseCase: importEventsUseCase,
+		getEventImportUseCase: getEventImportUseCase,
+		addAttendeesUseCase: addAttendeesUseCase,
+		removeAttendeesUseCase: removeAttendeesUseCase,
+		moveAttendeesUseCase: moveAttendeesUseCase,
+		listEventInvitesUseCase: listEventInvitesUseCase,
+		cancelEventInviteUseCase: cancelEventInviteUseCase,
+		checkInAttendeeUseCase: checkInAttendeeUseCase,
 	}
 }
 
 func (ec EventsController) GetAllEvents(c *gin.Context) {
-	events, err := ec.getEventsUseCase.Execute()
+	events, err := ec.getEventsUseCase.Execute(usecases.GetEventsProps{Ctx: c.Request.Context()})
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -82,19 +116,20 @@ func (ec EventsController) CreateEvent(c *gin.Context) {
 	
 	body := dtos.CreateEventProps{}
 	if err := c.ShouldBindJSON(&body); err != nil {
-		log.Printf("Binding error: %v", err)
-		c.JSON(400, gin.H{"error": "Invalid request body", "details": err.Error()})
+		c.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	log.Printf("Parsed event data before setting OrganizerID: %+v", body)
+	body.Ctx = c.Request.Context()
 	body.OrganizerID = userID.(string)
+	body.IP = c.ClientIP()
 	log.Printf("Event data after setting OrganizerID: %+v", body)
 
 	createdEvent, err := ec.createEventUseCase.Execute(body)
 	if err != nil {
 		log.Printf(useCaseErrorLog, err)
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -109,9 +144,12 @@ func (ec EventsController) GetEventsByUser(c *gin.Context) {
 		return
 	}
 
-	events, err := ec.getEventsByUserUseCase.Execute(userID.(string))
+	events, err := ec.getEventsByUserUseCase.Execute(usecases.GetEventsByUserProps{
+		Ctx:    c.Request.Context(),
+		UserID: userID.(string),
+	})
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -132,11 +170,12 @@ func (ec EventsController) GetEventById(c *gin.Context) {
 	}
 
 	event, err := ec.getEventByIdUseCase.Execute(usecases.GetEventByIdUseCaseProps{
+		Ctx:     c.Request.Context(),
 		EventID: eventID,
 		UserID:  userID.(string),
 	})
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -156,14 +195,25 @@ func (ec EventsController) RegisterToEvent(c *gin.Context) {
 		return
 	}
 
+	// The body is optional for events without ticket types or questions.
+	body := dtos.EventRegistrationDto{}
+	if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
 	props := usecases.RegisterToEventUseCaseProps{
+		Ctx: c.Request.Context(),
 		UserId: userID.(string),
 		EventId: eventID,
+		TicketType: body.TicketType,
+		Answers: body.Answers,
+		IP: c.ClientIP(),
 	}
 
 	attendees, err := ec.registerToEventUseCase.Execute(props)
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -184,13 +234,15 @@ func (ec EventsController) CancelEventSubscription(c *gin.Context) {
 	}
 
 	props := usecases.CancelEventSubscriptionUseCaseProps{
+		Ctx:     c.Request.Context(),
 		UserId:  userID.(string),
 		EventId: eventID,
+		IP:      c.ClientIP(),
 	}
 
 	attendees, err := ec.cancelEventSubscriptionUseCase.Execute(props)
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -214,13 +266,14 @@ func (ec EventsController) GetEventByOrganizer(c *gin.Context) {
 	}
 
 	props := usecases.GetEventByOrganizerUseCaseProps{
+		Ctx:         c.Request.Context(),
 		OrganizerId: userID.(string),
 		EventId:     eventId,
 	}
 
 	event, err := ec.getEventByOrganizerUseCase.Execute(props)
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -236,10 +289,13 @@ func (ec EventsController) GetEventsByOrganizer(c *gin.Context) {
 
 	log.Printf("GetEventsByOrganizer - UserID from context: %s", userID.(string))
 
-	events, err := ec.getEventsByOrganizerUseCase.Execute(userID.(string))
+	events, err := ec.getEventsByOrganizerUseCase.Execute(usecases.GetEventsByOrganizerProps{
+		Ctx:         c.Request.Context(),
+		OrganizerID: userID.(string),
+	})
 	if err != nil {
 		log.Printf("Error getting events by organizer for user %s: %v", userID.(string), err)
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -254,9 +310,12 @@ func (ec EventsController) GetEventsByCategory(c *gin.Context) {
 		return
 	}
 
-	events, err := ec.getEventsByCategoryUseCase.Execute(category)
+	events, err := ec.getEventsByCategoryUseCase.Execute(usecases.GetEventsByCategoryProps{
+		Ctx:      c.Request.Context(),
+		Category: category,
+	})
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -270,9 +329,12 @@ func (ec EventsController) GetEventsByTerm(c *gin.Context) {
 		return
 	}
 
-	events, err := ec.getEventsByTermUseCase.Execute(term)
+	events, err := ec.getEventsByTermUseCase.Execute(usecases.GetEventsByTermProps{
+		Ctx:  c.Request.Context(),
+		Term: term,
+	})
 	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -294,19 +356,20 @@ func (ec EventsController) UpdateEvent(c *gin.Context) {
 
 	body := dtos.UpdateEventProps{}
 	if err := c.ShouldBindJSON(&body); err != nil {
-		log.Printf("Binding error: %v", err)
-		c.JSON(400, gin.H{"error": "Invalid request body", "details": err.Error()})
+		c.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	// Set the event ID and organizer ID from path and auth
+	body.Ctx = c.Request.Context()
 	body.EventID = eventID
 	body.OrganizerID = userID.(string)
+	body.IP = c.ClientIP()
 
 	updatedEvent, err := ec.updateEventUseCase.Execute(body)
 	if err != nil {
 		log.Printf(useCaseErrorLog, err)
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -328,14 +391,16 @@ func (ec EventsController) DeleteEvent(c *gin.Context) {
 	}
 
 	props := usecases.DeleteEventProps{
+		Ctx:         c.Request.Context(),
 		EventID:     eventID,
 		OrganizerID: userID.(string),
+		IP:          c.ClientIP(),
 	}
 
 	_, err := ec.deleteEventUseCase.Execute(props)
 	if err != nil {
 		log.Printf(useCaseErrorLog, err)
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -343,11 +408,362 @@ func (ec EventsController) DeleteEvent(c *gin.Context) {
 	c.JSON(200, gin.H{"message": "Event deleted successfully"})
 }
 
+// ExportAttendees streams the attendee list of an event as CSV or XLSX.
+// Once the first byte is sent the status can no longer change, so errors
+// while streaming only cut the download short and are logged.
+func (ec EventsController) ExportAttendees(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	props := dtos.ExportAttendeesDto{}
+	if err := c.ShouldBindQuery(&props); err != nil {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if props.Format == "" {
+		props.Format = dtos.AttendeeExportCSV
+	}
+	props.Ctx = c.Request.Context()
+	props.EventID = eventID
+	props.OrganizerID = userID.(string)
+	props.IP = c.ClientIP()
+
+	export, err := ec.exportAttendeesUseCase.Execute(props)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if props.Format == dtos.AttendeeExportXLSX {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="attendees-%s.%s"`, export.EventID, props.Format))
+	c.Status(200)
+
+	if err := writeAttendees(c.Writer, props.Format, export); err != nil {
+		log.Printf("Exporting attendees of event %s: %v", eventID, err)
+	}
+}
+
+func writeAttendees(w io.Writer, format string, export *dtos.AttendeeExportDto) error {
+	var table utils.TableWriter
+	if format == dtos.AttendeeExportXLSX {
+		var err error
+		if table, err = utils.NewXLSXTableWriter(w, "Attendees"); err != nil {
+			return err
+		}
+	} else {
+		table = utils.NewCSVTableWriter(w)
+	}
+
+	// Each question of the event gets a column with its answers.
+	header := []string{"name", "email", "registered_at", "ticket_type"}
+	header = append(header, export.Questions...)
+	header = append(header, "checked_in", "checked_in_at")
+	if err := table.WriteRow(header); err != nil {
+		return err
+	}
+	for row, err := range export.Rows {
+		if err != nil {
+			return err
+		}
+		record := []string{row.Name, row.Email, exportTime(row.RegisteredAt), row.TicketType}
+		for _, question := range export.Questions {
+			record = append(record, row.Answers[question])
+		}
+		checkedIn, checkedInAt := "no", ""
+		if row.CheckedInAt != nil {
+			checkedIn, checkedInAt = "yes", exportTime(*row.CheckedInAt)
+		}
+		record = append(record, checkedIn, checkedInAt)
+		if err := table.WriteRow(record); err != nil {
+			return err
+		}
+	}
+	return table.Close()
+}
+
+// exportTime formats t in UTC, leaving unknown times blank.
+func exportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// maxEventImportSize caps the size of imported files.
+const maxEventImportSize = 10 << 20
+
+// ImportEvents takes the file as the request body. Without the format query
+// parameter, text/csv bodies are read as CSV and the others as JSON.
+func (ec EventsController) ImportEvents(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	props := dtos.ImportEventsDto{}
+	if err := c.ShouldBindQuery(&props); err != nil {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if props.Format == "" {
+		props.Format = dtos.EventImportJSON
+		if c.ContentType() == "text/csv" {
+			props.Format = dtos.EventImportCSV
+		}
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxEventImportSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File is larger than 10 MB"})
+			return
+		}
+		c.Error(err)
+		return
+	}
+	props.Ctx = c.Request.Context()
+	props.OrganizerID = userID.(string)
+	props.Data = data
+	props.IP = c.ClientIP()
+
+	eventImport, err := ec.importEventsUseCase.Execute(props)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if props.Async {
+		c.JSON(http.StatusAccepted, eventImport)
+		return
+	}
+	c.JSON(200, eventImport)
+}
+
+func (ec EventsController) GetEventImport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	eventImport, err := ec.getEventImportUseCase.Execute(dtos.GetEventImportDto{
+		Ctx:         c.Request.Context(),
+		OrganizerID: userID.(string),
+		ImportID:    c.Param("importID"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, eventImport)
+}
+
+// AddAttendees registers users to an event on behalf of its organizer and
+// invites the emails without an account.
+func (ec EventsController) AddAttendees(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	body := dtos.AddAttendeesDto{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	body.Ctx = c.Request.Context()
+	body.EventID = eventID
+	body.OrganizerID = userID.(string)
+	body.IP = c.ClientIP()
+
+	result, err := ec.addAttendeesUseCase.Execute(body)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, result)
+}
+
+func (ec EventsController) RemoveAttendees(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	body := dtos.RemoveAttendeesDto{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	body.Ctx = c.Request.Context()
+	body.EventID = eventID
+	body.OrganizerID = userID.(string)
+	body.IP = c.ClientIP()
+
+	result, err := ec.removeAttendeesUseCase.Execute(body)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, result)
+}
+
+// MoveAttendees answers with the attendees left in the source event.
+func (ec EventsController) MoveAttendees(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	body := dtos.MoveAttendeesDto{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	body.Ctx = c.Request.Context()
+	body.EventID = eventID
+	body.OrganizerID = userID.(string)
+	body.IP = c.ClientIP()
+
+	result, err := ec.moveAttendeesUseCase.Execute(body)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, result)
+}
+
+func (ec EventsController) ListEventInvites(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	invites, err := ec.listEventInvitesUseCase.Execute(dtos.ListEventInvitesDto{
+		Ctx:         c.Request.Context(),
+		EventID:     eventID,
+		OrganizerID: userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, invites)
+}
+
+func (ec EventsController) CancelEventInvite(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	_, err := ec.cancelEventInviteUseCase.Execute(dtos.CancelEventInviteDto{
+		Ctx:         c.Request.Context(),
+		EventID:     eventID,
+		OrganizerID: userID.(string),
+		InviteID:    c.Param("inviteID"),
+		IP:          c.ClientIP(),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, gin.H{"message": "Invite cancelled successfully"})
+}
+
+// CheckInAttendee records that an attendee arrived at the event, on behalf of
+// its organizer.
+func (ec EventsController) CheckInAttendee(c *gin.Context) {
+	eventID := c.Param("eventID")
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(400, userIDRequired)
+		return
+	}
+
+	if eventID == "" {
+		c.JSON(400, eventIDRequired)
+		return
+	}
+
+	checkIn, err := ec.checkInAttendeeUseCase.Execute(dtos.CheckInAttendeeDto{
+		Ctx:         c.Request.Context(),
+		EventID:     eventID,
+		OrganizerID: userID.(string),
+		UserID:      c.Param("userID"),
+		IP:          c.ClientIP(),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(200, checkIn)
+}
+
 func (ec EventsController) SetupRoutes() {
 	group := r.Router.Group("/events")
 
 	group.GET("/", ec.GetAllEvents)
 	group.POST("/", ec.CreateEvent)
+	group.POST("/import", ec.ImportEvents)
+	group.GET("/import/:importID", ec.GetEventImport)
 	group.GET("/registered", ec.GetEventsByUser)
 	group.GET(eventIDRoute, ec.GetEventById)
 	group.PUT(eventIDRoute, ec.UpdateEvent)
@@ -355,6 +771,13 @@ func (ec EventsController) SetupRoutes() {
 	group.POST("/:eventID/register", ec.RegisterToEvent)
 	group.DELETE("/:eventID/register", ec.CancelEventSubscription)
 	group.GET("/:eventID/organizer", ec.GetEventByOrganizer)
+	group.GET("/:eventID/attendees/export", ec.ExportAttendees)
+	group.POST("/:eventID/attendees", ec.AddAttendees)
+	group.POST("/:eventID/attendees/remove", ec.RemoveAttendees)
+	group.POST("/:eventID/attendees/move", ec.MoveAttendees)
+	group.POST("/:eventID/attendees/:userID/check-in", ec.CheckInAttendee)
+	group.GET("/:eventID/invites", ec.ListEventInvites)
+	group.DELETE("/:eventID/invites/:inviteID", ec.CancelEventInvite)
 	group.GET("/organizer", ec.GetEventsByOrganizer)
 	group.GET("/category", ec.GetEventsByCategory)
 	group.GET("/search", ec.GetEventsByTerm)
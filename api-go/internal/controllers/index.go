@@ -1,7 +1,11 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/database"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
@@ -12,8 +16,12 @@ import (
 
 var Controllers = []controller.Controller{}
 
-func SetupControllers() {
-	jwtService := ports.NewJWTService()
+// maxAsyncEventImports caps the imports running in the background, each
+// holding its file in memory.
+const maxAsyncEventImports = 4
+
+func SetupControllers(authConfig *config.AuthConfig, oidcConfig *config.OIDCConfig, cookieConfig *config.CookieConfig, jwtService services.IJWTService, passwordHasher services.IPasswordHasher, passwordPolicy usecases.PasswordPolicy) {
+	mailer := ports.NewMailer()
 
 	mapper := mappers.EventMapper{}
 	authMapper := mappers.AuthMapper{}
@@ -22,17 +30,31 @@ func SetupControllers() {
 	eventRepository := database.NewEventRepository(connection.Db, mapper)
 	userRepository := database.NewUserRepository(connection.Db, userMapper)
 	authRepository := database.NewAuthRepository(connection.Db, authMapper)
+	twoFactorRepository := database.NewTwoFactorRepository(connection.Db, mappers.TwoFactorMapper{})
+	sessionRepository := database.NewSessionRepository(connection.Db, mappers.SessionMapper{})
+	unitOfWork := database.NewUnitOfWork(connection.Db)
+
+	verificationPolicy := usecases.EmailVerificationPolicy{RequireVerified: authConfig.RequireVerifiedEmail}
+	loginGuard := usecases.NewLoginGuard(unitOfWork, usecases.LoginThrottlePolicy{
+		FreeAttempts:   authConfig.LoginFreeAttempts,
+		IPFreeAttempts: authConfig.LoginIPFreeAttempts,
+		BaseDelay:      time.Second,
+		MaxDelay:       time.Minute,
+		LockThreshold:  authConfig.LoginLockThreshold,
+		LockDuration:   authConfig.LoginLockDuration,
+		ResetAfter:     authConfig.LoginLockDuration,
+	}, mailer, authConfig.APIURL, authConfig.AccountUnlockTTL)
 
 	getEventsUseCase := usecases.NewGetEventsUseCase(eventRepository)
-	getEventsDecorator := usecase.NewUseCaseDecorator(getEventsUseCase)
+	getEventsDecorator := usecase.NewUseCaseWithPropsDecorator(getEventsUseCase)
 
-	createEventUseCase := usecases.NewCreateEventUseCase(eventRepository)
+	createEventUseCase := usecases.NewCreateEventUseCase(unitOfWork, verificationPolicy)
 	createEventDecorator := usecase.NewUseCaseWithPropsDecorator(createEventUseCase)
 
-	updateEventUseCase := usecases.NewUpdateEventUseCase(eventRepository)
+	updateEventUseCase := usecases.NewUpdateEventUseCase(unitOfWork)
 	updateEventDecorator := usecase.NewUseCaseWithPropsDecorator(updateEventUseCase)
 
-	deleteEventUseCase := usecases.NewDeleteEventUseCase(eventRepository)
+	deleteEventUseCase := usecases.NewDeleteEventUseCase(unitOfWork)
 	deleteEventDecorator := usecase.NewUseCaseWithPropsDecorator(deleteEventUseCase)
 
 	getEventsByUserUseCase := usecases.NewGetEventsByUserUseCase(userRepository, eventRepository)
@@ -41,10 +63,9 @@ func SetupControllers() {
 	getEventByIdUseCase := usecases.NewGetEventByIdUseCase(eventRepository, userRepository)
 	getEventByIdDecorator := usecase.NewUseCaseWithPropsDecorator(getEventByIdUseCase)
 
-	registerToEventUseCase := usecases.NewRegisterToEventUseCase(userRepository, eventRepository)
-	registerToEventDecorator := usecase.NewUseCaseWithPropsDecorator(registerToEventUseCase)
+	registerToEventUseCase := usecases.NewRegisterToEventUseCase(unitOfWork, verificationPolicy)
 
-	cancelEventSubscriptionUseCase := usecases.NewCancelEventSubscriptionUseCase(userRepository, eventRepository)
+	cancelEventSubscriptionUseCase := usecases.NewCancelEventSubscriptionUseCase(unitOfWork)
 	cancelEventSubscriptionDecorator := usecase.NewUseCaseWithPropsDecorator(cancelEventSubscriptionUseCase)
 
 	getEventByOrganizerUseCase := usecases.NewGetEventByOrganizerUseCase(eventRepository, userRepository)
@@ -59,6 +80,28 @@ func SetupControllers() {
 	getEventsByTermUseCase := usecases.NewGetEventsByTermUseCase(eventRepository)
 	getEventsByTermDecorator := usecase.NewUseCaseWithPropsDecorator(getEventsByTermUseCase)
 
+	exportAttendeesUseCase := usecases.NewExportAttendeesUseCase(unitOfWork, userRepository)
+	exportAttendeesDecorator := usecase.NewUseCaseWithPropsDecorator(exportAttendeesUseCase)
+
+	eventImportRepository := database.NewEventImportRepository(connection.Db, mappers.EventImportMapper{})
+	importEventsUseCase := usecases.NewImportEventsUseCase(unitOfWork, eventImportRepository, verificationPolicy, maxAsyncEventImports)
+	importEventsDecorator := usecase.NewUseCaseWithPropsDecorator(importEventsUseCase)
+	getEventImportUseCase := usecases.NewGetEventImportUseCase(eventImportRepository)
+	getEventImportDecorator := usecase.NewUseCaseWithPropsDecorator(getEventImportUseCase)
+
+	addAttendeesUseCase := usecases.NewAddAttendeesUseCase(unitOfWork, mailer, authConfig.FrontendURL)
+	addAttendeesDecorator := usecase.NewUseCaseWithPropsDecorator(addAttendeesUseCase)
+	removeAttendeesUseCase := usecases.NewRemoveAttendeesUseCase(unitOfWork)
+	removeAttendeesDecorator := usecase.NewUseCaseWithPropsDecorator(removeAttendeesUseCase)
+	moveAttendeesUseCase := usecases.NewMoveAttendeesUseCase(unitOfWork)
+	moveAttendeesDecorator := usecase.NewUseCaseWithPropsDecorator(moveAttendeesUseCase)
+	listEventInvitesUseCase := usecases.NewListEventInvitesUseCase(unitOfWork)
+	listEventInvitesDecorator := usecase.NewUseCaseWithPropsDecorator(listEventInvitesUseCase)
+	cancelEventInviteUseCase := usecases.NewCancelEventInviteUseCase(unitOfWork)
+	cancelEventInviteDecorator := usecase.NewUseCaseWithPropsDecorator(cancelEventInviteUseCase)
+	checkInAttendeeUseCase := usecases.NewCheckInAttendeeUseCase(unitOfWork)
+	checkInAttendeeDecorator := usecase.NewUseCaseWithPropsDecorator(checkInAttendeeUseCase)
+
 	eventsController := NewEventsController(
 		getEventsDecorator,
 		createEventDecorator,
@@ -66,30 +109,153 @@ func SetupControllers() {
 		deleteEventDecorator,
 		getEventsByUserDecorator,
 		getEventByIdDecorator,
-		registerToEventDecorator,
+		registerToEventUseCase,
 		cancelEventSubscriptionDecorator,
 		getEventByOrganizerDecorator,
 		getEventsByOrganizerDecorator,
 		getEventsByCategoryDecorator,
 		getEventsByTermDecorator,
+		exportAttendeesDecorator,
+		importEventsDecorator,
+		getEventImportDecorator,
+		addAttendeesDecorator,
+		removeAttendeesDecorator,
+		moveAttendeesDecorator,
+		listEventInvitesDecorator,
+		cancelEventInviteDecorator,
+		checkInAttendeeDecorator,
 	)
 	controller.Add(eventsController)
 
-	getAuthsUseCase := usecases.NewGetAuthsUseCase(authRepository)
-	getAuthsDecorator := usecase.NewUseCaseDecorator(getAuthsUseCase)
-	loginUseCase := usecases.NewLoginUseCase(authRepository, userRepository, jwtService)
-	loginDecorator := usecase.NewUseCaseWithPropsDecorator(loginUseCase)
+	getCredentialsUseCase := usecases.NewGetCredentialsUseCase(unitOfWork)
+	getCredentialsDecorator := usecase.NewUseCaseWithPropsDecorator(getCredentialsUseCase)
+	loginUseCase := usecases.NewLoginUseCase(authRepository, userRepository, twoFactorRepository, sessionRepository, passwordHasher, jwtService, loginGuard)
+	loginTwoFactorUseCase := usecases.NewLoginTwoFactorUseCase(unitOfWork, jwtService, loginGuard)
+	unlockAccountUseCase := usecases.NewUnlockAccountUseCase(unitOfWork)
 
-	authController := NewAuthController(getAuthsDecorator, loginDecorator)
+	forgotPasswordUseCase := usecases.NewForgotPasswordUseCase(unitOfWork, mailer, authConfig.FrontendURL, authConfig.PasswordResetTTL)
+	forgotPasswordDecorator := usecase.NewUseCaseWithPropsDecorator(forgotPasswordUseCase)
+	resetPasswordUseCase := usecases.NewResetPasswordUseCase(unitOfWork, passwordHasher, passwordPolicy)
+
+	verifyEmailUseCase := usecases.NewVerifyEmailUseCase(unitOfWork)
+	resendVerificationUseCase := usecases.NewResendVerificationUseCase(unitOfWork, mailer, authConfig.APIURL, authConfig.EmailVerificationTTL, authConfig.VerificationResendWindow)
+	resendVerificationDecorator := usecase.NewUseCaseWithPropsDecorator(resendVerificationUseCase)
+
+	confirmEmailChangeUseCase := usecases.NewConfirmEmailChangeUseCase(unitOfWork)
+
+	authController := NewAuthController(
+		getCredentialsDecorator,
+		loginUseCase,
+		loginTwoFactorUseCase,
+		forgotPasswordDecorator,
+		resetPasswordUseCase,
+		verifyEmailUseCase,
+		resendVerificationDecorator,
+		unlockAccountUseCase,
+		confirmEmailChangeUseCase,
+		usecases.NewRevokeSessionUseCase(unitOfWork),
+		cookieConfig,
+	)
 	controller.Add(authController)
 
+	twoFactorController := NewTwoFactorController(
+		usecases.NewEnrollTwoFactorUseCase(unitOfWork, authConfig.TOTPIssuer),
+		usecases.NewConfirmTwoFactorUseCase(unitOfWork),
+		usecases.NewDisableTwoFactorUseCase(unitOfWork, passwordHasher),
+		usecases.NewRegenerateRecoveryCodesUseCase(unitOfWork),
+	)
+	controller.Add(twoFactorController)
+
+	oidcProviders := map[string]services.IOIDCProvider{}
+	for _, provider := range oidcConfig.Providers {
+		oidcProviders[provider.Name] = ports.NewOIDCProvider(ports.OIDCProviderSettings{
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		})
+	}
+	oidcController := NewOIDCController(
+		usecases.NewStartOIDCLoginUseCase(oidcProviders),
+		usecases.NewCompleteOIDCLoginUseCase(oidcProviders, unitOfWork, jwtService, oidcConfig.DefaultUserType),
+		cookieConfig,
+	)
+	controller.Add(oidcController)
+
+	getJWKSUseCase := usecases.NewGetJWKSUseCase(jwtService)
+	getJWKSDecorator := usecase.NewUseCaseWithPropsDecorator(getJWKSUseCase)
+	wellKnownController := NewWellKnownController(getJWKSDecorator)
+	controller.Add(wellKnownController)
+
 	getUsersUseCase := usecases.NewGetUsersUseCase(userRepository)
-	getUsersDecorator := usecase.NewUseCaseDecorator(getUsersUseCase)
-	createUserUseCase := usecases.NewCreateUserUseCase(userRepository, authRepository)
-	createUserDecorator := usecase.NewUseCaseWithPropsDecorator(createUserUseCase)
+	getUsersDecorator := usecase.NewUseCaseWithPropsDecorator(getUsersUseCase)
+	createUserUseCase := usecases.NewCreateUserUseCase(unitOfWork, passwordHasher, passwordPolicy, mailer, authConfig.APIURL, authConfig.EmailVerificationTTL)
 	getUserUseCase := usecases.NewGetUserUseCase(userRepository)
 	getUserDecorator := usecase.NewUseCaseWithPropsDecorator(getUserUseCase)
 
-	usersController := NewUsersController(createUserDecorator, getUsersDecorator, getUserDecorator)
+	getProfileUseCase := usecases.NewGetProfileUseCase(userRepository)
+	getProfileDecorator := usecase.NewUseCaseWithPropsDecorator(getProfileUseCase)
+
+	usersController := NewUsersController(
+		createUserUseCase,
+		getUsersDecorator,
+		getUserDecorator,
+		getProfileDecorator,
+		usecases.NewUpdateProfileUseCase(unitOfWork, passwordHasher, mailer, authConfig.APIURL, authConfig.EmailVerificationTTL),
+		usecases.NewChangePasswordUseCase(unitOfWork, passwordHasher, passwordPolicy, jwtService),
+		usecases.NewExportUserDataUseCase(unitOfWork),
+		usecases.NewDeleteAccountUseCase(unitOfWork, passwordHasher),
+		cookieConfig,
+	)
 	controller.Add(usersController)
+
+	listAPIKeysUseCase := usecases.NewListAPIKeysUseCase(database.NewAPIKeyRepository(connection.Db, mappers.APIKeyMapper{}))
+	listAPIKeysDecorator := usecase.NewUseCaseWithPropsDecorator(listAPIKeysUseCase)
+	revokeAPIKeyUseCase := usecases.NewRevokeAPIKeyUseCase(unitOfWork)
+	revokeAPIKeyDecorator := usecase.NewUseCaseWithPropsDecorator(revokeAPIKeyUseCase)
+	apiKeysController := NewAPIKeysController(
+		usecases.NewCreateAPIKeyUseCase(unitOfWork),
+		listAPIKeysDecorator,
+		revokeAPIKeyDecorator,
+	)
+	controller.Add(apiKeysController)
+
+	listSessionsUseCase := usecases.NewListSessionsUseCase(sessionRepository)
+	listSessionsDecorator := usecase.NewUseCaseWithPropsDecorator(listSessionsUseCase)
+	revokeSessionUseCase := usecases.NewRevokeSessionUseCase(unitOfWork)
+	revokeSessionDecorator := usecase.NewUseCaseWithPropsDecorator(revokeSessionUseCase)
+	sessionsController := NewSessionsController(listSessionsDecorator, revokeSessionDecorator)
+	controller.Add(sessionsController)
+
+	listAuditLogUseCase := usecases.NewListAuditLogUseCase(userRepository, database.NewAuditLogRepository(connection.Db, mappers.AuditEntryMapper{}))
+	listAuditLogDecorator := usecase.NewUseCaseWithPropsDecorator(listAuditLogUseCase)
+	searchUsersUseCase := usecases.NewSearchUsersUseCase(userRepository)
+	searchUsersDecorator := usecase.NewUseCaseWithPropsDecorator(searchUsersUseCase)
+	suspendUserUseCase := usecases.NewSuspendUserUseCase(unitOfWork)
+	suspendUserDecorator := usecase.NewUseCaseWithPropsDecorator(suspendUserUseCase)
+	reactivateUserUseCase := usecases.NewReactivateUserUseCase(unitOfWork)
+	reactivateUserDecorator := usecase.NewUseCaseWithPropsDecorator(reactivateUserUseCase)
+	forceCancelEventUseCase := usecases.NewForceCancelEventUseCase(unitOfWork)
+	forceCancelEventDecorator := usecase.NewUseCaseWithPropsDecorator(forceCancelEventUseCase)
+	unpublishEventUseCase := usecases.NewSetEventPublishedUseCase(unitOfWork, false)
+	unpublishEventDecorator := usecase.NewUseCaseWithPropsDecorator(unpublishEventUseCase)
+	republishEventUseCase := usecases.NewSetEventPublishedUseCase(unitOfWork, true)
+	republishEventDecorator := usecase.NewUseCaseWithPropsDecorator(republishEventUseCase)
+	reassignOrganizerUseCase := usecases.NewReassignEventOrganizerUseCase(unitOfWork)
+	reassignOrganizerDecorator := usecase.NewUseCaseWithPropsDecorator(reassignOrganizerUseCase)
+	removeAttendeeUseCase := usecases.NewRemoveAttendeeUseCase(unitOfWork)
+	removeAttendeeDecorator := usecase.NewUseCaseWithPropsDecorator(removeAttendeeUseCase)
+	adminController := NewAdminController(
+		listAuditLogDecorator,
+		searchUsersDecorator,
+		suspendUserDecorator,
+		reactivateUserDecorator,
+		forceCancelEventDecorator,
+		unpublishEventDecorator,
+		republishEventDecorator,
+		reassignOrganizerDecorator,
+		removeAttendeeDecorator,
+	)
+	controller.Add(adminController)
 }
\ No newline at end of file
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	oidcLoginCookie = "oidc_login"
+	// oidcLoginMaxAge bounds, in seconds, how long the user may take at the
+	// provider.
+	oidcLoginMaxAge = 600
+)
+
+// OIDCController runs single sign-on with OpenID Connect providers. Its use
+// cases are not decorated because the decorator would log the PKCE verifier
+// and the issued tokens.
+type OIDCController struct {
+	startLoginUseCase    usecase.UseCaseWithProps[usecases.StartOIDCLoginProps, *dtos.OIDCLoginStartDto]
+	completeLoginUseCase usecase.UseCaseWithProps[dtos.OIDCCallbackDto, *dtos.LoginResultDto]
+	cookies              sessionCookies
+}
+
+func NewOIDCController(
+	startLoginUC usecase.UseCaseWithProps[usecases.StartOIDCLoginProps, *dtos.OIDCLoginStartDto],
+	completeLoginUC usecase.UseCaseWithProps[dtos.OIDCCallbackDto, *dtos.LoginResultDto],
+	cookieConfig *config.CookieConfig,
+) *OIDCController {
+	return &OIDCController{
+		startLoginUseCase:    startLoginUC,
+		completeLoginUseCase: completeLoginUC,
+		cookies:              sessionCookies{config: cookieConfig},
+	}
+}
+
+// Login redirects to the provider, remembering the state, nonce and PKCE
+// verifier of the attempt in a cookie only sent back to the callback.
+func (c *OIDCController) Login(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+
+	start, err := c.startLoginUseCase.Execute(usecases.StartOIDCLoginProps{
+		Ctx:      ctx.Request.Context(),
+		Provider: provider,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// Lax whatever the configuration, so the cookie comes back on the
+	// top-level redirect from the provider.
+	value := strings.Join([]string{start.State, start.Nonce, start.CodeVerifier}, ".")
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oidcLoginCookie, value, oidcLoginMaxAge, oidcCallbackPath(provider), c.cookies.config.Domain, c.cookies.config.Secure, true)
+	ctx.Redirect(http.StatusFound, start.AuthURL)
+}
+
+// Callback answers like AuthController.Login once the provider sends the
+// user back.
+func (c *OIDCController) Callback(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+
+	var input dtos.OIDCCallbackDto
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.Provider = provider
+	input.IP = ctx.ClientIP()
+	input.UserAgent = ctx.Request.UserAgent()
+
+	// The attempt is single-use whatever the outcome.
+	c.cookies.set(ctx, oidcLoginCookie, "", -1, oidcCallbackPath(provider), true)
+	cookie, err := ctx.Cookie(oidcLoginCookie)
+	if err != nil {
+		ctx.Error(exceptions.NewUnauthorizedException("Invalid or expired login attempt"))
+		return
+	}
+	if parts := strings.Split(cookie, "."); len(parts) == 3 {
+		input.ExpectedState, input.Nonce, input.CodeVerifier = parts[0], parts[1], parts[2]
+	}
+
+	result, err := c.completeLoginUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if result.TwoFactorRequired {
+		ctx.JSON(http.StatusOK, result)
+		return
+	}
+
+	c.cookies.respondWithToken(ctx, result.Token)
+}
+
+func oidcCallbackPath(provider string) string {
+	return "/auth/oidc/" + provider + "/callback"
+}
+
+func (c *OIDCController) SetupRoutes() {
+	group := r.Router.Group("/auth/oidc")
+
+	group.GET("/:provider/login", c.Login)
+	group.GET("/:provider/callback", c.Callback)
+}
@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// sessionCookieMaxAge is in seconds.
+const sessionCookieMaxAge = 3600
+
+// sessionCookies writes cookies with the configured attributes.
+type sessionCookies struct {
+	config *config.CookieConfig
+}
+
+func (s sessionCookies) set(ctx *gin.Context, name, value string, maxAge int, path string, httpOnly bool) {
+	ctx.SetSameSite(s.config.SameSite)
+	ctx.SetCookie(name, value, maxAge, path, s.config.Domain, s.config.Secure, httpOnly)
+}
+
+// respondWithToken ends a successful login. Besides returning the token, it
+// starts a cookie session with a fresh CSRF token.
+func (s sessionCookies) respondWithToken(ctx *gin.Context, token string) {
+	csrfToken, err := utils.NewOpaqueToken()
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	s.set(ctx, middlewares.SessionCookie, token, sessionCookieMaxAge, "/", true)
+	s.set(ctx, middlewares.CSRFCookie, csrfToken, sessionCookieMaxAge, "/", false)
+	ctx.JSON(http.StatusOK, gin.H{
+		"token": token,
+	})
+}
+
+func (s sessionCookies) clear(ctx *gin.Context) {
+	s.set(ctx, middlewares.SessionCookie, "", -1, "/", true)
+	s.set(ctx, middlewares.CSRFCookie, "", -1, "/", false)
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// SessionsController lets the authenticated user see where they are logged
+// in and log those sessions out.
+type SessionsController struct {
+	listUseCase   usecase.UseCaseWithProps[usecases.ListSessionsProps, []dtos.SessionDto]
+	revokeUseCase usecase.UseCaseWithProps[usecases.RevokeSessionProps, struct{}]
+}
+
+func NewSessionsController(
+	listUC usecase.UseCaseWithProps[usecases.ListSessionsProps, []dtos.SessionDto],
+	revokeUC usecase.UseCaseWithProps[usecases.RevokeSessionProps, struct{}],
+) *SessionsController {
+	return &SessionsController{
+		listUseCase:   listUC,
+		revokeUseCase: revokeUC,
+	}
+}
+
+func (c *SessionsController) List(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	sessions, err := c.listUseCase.Execute(usecases.ListSessionsProps{
+		Ctx:              ctx.Request.Context(),
+		UserID:           userID.(string),
+		CurrentSessionID: ctx.GetString("sessionID"),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sessions)
+}
+
+func (c *SessionsController) Revoke(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	_, err := c.revokeUseCase.Execute(usecases.RevokeSessionProps{
+		Ctx:       ctx.Request.Context(),
+		UserID:    userID.(string),
+		SessionID: ctx.Param("sessionID"),
+		IP:        ctx.ClientIP(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Session revoked"})
+}
+
+func (c *SessionsController) SetupRoutes() {
+	group := r.Router.Group("/users/me/sessions")
+
+	group.GET("", c.List)
+	group.DELETE("/:sessionID", c.Revoke)
+}
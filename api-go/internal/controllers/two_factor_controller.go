@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorController manages the TOTP enrollment of the authenticated user.
+// Its use cases are not decorated because the decorator logs props and
+// results, which here carry secrets, passwords and recovery codes.
+type TwoFactorController struct {
+	enrollUseCase                  usecase.UseCaseWithProps[usecases.EnrollTwoFactorProps, *dtos.TwoFactorEnrollmentDto]
+	confirmUseCase                 usecase.UseCaseWithProps[dtos.ConfirmTwoFactorDto, *dtos.RecoveryCodesDto]
+	disableUseCase                 usecase.UseCaseWithProps[dtos.DisableTwoFactorDto, struct{}]
+	regenerateRecoveryCodesUseCase usecase.UseCaseWithProps[dtos.RegenerateRecoveryCodesDto, *dtos.RecoveryCodesDto]
+}
+
+func NewTwoFactorController(
+	enrollUC usecase.UseCaseWithProps[usecases.EnrollTwoFactorProps, *dtos.TwoFactorEnrollmentDto],
+	confirmUC usecase.UseCaseWithProps[dtos.ConfirmTwoFactorDto, *dtos.RecoveryCodesDto],
+	disableUC usecase.UseCaseWithProps[dtos.DisableTwoFactorDto, struct{}],
+	regenerateRecoveryCodesUC usecase.UseCaseWithProps[dtos.RegenerateRecoveryCodesDto, *dtos.RecoveryCodesDto],
+) *TwoFactorController {
+	return &TwoFactorController{
+		enrollUseCase:                  enrollUC,
+		confirmUseCase:                 confirmUC,
+		disableUseCase:                 disableUC,
+		regenerateRecoveryCodesUseCase: regenerateRecoveryCodesUC,
+	}
+}
+
+func (c *TwoFactorController) Enroll(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	enrollment, err := c.enrollUseCase.Execute(usecases.EnrollTwoFactorProps{
+		Ctx:    ctx.Request.Context(),
+		UserID: userID.(string),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, enrollment)
+}
+
+func (c *TwoFactorController) Confirm(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.ConfirmTwoFactorDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+
+	codes, err := c.confirmUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, codes)
+}
+
+func (c *TwoFactorController) Disable(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.DisableTwoFactorDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+
+	if _, err := c.disableUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+func (c *TwoFactorController) RegenerateRecoveryCodes(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.RegenerateRecoveryCodesDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+
+	codes, err := c.regenerateRecoveryCodesUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, codes)
+}
+
+func (c *TwoFactorController) SetupRoutes() {
+	group := r.Router.Group("/auth/2fa")
+
+	group.POST("/enroll", c.Enroll)
+	group.POST("/confirm", c.Confirm)
+	group.POST("/disable", c.Disable)
+	group.POST("/recovery-codes", c.RegenerateRecoveryCodes)
+}
@@ -1,25 +1,54 @@
 package controllers
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"net/http"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
 	r "github.com/Gabriel-Schiestl/api-go/internal/server"
 	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
 	"github.com/gin-gonic/gin"
 )
 
 type UsersController struct {
-	createUserUseCase usecase.UseCaseWithPropsDecorator[dtos.CreateUserDTO, *dtos.UserResponseDTO]
-	getUsersUseCase   usecase.UseCaseDecorator[[]dtos.UserResponseDTO]
-	getUserUseCase   usecase.UseCaseWithPropsDecorator[string, dtos.UserResponseDTO]
+	getUsersUseCase   usecase.UseCaseWithPropsDecorator[usecases.GetUsersProps, []dtos.UserResponseDTO]
+	getUserUseCase   usecase.UseCaseWithPropsDecorator[usecases.GetUserProps, dtos.UserResponseDTO]
+	getProfileUseCase usecase.UseCaseWithPropsDecorator[usecases.GetProfileProps, *dtos.ProfileDto]
+	// Not decorated: the props carry passwords and the result a token.
+	createUserUseCase     usecase.UseCaseWithProps[dtos.CreateUserDTO, *dtos.UserResponseDTO]
+	updateProfileUseCase  usecase.UseCaseWithProps[dtos.UpdateProfileDto, *dtos.ProfileDto]
+	changePasswordUseCase usecase.UseCaseWithProps[dtos.ChangePasswordDto, *dtos.LoginResultDto]
+	// Not decorated either: the export is the user's personal data.
+	exportDataUseCase     usecase.UseCaseWithProps[usecases.ExportUserDataProps, *dtos.UserDataExportDto]
+	deleteAccountUseCase  usecase.UseCaseWithProps[dtos.DeleteAccountDto, struct{}]
+	cookies               sessionCookies
 }
 
-func NewUsersController(createUC usecase.UseCaseWithPropsDecorator[dtos.CreateUserDTO, *dtos.UserResponseDTO], getUC usecase.UseCaseDecorator[[]dtos.UserResponseDTO], getUserUC usecase.UseCaseWithPropsDecorator[string, dtos.UserResponseDTO]) *UsersController {
+func NewUsersController(
+	createUC usecase.UseCaseWithProps[dtos.CreateUserDTO, *dtos.UserResponseDTO],
+	getUC usecase.UseCaseWithPropsDecorator[usecases.GetUsersProps, []dtos.UserResponseDTO],
+	getUserUC usecase.UseCaseWithPropsDecorator[usecases.GetUserProps, dtos.UserResponseDTO],
+	getProfileUC usecase.UseCaseWithPropsDecorator[usecases.GetProfileProps, *dtos.ProfileDto],
+	updateProfileUC usecase.UseCaseWithProps[dtos.UpdateProfileDto, *dtos.ProfileDto],
+	changePasswordUC usecase.UseCaseWithProps[dtos.ChangePasswordDto, *dtos.LoginResultDto],
+	exportDataUC usecase.UseCaseWithProps[usecases.ExportUserDataProps, *dtos.UserDataExportDto],
+	deleteAccountUC usecase.UseCaseWithProps[dtos.DeleteAccountDto, struct{}],
+	cookieConfig *config.CookieConfig,
+) *UsersController {
 	return &UsersController{
 		createUserUseCase: createUC,
 		getUsersUseCase:   getUC,
 		getUserUseCase:   getUserUC,
+		getProfileUseCase:     getProfileUC,
+		updateProfileUseCase:  updateProfileUC,
+		changePasswordUseCase: changePasswordUC,
+		exportDataUseCase:     exportDataUC,
+		deleteAccountUseCase:  deleteAccountUC,
+		cookies:               sessionCookies{config: cookieConfig},
 	}
 }
 
@@ -34,12 +63,14 @@ func (c *UsersController) RegisterRoutes(r *gin.Engine) {
 func (c *UsersController) CreateUser(ctx *gin.Context) {
 	var input dtos.CreateUserDTO
 	if err := ctx.ShouldBindJSON(&input); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
+	input.Ctx = ctx.Request.Context()
+	input.IP = ctx.ClientIP()
 	_, err := c.createUserUseCase.Execute(input)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.Status(http.StatusCreated)
@@ -52,18 +83,18 @@ func (c *UsersController) GetUser(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.getUserUseCase.Execute(id)
+	user, err := c.getUserUseCase.Execute(usecases.GetUserProps{Ctx: ctx.Request.Context(), ID: id})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, user)
 }
 
 func (c *UsersController) GetUsers(ctx *gin.Context) {
-	users, err := c.getUsersUseCase.Execute()
+	users, err := c.getUsersUseCase.Execute(usecases.GetUsersProps{Ctx: ctx.Request.Context()})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, users)
@@ -76,19 +107,172 @@ func (c *UsersController) GetCurrentUser(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.getUserUseCase.Execute(userID.(string))
+	user, err := c.getProfileUseCase.Execute(usecases.GetProfileProps{Ctx: ctx.Request.Context(), UserID: userID.(string)})
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, user)
 }
 
+func (c *UsersController) UpdateCurrentUser(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.UpdateProfileDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+	input.IP = ctx.ClientIP()
+
+	profile, err := c.updateProfileUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, profile)
+}
+
+// ChangePassword answers like AuthController.Login, as the change logs every
+// session out, the current one included.
+func (c *UsersController) ChangePassword(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.ChangePasswordDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+	input.IP = ctx.ClientIP()
+	input.UserAgent = ctx.Request.UserAgent()
+
+	result, err := c.changePasswordUseCase.Execute(input)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	c.cookies.respondWithToken(ctx, result.Token)
+}
+
+// ExportData downloads what the account stores about the user as a JSON
+// document, or as a ZIP of one JSON file per section with ?format=zip.
+func (c *UsersController) ExportData(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "zip" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or zip"})
+		return
+	}
+
+	export, err := c.exportDataUseCase.Execute(usecases.ExportUserDataProps{
+		Ctx:    ctx.Request.Context(),
+		UserID: userID.(string),
+		IP:     ctx.ClientIP(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	filename := "eventhub-export-" + export.ExportedAt.UTC().Format("20060102")
+	if format == "json" {
+		ctx.Header("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+		ctx.JSON(http.StatusOK, export)
+		return
+	}
+
+	archive, err := zipExport(export)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.Header("Content-Disposition", `attachment; filename="`+filename+`.zip"`)
+	ctx.Data(http.StatusOK, "application/zip", archive)
+}
+
+func zipExport(export *dtos.UserDataExportDto) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", export.Profile},
+		{"registrations.json", export.Registrations},
+		{"organized_events.json", export.OrganizedEvents},
+	}
+	for _, file := range files {
+		w, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: export.ExportedAt})
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DeleteCurrentUser anonymizes the account and logs the caller out.
+func (c *UsersController) DeleteCurrentUser(ctx *gin.Context) {
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var input dtos.DeleteAccountDto
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	input.Ctx = ctx.Request.Context()
+	input.UserID = userID.(string)
+	input.IP = ctx.ClientIP()
+	input.SessionID = ctx.GetString("sessionID")
+
+	if _, err := c.deleteAccountUseCase.Execute(input); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	c.cookies.clear(ctx)
+	ctx.Status(http.StatusNoContent)
+}
+
 func (c *UsersController) SetupRoutes() {
 	group := r.Router.Group("/users")
 
 	group.GET("/", c.GetUsers)
 	group.GET("/me", c.GetCurrentUser)
+	group.PATCH("/me", c.UpdateCurrentUser)
+	group.DELETE("/me", c.DeleteCurrentUser)
+	group.GET("/me/export", c.ExportData)
+	group.POST("/me/password", c.ChangePassword)
 	group.GET("/:ID", c.GetUser)
 	group.POST("/", c.CreateUser)
 }
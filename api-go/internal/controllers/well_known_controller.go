@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	r "github.com/Gabriel-Schiestl/api-go/internal/server"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// jwksMaxAge lets verifiers cache the key set for a while; new keys are
+// published before they start signing.
+const jwksMaxAge = "public, max-age=300"
+
+// WellKnownController serves the documents under /.well-known.
+type WellKnownController struct {
+	getJWKSUseCase usecase.UseCaseWithProps[usecases.GetJWKSProps, services.JSONWebKeySet]
+}
+
+func NewWellKnownController(getJWKSUC usecase.UseCaseWithProps[usecases.GetJWKSProps, services.JSONWebKeySet]) *WellKnownController {
+	return &WellKnownController{getJWKSUseCase: getJWKSUC}
+}
+
+func (c *WellKnownController) GetJWKS(ctx *gin.Context) {
+	keys, err := c.getJWKSUseCase.Execute(usecases.GetJWKSProps{Ctx: ctx.Request.Context()})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Cache-Control", jwksMaxAge)
+	ctx.JSON(http.StatusOK, keys)
+}
+
+func (c *WellKnownController) SetupRoutes() {
+	r.Router.GET("/.well-known/jwks.json", c.GetJWKS)
+}
@@ -0,0 +1,14 @@
+package exceptions
+
+// ConflictException signals that the operation conflicts with the current state of the resource.
+type ConflictException struct {
+	s string
+}
+
+func NewConflictException(s string) *ConflictException {
+	return &ConflictException{s: s}
+}
+
+func (e *ConflictException) Error() string {
+	return e.s
+}
@@ -0,0 +1,14 @@
+package exceptions
+
+// ForbiddenException signals that the caller is not allowed to perform the operation.
+type ForbiddenException struct {
+	s string
+}
+
+func NewForbiddenException(s string) *ForbiddenException {
+	return &ForbiddenException{s: s}
+}
+
+func (e *ForbiddenException) Error() string {
+	return e.s
+}
@@ -0,0 +1,23 @@
+package exceptions
+
+import "time"
+
+// LockedException signals that the resource is temporarily locked, e.g. an
+// account after too many failed logins.
+type LockedException struct {
+	s          string
+	retryAfter time.Duration
+}
+
+func NewLockedException(s string, retryAfter time.Duration) *LockedException {
+	return &LockedException{s: s, retryAfter: retryAfter}
+}
+
+func (e *LockedException) Error() string {
+	return e.s
+}
+
+// RetryAfter is how long until the lock expires on its own.
+func (e *LockedException) RetryAfter() time.Duration {
+	return e.retryAfter
+}
@@ -0,0 +1,14 @@
+package exceptions
+
+// NotFoundException signals that the requested resource does not exist.
+type NotFoundException struct {
+	s string
+}
+
+func NewNotFoundException(s string) *NotFoundException {
+	return &NotFoundException{s: s}
+}
+
+func (e *NotFoundException) Error() string {
+	return e.s
+}
@@ -0,0 +1,22 @@
+package exceptions
+
+import "time"
+
+// TooManyRequestsException signals that the caller must wait before retrying the operation.
+type TooManyRequestsException struct {
+	s          string
+	retryAfter time.Duration
+}
+
+func NewTooManyRequestsException(s string, retryAfter time.Duration) *TooManyRequestsException {
+	return &TooManyRequestsException{s: s, retryAfter: retryAfter}
+}
+
+func (e *TooManyRequestsException) Error() string {
+	return e.s
+}
+
+// RetryAfter is how long the caller should wait before trying again.
+func (e *TooManyRequestsException) RetryAfter() time.Duration {
+	return e.retryAfter
+}
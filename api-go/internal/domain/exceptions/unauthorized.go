@@ -0,0 +1,14 @@
+package exceptions
+
+// UnauthorizedException signals that the caller could not be authenticated.
+type UnauthorizedException struct {
+	s string
+}
+
+func NewUnauthorizedException(s string) *UnauthorizedException {
+	return &UnauthorizedException{s: s}
+}
+
+func (e *UnauthorizedException) Error() string {
+	return e.s
+}
@@ -0,0 +1,14 @@
+package exceptions
+
+// ValidationException signals that the input is invalid.
+type ValidationException struct {
+	s string
+}
+
+func NewValidationException(s string) *ValidationException {
+	return &ValidationException{s: s}
+}
+
+func (e *ValidationException) Error() string {
+	return e.s
+}
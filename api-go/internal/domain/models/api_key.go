@@ -0,0 +1,138 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+// Scopes an APIKey can be granted. A key only reaches the routes of its
+// scopes, and never the account management ones.
+const (
+	APIKeyScopeEventsRead         = "events:read"
+	APIKeyScopeEventsWrite        = "events:write"
+	APIKeyScopeRegistrationsWrite = "registrations:write"
+)
+
+var apiKeyScopes = []string{APIKeyScopeEventsRead, APIKeyScopeEventsWrite, APIKeyScopeRegistrationsWrite}
+
+type APIKeyProps struct {
+	ID         *string
+	UserID     *string
+	Name       *string
+	Prefix     *string
+	KeyHash    *string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  *time.Time
+}
+
+type apiKey struct {
+	id         string
+	userID     string
+	name       string
+	prefix     string
+	keyHash    string
+	scopes     []string
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+	createdAt  time.Time
+}
+
+// APIKey lets scripts act as a user without their password. Only the hash
+// of the key is kept; Prefix, its first characters, tells keys apart.
+type APIKey interface {
+	GetID() string
+	GetUserID() string
+	GetName() string
+	GetPrefix() string
+	GetKeyHash() string
+	GetScopes() []string
+	// GetExpiresAt is nil for keys that never expire.
+	GetExpiresAt() *time.Time
+	GetLastUsedAt() *time.Time
+	GetRevokedAt() *time.Time
+	GetCreatedAt() time.Time
+	IsActive(now time.Time) bool
+	HasScope(scope string) bool
+	MarkUsed(now time.Time)
+	Revoke(now time.Time) error
+}
+
+func NewAPIKey(props APIKeyProps) (APIKey, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("API key user is required")
+	}
+	if props.Name == nil || *props.Name == "" {
+		return nil, exceptions.NewValidationException("API key name is required")
+	}
+	if props.KeyHash == nil || *props.KeyHash == "" {
+		return nil, exceptions.NewValidationException("API key hash is required")
+	}
+	if len(props.Scopes) == 0 {
+		return nil, exceptions.NewValidationException("API key needs at least one scope")
+	}
+	for _, scope := range props.Scopes {
+		if !slices.Contains(apiKeyScopes, scope) {
+			return nil, exceptions.NewValidationException("Unknown API key scope " + scope)
+		}
+	}
+
+	id := uuid.New().String()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &apiKey{
+		id:         id,
+		userID:     *props.UserID,
+		name:       *props.Name,
+		prefix:     derefString(props.Prefix),
+		keyHash:    *props.KeyHash,
+		scopes:     slices.Compact(slices.Sorted(slices.Values(props.Scopes))),
+		expiresAt:  props.ExpiresAt,
+		lastUsedAt: props.LastUsedAt,
+		revokedAt:  props.RevokedAt,
+		createdAt:  createdAt,
+	}, nil
+}
+
+func (k *apiKey) GetID() string             { return k.id }
+func (k *apiKey) GetUserID() string         { return k.userID }
+func (k *apiKey) GetName() string           { return k.name }
+func (k *apiKey) GetPrefix() string         { return k.prefix }
+func (k *apiKey) GetKeyHash() string        { return k.keyHash }
+func (k *apiKey) GetScopes() []string       { return slices.Clone(k.scopes) }
+func (k *apiKey) GetExpiresAt() *time.Time  { return k.expiresAt }
+func (k *apiKey) GetLastUsedAt() *time.Time { return k.lastUsedAt }
+func (k *apiKey) GetRevokedAt() *time.Time  { return k.revokedAt }
+func (k *apiKey) GetCreatedAt() time.Time   { return k.createdAt }
+
+func (k *apiKey) IsActive(now time.Time) bool {
+	return k.revokedAt == nil && (k.expiresAt == nil || now.Before(*k.expiresAt))
+}
+
+func (k *apiKey) HasScope(scope string) bool {
+	return slices.Contains(k.scopes, scope)
+}
+
+func (k *apiKey) MarkUsed(now time.Time) {
+	k.lastUsedAt = &now
+}
+
+func (k *apiKey) Revoke(now time.Time) error {
+	if k.revokedAt != nil {
+		return exceptions.NewConflictException("API key already revoked")
+	}
+	k.revokedAt = &now
+	return nil
+}
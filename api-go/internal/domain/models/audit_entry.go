@@ -0,0 +1,150 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditActionLoginSucceeded       = "auth.login_succeeded"
+	AuditActionLoginFailed          = "auth.login_failed"
+	AuditActionAccountLocked        = "auth.account_locked"
+	AuditActionAccountUnlocked      = "auth.account_unlocked"
+	AuditActionIdentityLinked       = "auth.identity_linked"
+	AuditActionUserProvisioned      = "auth.user_provisioned"
+	AuditActionAPIKeyCreated        = "auth.api_key_created"
+	AuditActionAPIKeyRevoked        = "auth.api_key_revoked"
+	AuditActionSessionRevoked       = "auth.session_revoked"
+	AuditActionPasswordChanged      = "auth.password_changed"
+	AuditActionEmailChangeRequested = "auth.email_change_requested"
+	AuditActionEmailChanged         = "auth.email_changed"
+	AuditActionDataExported         = "auth.data_exported"
+	AuditActionAccountDeleted       = "auth.account_deleted"
+	AuditActionUserCreated          = "user.created"
+	AuditActionEventCreated         = "event.created"
+	AuditActionEventUpdated         = "event.updated"
+	AuditActionEventDeleted         = "event.deleted"
+	AuditActionEventRegistered      = "event.registered"
+	AuditActionEventUnregistered    = "event.unregistered"
+	AuditActionAttendeesExported    = "event.attendees_exported"
+	AuditActionAttendeesAdded       = "event.attendees_added"
+	AuditActionAttendeesRemoved     = "event.attendees_removed"
+	AuditActionAttendeesMoved       = "event.attendees_moved"
+	AuditActionInviteCancelled      = "event.invite_cancelled"
+	AuditActionAttendeeCheckedIn    = "event.attendee_checked_in"
+	AuditActionUserSuspended        = "admin.user_suspended"
+	AuditActionUserReactivated      = "admin.user_reactivated"
+	AuditActionEventCancelled       = "admin.event_cancelled"
+	AuditActionEventUnpublished     = "admin.event_unpublished"
+	AuditActionEventRepublished     = "admin.event_republished"
+	AuditActionEventReassigned      = "admin.event_reassigned"
+	AuditActionAttendeeRemoved      = "admin.attendee_removed"
+)
+
+// Target types of the audit entries.
+const (
+	AuditTargetUser  = "user"
+	AuditTargetEvent = "event"
+)
+
+// AuditChange is the value of a field before and after an action. Before is
+// nil for created resources and After for deleted ones.
+type AuditChange struct {
+	Before *string
+	After  *string
+}
+
+type AuditEntryProps struct {
+	ID         *string
+	ActorID    *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	IP         *string
+	RequestID  *string
+	Details    map[string]string
+	Changes    map[string]AuditChange
+	CreatedAt  *time.Time
+}
+
+type auditEntry struct {
+	id         string
+	actorID    *string
+	action     string
+	targetType string
+	targetID   string
+	ip         string
+	requestID  string
+	details    map[string]string
+	changes    map[string]AuditChange
+	createdAt  time.Time
+}
+
+// AuditEntry records who did what to which resource. Entries are never
+// changed once appended.
+type AuditEntry interface {
+	GetID() string
+	// GetActorID is nil when the actor is anonymous, e.g. a failed login.
+	GetActorID() *string
+	GetAction() string
+	GetTargetType() string
+	GetTargetID() string
+	GetIP() string
+	// GetRequestID is empty for actions taken outside of an HTTP request.
+	GetRequestID() string
+	GetDetails() map[string]string
+	// GetChanges maps the fields an action changed to their values.
+	GetChanges() map[string]AuditChange
+	GetCreatedAt() time.Time
+}
+
+func NewAuditEntry(props AuditEntryProps) (AuditEntry, error) {
+	if props.Action == nil || *props.Action == "" {
+		return nil, exceptions.NewValidationException("Audit action is required")
+	}
+
+	id := uuid.NewString()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	entry := &auditEntry{
+		id:        id,
+		actorID:   props.ActorID,
+		action:    *props.Action,
+		details:   props.Details,
+		changes:   props.Changes,
+		createdAt: createdAt,
+	}
+	if props.TargetType != nil {
+		entry.targetType = *props.TargetType
+	}
+	if props.TargetID != nil {
+		entry.targetID = *props.TargetID
+	}
+	if props.IP != nil {
+		entry.ip = *props.IP
+	}
+	if props.RequestID != nil {
+		entry.requestID = *props.RequestID
+	}
+	return entry, nil
+}
+
+func (e *auditEntry) GetID() string                      { return e.id }
+func (e *auditEntry) GetActorID() *string                { return e.actorID }
+func (e *auditEntry) GetAction() string                  { return e.action }
+func (e *auditEntry) GetTargetType() string              { return e.targetType }
+func (e *auditEntry) GetTargetID() string                { return e.targetID }
+func (e *auditEntry) GetIP() string                      { return e.ip }
+func (e *auditEntry) GetRequestID() string               { return e.requestID }
+func (e *auditEntry) GetDetails() map[string]string      { return e.details }
+func (e *auditEntry) GetChanges() map[string]AuditChange { return e.changes }
+func (e *auditEntry) GetCreatedAt() time.Time            { return e.createdAt }
@@ -3,45 +3,64 @@ package models
 import (
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+// Algorithms a password hash can be computed with.
+const (
+	PasswordAlgorithmBcrypt   = "bcrypt"
+	PasswordAlgorithmArgon2id = "argon2id"
 )
 
 type AuthProps struct {
-	ID        *string
-	Email     *string
-	Password  *string
-	CreatedAt *time.Time
+	UserID            *string
+	PasswordHash      *string
+	Algorithm         *string
+	PasswordChangedAt *time.Time
+	CreatedAt         *time.Time
 }
 
 type auth struct {
-	id        string
-	email     string
-	password  string
-	createdAt time.Time
+	userID            string
+	passwordHash      string
+	algorithm         string
+	passwordChangedAt *time.Time
+	createdAt         time.Time
 }
 
+// Auth holds the password of a user. Two-factor settings and linked
+// identities live beside it, also keyed by user. Accounts provisioned
+// through single sign-on have one without a password until they set it.
 type Auth interface {
-	GetID() string
-	GetEmail() string
-	GetPassword() string
+	GetUserID() string
+	GetPasswordHash() string
+	// GetAlgorithm names how the hash was computed, empty without a
+	// password.
+	GetAlgorithm() string
+	HasPassword() bool
+	// GetPasswordChangedAt is nil until the password is first changed.
+	GetPasswordChangedAt() *time.Time
 	GetCreatedAt() time.Time
+	SetPassword(hash, algorithm string, at time.Time)
+	Rehash(hash, algorithm string)
+	RemovePassword(at time.Time)
 }
 
-func NewAuth(props AuthProps) Auth {
-	id := uuid.New().String()
-	if props.ID != nil {
-		id = *props.ID
+func NewAuth(props AuthProps) (Auth, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Credential user is required")
 	}
 	createdAt := time.Now()
 	if props.CreatedAt != nil {
 		createdAt = *props.CreatedAt
 	}
 	return &auth{
-		id:        id,
-		email:     derefString(props.Email),
-		password:  derefString(props.Password),
-		createdAt: createdAt,
-	}
+		userID:            *props.UserID,
+		passwordHash:      derefString(props.PasswordHash),
+		algorithm:         derefString(props.Algorithm),
+		passwordChangedAt: props.PasswordChangedAt,
+		createdAt:         createdAt,
+	}, nil
 }
 
 func derefString(s *string) string {
@@ -51,7 +70,30 @@ func derefString(s *string) string {
 	return *s
 }
 
-func (a *auth) GetID() string        { return a.id }
-func (a *auth) GetEmail() string     { return a.email }
-func (a *auth) GetPassword() string  { return a.password }
-func (a *auth) GetCreatedAt() time.Time { return a.createdAt }
+func (a *auth) GetUserID() string                { return a.userID }
+func (a *auth) GetPasswordHash() string          { return a.passwordHash }
+func (a *auth) GetAlgorithm() string             { return a.algorithm }
+func (a *auth) HasPassword() bool                { return a.passwordHash != "" }
+func (a *auth) GetPasswordChangedAt() *time.Time { return a.passwordChangedAt }
+func (a *auth) GetCreatedAt() time.Time          { return a.createdAt }
+
+// SetPassword replaces the password hash. Tokens issued before `at` are no
+// longer accepted.
+func (a *auth) SetPassword(hash, algorithm string, at time.Time) {
+	a.passwordHash = hash
+	a.algorithm = algorithm
+	a.passwordChangedAt = &at
+}
+
+// Rehash stores a new hash of the same password, computed with stronger
+// settings. Unlike SetPassword it keeps the issued tokens valid.
+func (a *auth) Rehash(hash, algorithm string) {
+	a.passwordHash = hash
+	a.algorithm = algorithm
+}
+
+// RemovePassword stops password logins until a new one is set through the
+// reset flow, and rejects the tokens issued before `at`.
+func (a *auth) RemovePassword(at time.Time) {
+	a.SetPassword("", "", at)
+}
@@ -1,12 +1,26 @@
 package models
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
-	"github.com/Gabriel-Schiestl/go-clarch/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/google/uuid"
 )
 
+// AttendeeRegistration is what an event keeps about one of its attendees.
+type AttendeeRegistration struct {
+    // RegisteredAt is zero for registrations made before it was recorded.
+    RegisteredAt time.Time
+    // TicketType is empty for attendees added by the organizer and for
+    // events without ticket types.
+    TicketType string
+    // Answers maps the questions of the event to the attendee's answers.
+    Answers     map[string]string
+    CheckedInAt *time.Time
+}
+
 type EventProps struct {
     ID          *string
     Name        *string
@@ -15,9 +29,17 @@ type EventProps struct {
     Description *string
     OrganizerID *string
     Attendees   []string
+    // Registrations are keyed by attendee. Attendees from before they were
+    // recorded have no entry.
+    Registrations map[string]AttendeeRegistration
     CreatedAt   *time.Time
     Category    *string
     Limit       *int
+    UnpublishedAt *time.Time
+    // TicketTypes, when set, are the ones attendees choose from.
+    TicketTypes []string
+    // Questions are asked to attendees when they register.
+    Questions []string
 }
 
 type event struct {
@@ -28,9 +50,13 @@ type event struct {
     description string
     organizerID string
     attendees   []string
+    registrations map[string]AttendeeRegistration
     createdAt   time.Time
     category    string
     limit       int
+    unpublishedAt *time.Time
+    ticketTypes []string
+    questions   []string
 }
 
 type Event interface {
@@ -41,45 +67,74 @@ type Event interface {
     Description() string
     OrganizerID() string
     Attendees() []string
+    // Registrations maps the attendees to their registrations, leaving out
+    // those registered before they were recorded.
+    Registrations() map[string]AttendeeRegistration
     CreatedAt() time.Time
     Category() string
     Limit() int
     AddAttendee(attendee string) error
+    // AddAttendeeOverLimit applies the rules of AddAttendee except the
+    // attendee limit, for organizers adding attendees themselves.
+    AddAttendeeOverLimit(attendee string) error
+    // Register applies the rules of AddAttendee for attendees registering
+    // themselves, who pick a ticket type and answer every question.
+    Register(attendee, ticketType string, answers map[string]string) error
+    CheckIn(attendee string, at time.Time) error
     CancelSubscription(attendee string) error
+    TransferTo(organizerID string) error
+    // UnpublishedAt is nil while the event is listed publicly.
+    UnpublishedAt() *time.Time
+    IsPublished() bool
+    TicketTypes() []string
+    Questions() []string
+    Unpublish(at time.Time) error
+    Republish() error
 }
 
 func NewEvent(props EventProps) (Event, error) {
 	if props.Name == nil || *props.Name == "" {
-		return nil, exceptions.NewBusinessException("Event name is required")
+		return nil, exceptions.NewValidationException("Event name is required")
 	}
 	if props.Location == nil || *props.Location == "" {
-		return nil, exceptions.NewBusinessException("Event location is required")
+		return nil, exceptions.NewValidationException("Event location is required")
 	}
 	if props.Date == nil {
-		return nil, exceptions.NewBusinessException("Event date is required")
+		return nil, exceptions.NewValidationException("Event date is required")
 	}
 	if props.OrganizerID == nil || *props.OrganizerID == "" {
-		return nil, exceptions.NewBusinessException("Organizer ID is required")
+		return nil, exceptions.NewValidationException("Organizer ID is required")
 	}
 
     if props.Category == nil || *props.Category == "" {
-        return nil, exceptions.NewBusinessException("Event category is required")
+        return nil, exceptions.NewValidationException("Event category is required")
     }
 
     if props.Limit == nil || *props.Limit < 0 {
-        return nil, exceptions.NewBusinessException("Event limit cannot be negative")
+        return nil, exceptions.NewValidationException("Event limit cannot be negative")
+    }
+
+    if err := checkEventOptions("ticket type", props.TicketTypes); err != nil {
+        return nil, err
+    }
+    if err := checkEventOptions("question", props.Questions); err != nil {
+        return nil, err
     }
 
 	event := &event{
 		name:        *props.Name,
 		location:    *props.Location,
 		date:        *props.Date,
-		description: *props.Description,
+		description: derefString(props.Description),
 		organizerID: *props.OrganizerID,
 		attendees:   props.Attendees,
+		registrations: props.Registrations,
 		createdAt:   time.Now(),
         category:    *props.Category,
         limit:       *props.Limit,
+        unpublishedAt: props.UnpublishedAt,
+        ticketTypes: props.TicketTypes,
+        questions:   props.Questions,
     }
 
     if props.ID == nil || *props.ID == "" {
@@ -99,43 +154,154 @@ func LoadEvent(props EventProps) (Event, error) {
 	return NewEvent(props)
 }
 
+// checkEventOptions refuses blank and repeated ticket types or questions.
+func checkEventOptions(kind string, options []string) error {
+    for i, option := range options {
+        if option == "" {
+            return exceptions.NewValidationException(fmt.Sprintf("Event %s cannot be empty", kind))
+        }
+        if slices.Contains(options[:i], option) {
+            return exceptions.NewValidationException(fmt.Sprintf("Event %s %q is repeated", kind, option))
+        }
+    }
+    return nil
+}
+
 func (e *event) AddAttendee(attendee string) error {
+    return e.addAttendee(attendee, AttendeeRegistration{}, true)
+}
+
+func (e *event) AddAttendeeOverLimit(attendee string) error {
+    return e.addAttendee(attendee, AttendeeRegistration{}, false)
+}
+
+func (e *event) Register(attendee, ticketType string, answers map[string]string) error {
+    switch {
+    case len(e.ticketTypes) == 0 && ticketType != "":
+        return exceptions.NewValidationException("Event has no ticket types")
+    case len(e.ticketTypes) > 0 && !slices.Contains(e.ticketTypes, ticketType):
+        return exceptions.NewValidationException("Invalid ticket type")
+    }
+
+    for question := range answers {
+        if !slices.Contains(e.questions, question) {
+            return exceptions.NewValidationException(fmt.Sprintf("Unknown question %q", question))
+        }
+    }
+    for _, question := range e.questions {
+        if answers[question] == "" {
+            return exceptions.NewValidationException(fmt.Sprintf("Question %q must be answered", question))
+        }
+    }
+
+    return e.addAttendee(attendee, AttendeeRegistration{TicketType: ticketType, Answers: answers}, true)
+}
+
+func (e *event) addAttendee(attendee string, registration AttendeeRegistration, checkLimit bool) error {
     if attendee == "" {
-        return exceptions.NewBusinessException("Attendee cannot be empty")
+        return exceptions.NewValidationException("Attendee cannot be empty")
     }
 
-    if len(e.attendees) >= e.limit && e.limit > 0 {
-        return exceptions.NewBusinessException("Event attendee limit reached")
+    if e.unpublishedAt != nil {
+        return exceptions.NewConflictException("Event is not published")
+    }
+
+    if checkLimit && len(e.attendees) >= e.limit && e.limit > 0 {
+        return exceptions.NewConflictException("Event attendee limit reached")
     }
 
     if attendee == e.organizerID {
-        return exceptions.NewBusinessException("Organizer cannot be an attendee")
+        return exceptions.NewValidationException("Organizer cannot be an attendee")
     }
 
     for _, a := range e.attendees {
         if a == attendee {
-            return exceptions.NewBusinessException("Attendee already exists")
+            return exceptions.NewConflictException("Attendee already exists")
         }
     }
 
     e.attendees = append(e.attendees, attendee)
-    
+    if e.registrations == nil {
+        e.registrations = map[string]AttendeeRegistration{}
+    }
+    registration.RegisteredAt = time.Now()
+    e.registrations[attendee] = registration
+
     return nil
 }
 
 func (e *event) CancelSubscription(attendee string) error {
     if attendee == "" {
-        return exceptions.NewBusinessException("Attendee cannot be empty")
+        return exceptions.NewValidationException("Attendee cannot be empty")
     }
 
     for i, a := range e.attendees {
         if a == attendee {
             e.attendees = append(e.attendees[:i], e.attendees[i+1:]...)
+            delete(e.registrations, attendee)
             return nil
         }
     }
 
-    return exceptions.NewBusinessException("Attendee not subscribed to the event")
+    return exceptions.NewConflictException("Attendee not subscribed to the event")
+}
+
+// TransferTo hands the event over to another organizer, who stops being an
+// attendee if they were one.
+func (e *event) TransferTo(organizerID string) error {
+    if organizerID == "" {
+        return exceptions.NewValidationException("Organizer ID is required")
+    }
+
+    for i, a := range e.attendees {
+        if a == organizerID {
+            e.attendees = append(e.attendees[:i], e.attendees[i+1:]...)
+            delete(e.registrations, organizerID)
+            break
+        }
+    }
+    e.organizerID = organizerID
+
+    return nil
+}
+
+// CheckIn records that the attendee arrived at the event.
+func (e *event) CheckIn(attendee string, at time.Time) error {
+    if !slices.Contains(e.attendees, attendee) {
+        return exceptions.NewConflictException("Attendee not subscribed to the event")
+    }
+
+    registration := e.registrations[attendee]
+    if registration.CheckedInAt != nil {
+        return exceptions.NewConflictException("Attendee already checked in")
+    }
+    registration.CheckedInAt = &at
+    if e.registrations == nil {
+        e.registrations = map[string]AttendeeRegistration{}
+    }
+    e.registrations[attendee] = registration
+
+    return nil
+}
+
+// Unpublish hides the event from public listings and closes registration;
+// its organizer and attendees still see it.
+func (e *event) Unpublish(at time.Time) error {
+    if e.unpublishedAt != nil {
+        return exceptions.NewConflictException("Event already unpublished")
+    }
+    e.unpublishedAt = &at
+
+    return nil
+}
+
+func (e *event) Republish() error {
+    if e.unpublishedAt == nil {
+        return exceptions.NewConflictException("Event already published")
+    }
+    e.unpublishedAt = nil
+
+    return nil
 }
 
 func (e *event) ID() string { return e.id }
@@ -145,6 +311,11 @@ func (e *event) Date() time.Time { return e.date }
 func (e *event) Description() string { return e.description }
 func (e *event) OrganizerID() string { return e.organizerID }
 func (e *event) Attendees() []string { return e.attendees }
+func (e *event) Registrations() map[string]AttendeeRegistration { return e.registrations }
 func (e *event) CreatedAt() time.Time { return e.createdAt }
 func (e *event) Category() string {return e.category}
-func (e *event) Limit() int { return e.limit }
\ No newline at end of file
+func (e *event) Limit() int { return e.limit }
+func (e *event) UnpublishedAt() *time.Time { return e.unpublishedAt }
+func (e *event) IsPublished() bool { return e.unpublishedAt == nil }
+func (e *event) TicketTypes() []string { return e.ticketTypes }
+func (e *event) Questions() []string { return e.questions }
\ No newline at end of file
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+// Modes of an event import: all-or-nothing imports nothing when any row is
+// invalid, best-effort imports the valid rows and reports the others.
+const (
+	EventImportAllOrNothing = "all_or_nothing"
+	EventImportBestEffort   = "best_effort"
+)
+
+// Statuses of an event import. An import is failed when it stopped on an
+// error other than invalid rows, or when an all-or-nothing import had any.
+const (
+	EventImportPending   = "pending"
+	EventImportRunning   = "running"
+	EventImportCompleted = "completed"
+	EventImportFailed    = "failed"
+)
+
+// EventImportRowError is why a row of an import was not imported. Row
+// counts the events of the file from 1.
+type EventImportRowError struct {
+	Row     int
+	Message string
+}
+
+type EventImportProps struct {
+	ID          *string
+	OrganizerID *string
+	Format      *string
+	Mode        *string
+	DryRun      bool
+	Status      *string
+	Total       int
+	Processed   int
+	Imported    int
+	Errors      []EventImportRowError
+	Failure     *string
+	CreatedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+type eventImport struct {
+	id          string
+	organizerID string
+	format      string
+	mode        string
+	dryRun      bool
+	status      string
+	total       int
+	processed   int
+	imported    int
+	errors      []EventImportRowError
+	failure     string
+	createdAt   time.Time
+	finishedAt  *time.Time
+}
+
+// EventImport tracks the progress of an organizer importing events from a
+// file. Dry runs validate every row without importing any.
+type EventImport interface {
+	GetID() string
+	GetOrganizerID() string
+	GetFormat() string
+	GetMode() string
+	IsDryRun() bool
+	GetStatus() string
+	// GetTotal is the number of rows in the file, 0 until it is parsed.
+	GetTotal() int
+	GetProcessed() int
+	GetImported() int
+	GetErrors() []EventImportRowError
+	// GetFailure explains a failed import that has no row errors.
+	GetFailure() string
+	GetCreatedAt() time.Time
+	GetFinishedAt() *time.Time
+	IsFinished() bool
+	Start(total int) error
+	// Advance records that processed rows were checked, imported of them
+	// saved and the others rejected for errs.
+	Advance(processed, imported int, errs []EventImportRowError)
+	Complete(now time.Time)
+	Fail(now time.Time, failure string)
+}
+
+// NewEventImport defaults Status to pending and CreatedAt to now.
+func NewEventImport(props EventImportProps) (EventImport, error) {
+	if props.OrganizerID == nil || *props.OrganizerID == "" {
+		return nil, exceptions.NewValidationException("Import organizer is required")
+	}
+	mode := EventImportAllOrNothing
+	if props.Mode != nil && *props.Mode != "" {
+		mode = *props.Mode
+	}
+	if mode != EventImportAllOrNothing && mode != EventImportBestEffort {
+		return nil, exceptions.NewValidationException("Import mode must be all_or_nothing or best_effort")
+	}
+
+	id := uuid.NewString()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	status := EventImportPending
+	if props.Status != nil {
+		status = *props.Status
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &eventImport{
+		id:          id,
+		organizerID: *props.OrganizerID,
+		format:      derefString(props.Format),
+		mode:        mode,
+		dryRun:      props.DryRun,
+		status:      status,
+		total:       props.Total,
+		processed:   props.Processed,
+		imported:    props.Imported,
+		errors:      props.Errors,
+		failure:     derefString(props.Failure),
+		createdAt:   createdAt,
+		finishedAt:  props.FinishedAt,
+	}, nil
+}
+
+func (i *eventImport) GetID() string                    { return i.id }
+func (i *eventImport) GetOrganizerID() string           { return i.organizerID }
+func (i *eventImport) GetFormat() string                { return i.format }
+func (i *eventImport) GetMode() string                  { return i.mode }
+func (i *eventImport) IsDryRun() bool                   { return i.dryRun }
+func (i *eventImport) GetStatus() string                { return i.status }
+func (i *eventImport) GetTotal() int                    { return i.total }
+func (i *eventImport) GetProcessed() int                { return i.processed }
+func (i *eventImport) GetImported() int                 { return i.imported }
+func (i *eventImport) GetErrors() []EventImportRowError { return i.errors }
+func (i *eventImport) GetFailure() string               { return i.failure }
+func (i *eventImport) GetCreatedAt() time.Time          { return i.createdAt }
+func (i *eventImport) GetFinishedAt() *time.Time        { return i.finishedAt }
+
+func (i *eventImport) IsFinished() bool {
+	return i.status == EventImportCompleted || i.status == EventImportFailed
+}
+
+func (i *eventImport) Start(total int) error {
+	if i.status != EventImportPending {
+		return exceptions.NewConflictException("Import already started")
+	}
+	i.status = EventImportRunning
+	i.total = total
+	return nil
+}
+
+func (i *eventImport) Advance(processed, imported int, errs []EventImportRowError) {
+	i.processed += processed
+	i.imported += imported
+	i.errors = append(i.errors, errs...)
+}
+
+// Complete fails all-or-nothing imports that rejected any row.
+func (i *eventImport) Complete(now time.Time) {
+	i.status = EventImportCompleted
+	if i.mode == EventImportAllOrNothing && len(i.errors) > 0 {
+		i.status = EventImportFailed
+	}
+	i.finishedAt = &now
+}
+
+func (i *eventImport) Fail(now time.Time, failure string) {
+	i.status = EventImportFailed
+	i.failure = failure
+	i.finishedAt = &now
+}
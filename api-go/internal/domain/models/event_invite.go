@@ -0,0 +1,100 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+type EventInviteProps struct {
+	ID        *string
+	EventID   *string
+	Email     *string
+	InvitedBy *string
+	// OverLimit lets the invitee in even when the event is full.
+	OverLimit  bool
+	CreatedAt  *time.Time
+	AcceptedAt *time.Time
+}
+
+type eventInvite struct {
+	id         string
+	eventID    string
+	email      string
+	invitedBy  string
+	overLimit  bool
+	createdAt  time.Time
+	acceptedAt *time.Time
+}
+
+// EventInvite is a place an organizer set aside for someone without an
+// account. It is accepted, and the invitee registered, once an account
+// proves owning the address.
+type EventInvite interface {
+	GetID() string
+	GetEventID() string
+	// GetEmail is lower-cased, see NormalizeInviteEmail.
+	GetEmail() string
+	GetInvitedBy() string
+	IsOverLimit() bool
+	GetCreatedAt() time.Time
+	GetAcceptedAt() *time.Time
+	IsPending() bool
+	Accept(now time.Time) error
+}
+
+// NormalizeInviteEmail is the form invite emails are stored and looked up
+// in, so that an address matches whatever case the account uses.
+func NormalizeInviteEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func NewEventInvite(props EventInviteProps) (EventInvite, error) {
+	if props.EventID == nil || *props.EventID == "" {
+		return nil, exceptions.NewValidationException("Invite event is required")
+	}
+	if props.Email == nil || NormalizeInviteEmail(*props.Email) == "" {
+		return nil, exceptions.NewValidationException("Invite email is required")
+	}
+	if props.InvitedBy == nil || *props.InvitedBy == "" {
+		return nil, exceptions.NewValidationException("Invite organizer is required")
+	}
+
+	id := uuid.NewString()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &eventInvite{
+		id:         id,
+		eventID:    *props.EventID,
+		email:      NormalizeInviteEmail(*props.Email),
+		invitedBy:  *props.InvitedBy,
+		overLimit:  props.OverLimit,
+		createdAt:  createdAt,
+		acceptedAt: props.AcceptedAt,
+	}, nil
+}
+
+func (i *eventInvite) GetID() string             { return i.id }
+func (i *eventInvite) GetEventID() string        { return i.eventID }
+func (i *eventInvite) GetEmail() string          { return i.email }
+func (i *eventInvite) GetInvitedBy() string      { return i.invitedBy }
+func (i *eventInvite) IsOverLimit() bool         { return i.overLimit }
+func (i *eventInvite) GetCreatedAt() time.Time   { return i.createdAt }
+func (i *eventInvite) GetAcceptedAt() *time.Time { return i.acceptedAt }
+func (i *eventInvite) IsPending() bool           { return i.acceptedAt == nil }
+
+func (i *eventInvite) Accept(now time.Time) error {
+	if i.acceptedAt != nil {
+		return exceptions.NewConflictException("Invite already accepted")
+	}
+	i.acceptedAt = &now
+	return nil
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+type ExternalIdentityProps struct {
+	Provider  *string
+	Subject   *string
+	UserID    *string
+	Email     *string
+	CreatedAt *time.Time
+}
+
+type externalIdentity struct {
+	provider  string
+	subject   string
+	userID    string
+	email     string
+	createdAt time.Time
+}
+
+// ExternalIdentity links an account at an OpenID Connect provider, identified
+// by the provider's subject, to a local user.
+type ExternalIdentity interface {
+	GetProvider() string
+	GetSubject() string
+	GetUserID() string
+	// GetEmail is the address the provider reported when the link was made.
+	GetEmail() string
+	GetCreatedAt() time.Time
+}
+
+func NewExternalIdentity(props ExternalIdentityProps) (ExternalIdentity, error) {
+	if props.Provider == nil || *props.Provider == "" {
+		return nil, exceptions.NewValidationException("Identity provider is required")
+	}
+	if props.Subject == nil || *props.Subject == "" {
+		return nil, exceptions.NewValidationException("Identity subject is required")
+	}
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Identity user is required")
+	}
+
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &externalIdentity{
+		provider:  *props.Provider,
+		subject:   *props.Subject,
+		userID:    *props.UserID,
+		email:     derefString(props.Email),
+		createdAt: createdAt,
+	}, nil
+}
+
+func (i *externalIdentity) GetProvider() string     { return i.provider }
+func (i *externalIdentity) GetSubject() string      { return i.subject }
+func (i *externalIdentity) GetUserID() string       { return i.userID }
+func (i *externalIdentity) GetEmail() string        { return i.email }
+func (i *externalIdentity) GetCreatedAt() time.Time { return i.createdAt }
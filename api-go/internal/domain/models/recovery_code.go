@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeProps struct {
+	ID        *string
+	UserID    *string
+	CodeHash  *string
+	UsedAt    *time.Time
+	CreatedAt *time.Time
+}
+
+type recoveryCode struct {
+	id        string
+	userID    string
+	codeHash  string
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+// RecoveryCode is a single-use code that replaces a TOTP code when the user
+// loses their device. Only its hash is kept.
+type RecoveryCode interface {
+	GetID() string
+	GetUserID() string
+	GetCodeHash() string
+	GetUsedAt() *time.Time
+	GetCreatedAt() time.Time
+	Use(now time.Time) error
+}
+
+func NewRecoveryCode(props RecoveryCodeProps) (RecoveryCode, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Recovery code user is required")
+	}
+	if props.CodeHash == nil || *props.CodeHash == "" {
+		return nil, exceptions.NewValidationException("Recovery code hash is required")
+	}
+
+	id := uuid.New().String()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &recoveryCode{
+		id:        id,
+		userID:    *props.UserID,
+		codeHash:  *props.CodeHash,
+		usedAt:    props.UsedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (c *recoveryCode) GetID() string           { return c.id }
+func (c *recoveryCode) GetUserID() string       { return c.userID }
+func (c *recoveryCode) GetCodeHash() string     { return c.codeHash }
+func (c *recoveryCode) GetUsedAt() *time.Time   { return c.usedAt }
+func (c *recoveryCode) GetCreatedAt() time.Time { return c.createdAt }
+
+func (c *recoveryCode) Use(now time.Time) error {
+	if c.usedAt != nil {
+		return exceptions.NewUnauthorizedException("Invalid recovery code")
+	}
+	c.usedAt = &now
+	return nil
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+// SessionTTL is how long a login lasts, and so the lifetime of the access
+// token it issues.
+const SessionTTL = 24 * time.Hour
+
+// Limits of the columns user agent data is stored in.
+const (
+	sessionUserAgentMaxLength = 512
+	sessionDeviceMaxLength    = 100
+)
+
+type SessionProps struct {
+	ID         *string
+	UserID     *string
+	IP         *string
+	UserAgent  *string
+	Device     *string
+	CreatedAt  *time.Time
+	LastSeenAt *time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+}
+
+type session struct {
+	id         string
+	userID     string
+	ip         string
+	userAgent  string
+	device     string
+	createdAt  time.Time
+	lastSeenAt time.Time
+	expiresAt  time.Time
+	revokedAt  *time.Time
+}
+
+// Session is a login of a user, identified by the sid claim of the access
+// token it issued. Revoking it invalidates that token.
+type Session interface {
+	GetID() string
+	GetUserID() string
+	// GetIP is the address the session was last seen from.
+	GetIP() string
+	GetUserAgent() string
+	// GetDevice is a readable summary of the user agent, such as
+	// "Firefox on Linux".
+	GetDevice() string
+	GetCreatedAt() time.Time
+	GetLastSeenAt() time.Time
+	GetExpiresAt() time.Time
+	GetRevokedAt() *time.Time
+	IsActive(now time.Time) bool
+	MarkSeen(now time.Time, ip string)
+	Revoke(now time.Time) error
+}
+
+// NewSession defaults CreatedAt to now, LastSeenAt to CreatedAt and
+// ExpiresAt to SessionTTL after CreatedAt.
+func NewSession(props SessionProps) (Session, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Session user is required")
+	}
+
+	id := uuid.New().String()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+	lastSeenAt := createdAt
+	if props.LastSeenAt != nil {
+		lastSeenAt = *props.LastSeenAt
+	}
+	expiresAt := createdAt.Add(SessionTTL)
+	if props.ExpiresAt != nil {
+		expiresAt = *props.ExpiresAt
+	}
+
+	return &session{
+		id:         id,
+		userID:     *props.UserID,
+		ip:         derefString(props.IP),
+		userAgent:  truncate(derefString(props.UserAgent), sessionUserAgentMaxLength),
+		device:     truncate(derefString(props.Device), sessionDeviceMaxLength),
+		createdAt:  createdAt,
+		lastSeenAt: lastSeenAt,
+		expiresAt:  expiresAt,
+		revokedAt:  props.RevokedAt,
+	}, nil
+}
+
+func (s *session) GetID() string            { return s.id }
+func (s *session) GetUserID() string        { return s.userID }
+func (s *session) GetIP() string            { return s.ip }
+func (s *session) GetUserAgent() string     { return s.userAgent }
+func (s *session) GetDevice() string        { return s.device }
+func (s *session) GetCreatedAt() time.Time  { return s.createdAt }
+func (s *session) GetLastSeenAt() time.Time { return s.lastSeenAt }
+func (s *session) GetExpiresAt() time.Time  { return s.expiresAt }
+func (s *session) GetRevokedAt() *time.Time { return s.revokedAt }
+
+func (s *session) IsActive(now time.Time) bool {
+	return s.revokedAt == nil && now.Before(s.expiresAt)
+}
+
+func (s *session) MarkSeen(now time.Time, ip string) {
+	s.lastSeenAt = now
+	if ip != "" {
+		s.ip = ip
+	}
+}
+
+func (s *session) Revoke(now time.Time) error {
+	if s.revokedAt != nil {
+		return exceptions.NewConflictException("Session already revoked")
+	}
+	s.revokedAt = &now
+	return nil
+}
+
+// truncate cuts s to at most max bytes without splitting a UTF-8 sequence.
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
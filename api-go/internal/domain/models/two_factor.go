@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+)
+
+type TwoFactorProps struct {
+	UserID       *string
+	Secret       *string
+	ConfirmedAt  *time.Time
+	LastUsedStep *int64
+	CreatedAt    *time.Time
+}
+
+type twoFactor struct {
+	userID       string
+	secret       string
+	confirmedAt  *time.Time
+	lastUsedStep int64
+	createdAt    time.Time
+}
+
+// TwoFactor is a user's TOTP enrollment. It stays pending until the user
+// proves they can generate codes, and only then protects the login.
+type TwoFactor interface {
+	GetUserID() string
+	GetSecret() string
+	GetConfirmedAt() *time.Time
+	GetLastUsedStep() int64
+	GetCreatedAt() time.Time
+	IsEnabled() bool
+	Confirm(at time.Time)
+	UseStep(step int64) error
+}
+
+func NewTwoFactor(props TwoFactorProps) (TwoFactor, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Two-factor user is required")
+	}
+	if props.Secret == nil || *props.Secret == "" {
+		return nil, exceptions.NewValidationException("Two-factor secret is required")
+	}
+
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+	var lastUsedStep int64
+	if props.LastUsedStep != nil {
+		lastUsedStep = *props.LastUsedStep
+	}
+
+	return &twoFactor{
+		userID:       *props.UserID,
+		secret:       *props.Secret,
+		confirmedAt:  props.ConfirmedAt,
+		lastUsedStep: lastUsedStep,
+		createdAt:    createdAt,
+	}, nil
+}
+
+func (t *twoFactor) GetUserID() string          { return t.userID }
+func (t *twoFactor) GetSecret() string          { return t.secret }
+func (t *twoFactor) GetConfirmedAt() *time.Time { return t.confirmedAt }
+func (t *twoFactor) GetLastUsedStep() int64     { return t.lastUsedStep }
+func (t *twoFactor) GetCreatedAt() time.Time    { return t.createdAt }
+func (t *twoFactor) IsEnabled() bool            { return t.confirmedAt != nil }
+
+func (t *twoFactor) Confirm(at time.Time) {
+	if t.confirmedAt == nil {
+		t.confirmedAt = &at
+	}
+}
+
+// UseStep records the time step of an accepted code so the same code cannot
+// be replayed within its validity window.
+func (t *twoFactor) UseStep(step int64) error {
+	if step <= t.lastUsedStep {
+		return exceptions.NewUnauthorizedException("Invalid two-factor code")
+	}
+	t.lastUsedStep = step
+	return nil
+}
@@ -3,34 +3,81 @@ package models
 import (
 	"time"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/google/uuid"
 )
 
+// UserTypeAdmin grants access to the administration API. Users cannot sign
+// up as admins.
+const UserTypeAdmin = "admin"
+
 type UserProps struct {
-	ID        *string
-	Name      *string
-	Email     *string
-	Password  *string
-	UserType  *string
-	CreatedAt *time.Time
+	ID              *string
+	Name            *string
+	Email           *string
+	UserType        *string
+	CreatedAt       *time.Time
+	EmailVerifiedAt *time.Time
+	PendingEmail    *string
+	Profile         *UserProfile
+	DeletedAt       *time.Time
+	Suspension      *UserSuspension
+}
+
+// UserSuspension records why and since when an admin suspended an account.
+type UserSuspension struct {
+	At     time.Time
+	Reason string
+}
+
+// UserProfile holds the optional details users show about themselves.
+type UserProfile struct {
+	AvatarURL string
+	Bio       string
+	Phone     string
+	Company   string
 }
 
 type user struct {
-	id        string
-	name      string
-	email     string
-	password  string
-	userType  string
-	createdAt time.Time
+	id              string
+	name            string
+	email           string
+	userType        string
+	createdAt       time.Time
+	emailVerifiedAt *time.Time
+	pendingEmail    string
+	profile         UserProfile
+	deletedAt       *time.Time
+	suspension      *UserSuspension
 }
 
 type User interface {
 	GetID() string
 	GetName() string
 	GetEmail() string
-	GetPassword() string
 	GetUserType() string
+	IsAdmin() bool
 	GetCreatedAt() time.Time
+	// GetEmailVerifiedAt is nil while the email address is unverified.
+	GetEmailVerifiedAt() *time.Time
+	IsEmailVerified() bool
+	VerifyEmail(at time.Time)
+	// GetPendingEmail is the address the user asked to switch to, empty
+	// when no change awaits confirmation.
+	GetPendingEmail() string
+	RequestEmailChange(email string)
+	ConfirmEmailChange(at time.Time) error
+	GetProfile() UserProfile
+	Rename(name string)
+	UpdateProfile(profile UserProfile)
+	// GetDeletedAt is nil unless the user deleted their account.
+	GetDeletedAt() *time.Time
+	Anonymize(at time.Time) error
+	// GetSuspension is nil unless an admin suspended the account.
+	GetSuspension() *UserSuspension
+	IsSuspended() bool
+	Suspend(at time.Time, reason string) error
+	Reactivate() error
 }
 
 func NewUser(props UserProps) User {
@@ -42,19 +89,105 @@ func NewUser(props UserProps) User {
 	if props.CreatedAt != nil {
 		createdAt = *props.CreatedAt
 	}
-	return user{
-		id:        id,
-		name:      derefString(props.Name),
-		email:     derefString(props.Email),
-		password:  derefString(props.Password),
-		userType:  derefString(props.UserType),
-		createdAt: createdAt,
+	var profile UserProfile
+	if props.Profile != nil {
+		profile = *props.Profile
+	}
+	return &user{
+		id:              id,
+		name:            derefString(props.Name),
+		email:           derefString(props.Email),
+		userType:        derefString(props.UserType),
+		createdAt:       createdAt,
+		emailVerifiedAt: props.EmailVerifiedAt,
+		pendingEmail:    derefString(props.PendingEmail),
+		profile:         profile,
+		deletedAt:       props.DeletedAt,
+		suspension:      props.Suspension,
+	}
+}
+
+func (u *user) GetID() string                  { return u.id }
+func (u *user) GetName() string                { return u.name }
+func (u *user) GetEmail() string               { return u.email }
+func (u *user) GetUserType() string            { return u.userType }
+func (u *user) IsAdmin() bool                  { return u.userType == UserTypeAdmin }
+func (u *user) GetCreatedAt() time.Time        { return u.createdAt }
+func (u *user) GetEmailVerifiedAt() *time.Time { return u.emailVerifiedAt }
+func (u *user) IsEmailVerified() bool          { return u.emailVerifiedAt != nil }
+func (u *user) GetPendingEmail() string        { return u.pendingEmail }
+func (u *user) GetProfile() UserProfile        { return u.profile }
+func (u *user) GetDeletedAt() *time.Time       { return u.deletedAt }
+func (u *user) GetSuspension() *UserSuspension { return u.suspension }
+func (u *user) IsSuspended() bool              { return u.suspension != nil }
+
+// VerifyEmail marks the current email address as confirmed. Verifying an
+// already verified address keeps the original date.
+func (u *user) VerifyEmail(at time.Time) {
+	if u.emailVerifiedAt == nil {
+		u.emailVerifiedAt = &at
+	}
+}
+
+// RequestEmailChange records the address to switch to once its owner
+// confirms it; the current address keeps working meanwhile.
+func (u *user) RequestEmailChange(email string) {
+	u.pendingEmail = email
+}
+
+// ConfirmEmailChange switches to the pending address, which confirming
+// proved the user owns.
+func (u *user) ConfirmEmailChange(at time.Time) error {
+	if u.pendingEmail == "" {
+		return exceptions.NewConflictException("No email change to confirm")
+	}
+	u.email = u.pendingEmail
+	u.pendingEmail = ""
+	u.emailVerifiedAt = &at
+	return nil
+}
+
+func (u *user) Rename(name string) {
+	u.name = name
+}
+
+func (u *user) UpdateProfile(profile UserProfile) {
+	u.profile = profile
+}
+
+// Anonymize erases what identifies the user while keeping the row, so audit
+// entries still point at an account. Signing in is stopped through the
+// user's Auth.
+func (u *user) Anonymize(at time.Time) error {
+	if u.deletedAt != nil {
+		return exceptions.NewConflictException("Account already deleted")
+	}
+	u.name = "Deleted user"
+	u.email = u.id + "@deleted.invalid"
+	u.pendingEmail = ""
+	u.emailVerifiedAt = nil
+	u.profile = UserProfile{}
+	u.deletedAt = &at
+	return nil
+}
+
+// Suspend stops the user from signing in and using their sessions and API
+// keys until the account is reactivated.
+func (u *user) Suspend(at time.Time, reason string) error {
+	if u.deletedAt != nil {
+		return exceptions.NewConflictException("Account deleted")
 	}
+	if u.suspension != nil {
+		return exceptions.NewConflictException("Account already suspended")
+	}
+	u.suspension = &UserSuspension{At: at, Reason: reason}
+	return nil
 }
 
-func (u user) GetID() string        { return u.id }
-func (u user) GetName() string      { return u.name }
-func (u user) GetEmail() string     { return u.email }
-func (u user) GetPassword() string  { return u.password }
-func (u user) GetUserType() string  { return u.userType }
-func (u user) GetCreatedAt() time.Time { return u.createdAt }
+func (u *user) Reactivate() error {
+	if u.suspension == nil {
+		return exceptions.NewConflictException("Account not suspended")
+	}
+	u.suspension = nil
+	return nil
+}
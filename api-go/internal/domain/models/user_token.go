@@ -0,0 +1,107 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/google/uuid"
+)
+
+// Purposes a UserToken can be issued for. A token is only accepted by the
+// flow matching its purpose.
+const (
+	TokenPurposePasswordReset     = "password_reset"
+	TokenPurposeEmailVerification = "email_verification"
+	TokenPurposeAccountUnlock     = "account_unlock"
+	TokenPurposeEmailChange       = "email_change"
+)
+
+type UserTokenProps struct {
+	ID        *string
+	UserID    *string
+	Purpose   *string
+	TokenHash *string
+	ExpiresAt *time.Time
+	UsedAt    *time.Time
+	CreatedAt *time.Time
+}
+
+type userToken struct {
+	id        string
+	userID    string
+	purpose   string
+	tokenHash string
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+// UserToken is a single-use secret sent to a user out of band. Only the hash
+// of the secret is kept.
+type UserToken interface {
+	GetID() string
+	GetUserID() string
+	GetPurpose() string
+	GetTokenHash() string
+	GetExpiresAt() time.Time
+	GetUsedAt() *time.Time
+	GetCreatedAt() time.Time
+	IsUsable(now time.Time) bool
+	Use(now time.Time) error
+}
+
+func NewUserToken(props UserTokenProps) (UserToken, error) {
+	if props.UserID == nil || *props.UserID == "" {
+		return nil, exceptions.NewValidationException("Token user is required")
+	}
+	if props.Purpose == nil || *props.Purpose == "" {
+		return nil, exceptions.NewValidationException("Token purpose is required")
+	}
+	if props.TokenHash == nil || *props.TokenHash == "" {
+		return nil, exceptions.NewValidationException("Token hash is required")
+	}
+	if props.ExpiresAt == nil {
+		return nil, exceptions.NewValidationException("Token expiration is required")
+	}
+
+	id := uuid.New().String()
+	if props.ID != nil {
+		id = *props.ID
+	}
+	createdAt := time.Now()
+	if props.CreatedAt != nil {
+		createdAt = *props.CreatedAt
+	}
+
+	return &userToken{
+		id:        id,
+		userID:    *props.UserID,
+		purpose:   *props.Purpose,
+		tokenHash: *props.TokenHash,
+		expiresAt: *props.ExpiresAt,
+		usedAt:    props.UsedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (t *userToken) GetID() string           { return t.id }
+func (t *userToken) GetUserID() string       { return t.userID }
+func (t *userToken) GetPurpose() string      { return t.purpose }
+func (t *userToken) GetTokenHash() string    { return t.tokenHash }
+func (t *userToken) GetExpiresAt() time.Time { return t.expiresAt }
+func (t *userToken) GetUsedAt() *time.Time   { return t.usedAt }
+func (t *userToken) GetCreatedAt() time.Time { return t.createdAt }
+
+func (t *userToken) IsUsable(now time.Time) bool {
+	return t.usedAt == nil && now.Before(t.expiresAt)
+}
+
+// Use consumes the token. Expired and already used tokens are rejected with
+// the same message so callers cannot tell them apart.
+func (t *userToken) Use(now time.Time) error {
+	if !t.IsUsable(now) {
+		return exceptions.NewValidationException("Invalid or expired token")
+	}
+	t.usedAt = &now
+	return nil
+}
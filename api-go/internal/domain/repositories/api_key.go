@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key models.APIKey) error
+	FindByID(ctx context.Context, id string) (models.APIKey, error)
+	FindByHash(ctx context.Context, hash string) (models.APIKey, error)
+	// FindByUserID lists the user's keys, revoked ones included, newest
+	// first.
+	FindByUserID(ctx context.Context, userID string) ([]models.APIKey, error)
+	Save(ctx context.Context, key models.APIKey) error
+	// RevokeForUser revokes every key of the user that is not revoked yet.
+	RevokeForUser(ctx context.Context, userID string, now time.Time) error
+}
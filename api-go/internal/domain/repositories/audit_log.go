@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// AuditLogFilter selects audit entries. Empty fields match every entry,
+// From is inclusive and To exclusive.
+type AuditLogFilter struct {
+	ActorID    string
+	TargetType string
+	TargetID   string
+	Action     string
+	From       *time.Time
+	To         *time.Time
+	// Limit caps the number of entries returned.
+	Limit int
+}
+
+// AuditLogRepository is append-only.
+type AuditLogRepository interface {
+	Append(ctx context.Context, entry models.AuditEntry) error
+	// Find lists the entries matching filter, newest first.
+	Find(ctx context.Context, filter AuditLogFilter) ([]models.AuditEntry, error)
+}
@@ -1,9 +1,15 @@
 package repositories
 
-import "github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+import (
+	"context"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// AuthRepository stores the password of every user, one Auth each.
 type AuthRepository interface {
-	Create(auth models.Auth) error
-	FindAll() ([]models.Auth, error)
-	FindByEmail(email string) (models.Auth, error)
+	Create(ctx context.Context, auth models.Auth) error
+	// FindByUserID returns a NotFoundException for unknown users.
+	FindByUserID(ctx context.Context, userID string) (models.Auth, error)
+	Save(ctx context.Context, auth models.Auth) error
 }
@@ -1,17 +1,24 @@
 package repositories
 
 import (
+	"context"
+
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 )
 
 type IEventRepository interface {
-	FindByID(id string) (models.Event, error)
-	FindAll() ([]models.Event, error)
-	FindByAttendee(userID string) ([]models.Event, error)
-	FindByOrganizerID(organizerID string) ([]models.Event, error)
-	FindEventByOrganizerID(eventID, organizerID string) (models.Event, error)
-	FindByCategory(category string) ([]models.Event, error)
-	FindByTerm(term string) ([]models.Event, error)
-	Save(event models.Event) error
-	Delete(id string) error
+	FindByID(ctx context.Context, id string) (models.Event, error)
+	// FindByIDForUpdate locks the event row until the surrounding
+	// transaction ends. Outside a UnitOfWork it behaves like FindByID.
+	FindByIDForUpdate(ctx context.Context, id string) (models.Event, error)
+	// FindAll, FindByCategory and FindByTerm back the public listings, so
+	// they leave unpublished events out.
+	FindAll(ctx context.Context) ([]models.Event, error)
+	FindByAttendee(ctx context.Context, userID string) ([]models.Event, error)
+	FindByOrganizerID(ctx context.Context, organizerID string) ([]models.Event, error)
+	FindEventByOrganizerID(ctx context.Context, eventID, organizerID string) (models.Event, error)
+	FindByCategory(ctx context.Context, category string) ([]models.Event, error)
+	FindByTerm(ctx context.Context, term string) ([]models.Event, error)
+	Save(ctx context.Context, event models.Event) error
+	Delete(ctx context.Context, id string) error
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// EventImportRepository stores the progress of event imports. Imports save
+// their progress outside of the transaction the events are saved in, so
+// that it can be followed while they run.
+type EventImportRepository interface {
+	Create(ctx context.Context, eventImport models.EventImport) error
+	FindByID(ctx context.Context, id string) (models.EventImport, error)
+	// FindUnfinished lists the imports still pending or running.
+	FindUnfinished(ctx context.Context) ([]models.EventImport, error)
+	Save(ctx context.Context, eventImport models.EventImport) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type EventInviteRepository interface {
+	Create(ctx context.Context, invite models.EventInvite) error
+	FindByID(ctx context.Context, id string) (models.EventInvite, error)
+	// FindPendingByEvent lists the invites of the event not accepted yet,
+	// oldest first.
+	FindPendingByEvent(ctx context.Context, eventID string) ([]models.EventInvite, error)
+	// FindPendingByEmail lists the invites not accepted yet sent to email,
+	// whatever its case, oldest first.
+	FindPendingByEmail(ctx context.Context, email string) ([]models.EventInvite, error)
+	Save(ctx context.Context, invite models.EventInvite) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type ExternalIdentityRepository interface {
+	// FindByProviderSubject returns a NotFoundException when the provider
+	// account was never linked.
+	FindByProviderSubject(ctx context.Context, provider, subject string) (models.ExternalIdentity, error)
+	// FindByUserID lists the identities linked to the user, oldest first.
+	FindByUserID(ctx context.Context, userID string) ([]models.ExternalIdentity, error)
+	Create(ctx context.Context, identity models.ExternalIdentity) error
+	DeleteForUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type LoginThrottleRepository interface {
+	// Find returns a NotFoundException when no failure was recorded.
+	Find(ctx context.Context, scope, key string) (models.LoginThrottle, error)
+	FindForUpdate(ctx context.Context, scope, key string) (models.LoginThrottle, error)
+	Save(ctx context.Context, throttle models.LoginThrottle) error
+	Delete(ctx context.Context, scope, key string) error
+}
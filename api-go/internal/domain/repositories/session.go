@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session models.Session) error
+	FindByID(ctx context.Context, id string) (models.Session, error)
+	// FindActiveByUserID lists the sessions of the user that are neither
+	// revoked nor expired at now, most recently seen first.
+	FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]models.Session, error)
+	Save(ctx context.Context, session models.Session) error
+	// RevokeForUser revokes every active session of the user at now.
+	RevokeForUser(ctx context.Context, userID string, now time.Time) error
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type TwoFactorRepository interface {
+	// FindByUserID returns a NotFoundException when the user never enrolled.
+	FindByUserID(ctx context.Context, userID string) (models.TwoFactor, error)
+	// FindByUserIDForUpdate locks the enrollment so two logins cannot accept
+	// the same code concurrently.
+	FindByUserIDForUpdate(ctx context.Context, userID string) (models.TwoFactor, error)
+	Save(ctx context.Context, twoFactor models.TwoFactor) error
+	Delete(ctx context.Context, userID string) error
+}
+
+type RecoveryCodeRepository interface {
+	// ReplaceForUser deletes the user's codes and stores the new ones.
+	ReplaceForUser(ctx context.Context, userID string, codes []models.RecoveryCode) error
+	FindUnusedByUser(ctx context.Context, userID string) ([]models.RecoveryCode, error)
+	Save(ctx context.Context, code models.RecoveryCode) error
+	DeleteForUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import "context"
+
+// Repositories exposes repositories that share the same transaction.
+type Repositories interface {
+	Events() IEventRepository
+	Users() UserRepository
+	Auths() AuthRepository
+	UserTokens() UserTokenRepository
+	TwoFactors() TwoFactorRepository
+	RecoveryCodes() RecoveryCodeRepository
+	LoginThrottles() LoginThrottleRepository
+	AuditLog() AuditLogRepository
+	ExternalIdentities() ExternalIdentityRepository
+	APIKeys() APIKeyRepository
+	Sessions() SessionRepository
+	EventInvites() EventInviteRepository
+}
+
+// UnitOfWork runs fn inside a single transaction bound to ctx. Every write
+// made through repos is rolled back if fn returns an error (business
+// exceptions included) or panics, and committed otherwise.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+}
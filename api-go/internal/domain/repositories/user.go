@@ -1,10 +1,39 @@
 package repositories
 
-import "github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+// Statuses a UserFilter can select: deleted accounts are neither active nor
+// suspended.
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusDeleted   = "deleted"
+)
+
+// UserFilter selects users. Empty fields match every user; Term matches
+// names and emails regardless of case.
+type UserFilter struct {
+	Term     string
+	UserType string
+	Status   string
+	// Limit caps the number of users returned.
+	Limit int
+}
 
 type UserRepository interface {
-	Create(user models.User) error
-	FindAll() ([]models.User, error)
-	FindByEmail(email string) (models.User, error)
-	FindById(id string) (models.User, error)
+	Create(ctx context.Context, user models.User) error
+	FindAll(ctx context.Context) ([]models.User, error)
+	FindByEmail(ctx context.Context, email string) (models.User, error)
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	FindById(ctx context.Context, id string) (models.User, error)
+	// FindByIDs returns the users among ids that exist, in no particular
+	// order.
+	FindByIDs(ctx context.Context, ids []string) ([]models.User, error)
+	Save(ctx context.Context, user models.User) error
+	// Search lists the users matching filter, newest first.
+	Search(ctx context.Context, filter UserFilter) ([]models.User, error)
 }
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+)
+
+type UserTokenRepository interface {
+	Create(ctx context.Context, token models.UserToken) error
+	// FindByHashForUpdate locks the token until the surrounding transaction
+	// ends, so concurrent requests cannot both consume it.
+	FindByHashForUpdate(ctx context.Context, purpose, hash string) (models.UserToken, error)
+	// FindLatestForUser returns the most recently created token of the given
+	// purpose, used or not.
+	FindLatestForUser(ctx context.Context, userID, purpose string) (models.UserToken, error)
+	Save(ctx context.Context, token models.UserToken) error
+	// RevokeForUser marks every unused token of the given purpose as used.
+	RevokeForUser(ctx context.Context, userID, purpose string, at time.Time) error
+}
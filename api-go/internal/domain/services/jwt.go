@@ -1,6 +1,34 @@
 package services
 
 type IJWTService interface {
-	GenerateToken(userID string) (*string, error)
+	// GenerateToken issues the access token of a session, whose ID is the
+	// sid claim.
+	GenerateToken(userID, sessionID string) (*string, error)
+	// ExtractClaims only accepts access tokens, never challenge tokens.
 	ExtractClaims(token string) (map[string]interface{}, error)
-}
\ No newline at end of file
+	// GenerateChallengeToken issues a short-lived token proving the password
+	// step of a two-factor login succeeded.
+	GenerateChallengeToken(userID string) (*string, error)
+	ExtractChallengeSubject(token string) (string, error)
+	// PublicKeys returns the keys other services may verify tokens with.
+	PublicKeys() JSONWebKeySet
+}
+
+// JSONWebKey is the public half of a signing key, in the JSON Web Key
+// format of RFC 7517. Only the members of its key type are set.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X describe Ed25519 keys.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
@@ -0,0 +1,13 @@
+package services
+
+import "context"
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type IMailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
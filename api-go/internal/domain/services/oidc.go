@@ -0,0 +1,24 @@
+package services
+
+import "context"
+
+// OIDCClaims are the claims of an ID token whose signature, issuer,
+// audience and expiry were already verified.
+type OIDCClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Nonce         string
+}
+
+// IOIDCProvider runs the authorization-code flow with PKCE against one
+// OpenID Connect provider.
+type IOIDCProvider interface {
+	// AuthCodeURL is where the user is sent to log in. The provider echoes
+	// state back and puts nonce in the ID token; codeVerifier is sent as its
+	// S256 challenge.
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems the code and returns the verified ID token claims.
+	Exchange(ctx context.Context, code, codeVerifier string) (*OIDCClaims, error)
+}
@@ -0,0 +1,13 @@
+package services
+
+// IPasswordHasher hashes passwords with the configured algorithm and checks
+// them against hashes computed with any supported one. Hashes embed the
+// parameters they were computed with.
+type IPasswordHasher interface {
+	// Hash returns the hash of password and the algorithm that computed it.
+	Hash(password string) (hash, algorithm string, err error)
+	Verify(password, hash, algorithm string) bool
+	// NeedsRehash reports whether hash was computed with another algorithm
+	// or parameters than the configured ones.
+	NeedsRehash(hash, algorithm string) bool
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.APIKeyMapper
+}
+
+func NewAPIKeyRepository(db *gorm.DB, mapper mappers.APIKeyMapper) repositories.APIKeyRepository {
+	return &apiKeyRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *apiKeyRepositoryImpl) Create(ctx context.Context, key models.APIKey) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(key)).Error; err != nil {
+		return fmt.Errorf("error creating API key: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepositoryImpl) FindByID(ctx context.Context, id string) (models.APIKey, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *apiKeyRepositoryImpl) FindByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	return r.findOne(ctx, "key_hash = ?", hash)
+}
+
+func (r *apiKeyRepositoryImpl) findOne(ctx context.Context, query string, arg string) (models.APIKey, error) {
+	var entity entities.APIKey
+	if err := r.db.WithContext(ctx).Where(query, arg).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("API key not found")
+		}
+		return nil, fmt.Errorf("error retrieving API key: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *apiKeyRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]models.APIKey, error) {
+	var rows []entities.APIKey
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving API keys: %w", err)
+	}
+
+	keys := make([]models.APIKey, 0, len(rows))
+	for i := range rows {
+		key, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepositoryImpl) Save(ctx context.Context, key models.APIKey) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(key)).Error; err != nil {
+		return fmt.Errorf("error saving API key %s: %w", key.GetID(), err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepositoryImpl) RevokeForUser(ctx context.Context, userID string, now time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&entities.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error
+	if err != nil {
+		return fmt.Errorf("error revoking API keys: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type auditLogRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.AuditEntryMapper
+}
+
+func NewAuditLogRepository(db *gorm.DB, mapper mappers.AuditEntryMapper) repositories.AuditLogRepository {
+	return &auditLogRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *auditLogRepositoryImpl) Append(ctx context.Context, entry models.AuditEntry) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(entry)).Error; err != nil {
+		return fmt.Errorf("error appending audit entry: %w", err)
+	}
+	return nil
+}
+
+func (r *auditLogRepositoryImpl) Find(ctx context.Context, filter repositories.AuditLogFilter) ([]models.AuditEntry, error) {
+	query := r.db.WithContext(ctx).Order("created_at DESC, id DESC").Limit(filter.Limit)
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var rows []entities.AuditEntry
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("error listing audit entries: %w", err)
+	}
+
+	entries := make([]models.AuditEntry, 0, len(rows))
+	for i := range rows {
+		entry, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
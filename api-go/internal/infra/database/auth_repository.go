@@ -1,6 +1,11 @@
 package database
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
@@ -9,7 +14,7 @@ import (
 )
 
 type authRepositoryImpl struct {
-	db *gorm.DB
+	db     *gorm.DB
 	mapper mappers.AuthMapper
 }
 
@@ -17,28 +22,27 @@ func NewAuthRepository(db *gorm.DB, mapper mappers.AuthMapper) repositories.Auth
 	return &authRepositoryImpl{db: db, mapper: mapper}
 }
 
-func (r *authRepositoryImpl) Create(auth models.Auth) error {
-	entity := r.mapper.DomainToModel(auth)
-	return r.db.Create(entity).Error
+func (r *authRepositoryImpl) Create(ctx context.Context, auth models.Auth) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(auth)).Error; err != nil {
+		return fmt.Errorf("error creating credentials: %w", err)
+	}
+	return nil
 }
 
-func (r *authRepositoryImpl) FindAll() ([]models.Auth, error) {
-	var entities []entities.Auth
-	if err := r.db.Find(&entities).Error; err != nil {
-		return nil, err
-	}
-	var auths []models.Auth
-	for _, entity := range entities {
-		auths = append(auths, r.mapper.ModelToDomain(&entity))
+func (r *authRepositoryImpl) FindByUserID(ctx context.Context, userID string) (models.Auth, error) {
+	var entity entities.Auth
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("credentials not found")
+		}
+		return nil, fmt.Errorf("error retrieving credentials: %w", err)
 	}
-	return auths, nil
+	return r.mapper.ModelToDomain(&entity)
 }
 
-func (r *authRepositoryImpl) FindByEmail(email string) (models.Auth, error) {
-	var entity entities.Auth
-	if err := r.db.Where("email = ?", email).First(&entity).Error; err != nil {
-		return models.NewAuth(models.AuthProps{}), err
+func (r *authRepositoryImpl) Save(ctx context.Context, auth models.Auth) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(auth)).Error; err != nil {
+		return fmt.Errorf("error saving credentials of user %s: %w", auth.GetUserID(), err)
 	}
-	auth := r.mapper.ModelToDomain(&entity)
-	return auth, nil
+	return nil
 }
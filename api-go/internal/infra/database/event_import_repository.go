@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type eventImportRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.EventImportMapper
+}
+
+func NewEventImportRepository(db *gorm.DB, mapper mappers.EventImportMapper) repositories.EventImportRepository {
+	return &eventImportRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *eventImportRepositoryImpl) Create(ctx context.Context, eventImport models.EventImport) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(eventImport)).Error; err != nil {
+		return fmt.Errorf("error creating event import: %w", err)
+	}
+	return nil
+}
+
+func (r *eventImportRepositoryImpl) FindByID(ctx context.Context, id string) (models.EventImport, error) {
+	var entity entities.EventImport
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("Import not found")
+		}
+		return nil, fmt.Errorf("error retrieving event import: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *eventImportRepositoryImpl) FindUnfinished(ctx context.Context) ([]models.EventImport, error) {
+	var rows []entities.EventImport
+	err := r.db.WithContext(ctx).
+		Where("status IN ?", []string{models.EventImportPending, models.EventImportRunning}).
+		Order("created_at").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving unfinished event imports: %w", err)
+	}
+
+	imports := make([]models.EventImport, 0, len(rows))
+	for i := range rows {
+		eventImport, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, eventImport)
+	}
+	return imports, nil
+}
+
+func (r *eventImportRepositoryImpl) Save(ctx context.Context, eventImport models.EventImport) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(eventImport)).Error; err != nil {
+		return fmt.Errorf("error saving event import %s: %w", eventImport.GetID(), err)
+	}
+	return nil
+}
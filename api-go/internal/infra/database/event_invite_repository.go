@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type eventInviteRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.EventInviteMapper
+}
+
+func NewEventInviteRepository(db *gorm.DB, mapper mappers.EventInviteMapper) repositories.EventInviteRepository {
+	return &eventInviteRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *eventInviteRepositoryImpl) Create(ctx context.Context, invite models.EventInvite) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(invite)).Error; err != nil {
+		return fmt.Errorf("error creating event invite: %w", err)
+	}
+	return nil
+}
+
+func (r *eventInviteRepositoryImpl) FindByID(ctx context.Context, id string) (models.EventInvite, error) {
+	var entity entities.EventInvite
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("Invite not found")
+		}
+		return nil, fmt.Errorf("error retrieving event invite: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *eventInviteRepositoryImpl) FindPendingByEvent(ctx context.Context, eventID string) ([]models.EventInvite, error) {
+	return r.findPending(ctx, "event_id = ?", eventID)
+}
+
+func (r *eventInviteRepositoryImpl) FindPendingByEmail(ctx context.Context, email string) ([]models.EventInvite, error) {
+	return r.findPending(ctx, "email = ?", models.NormalizeInviteEmail(email))
+}
+
+func (r *eventInviteRepositoryImpl) findPending(ctx context.Context, query string, arg string) ([]models.EventInvite, error) {
+	var rows []entities.EventInvite
+	err := r.db.WithContext(ctx).
+		Where("accepted_at IS NULL").
+		Where(query, arg).
+		Order("created_at, id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving event invites: %w", err)
+	}
+
+	invites := make([]models.EventInvite, 0, len(rows))
+	for i := range rows {
+		invite, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
+
+func (r *eventInviteRepositoryImpl) Save(ctx context.Context, invite models.EventInvite) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(invite)).Error; err != nil {
+		return fmt.Errorf("error saving event invite %s: %w", invite.GetID(), err)
+	}
+	return nil
+}
+
+func (r *eventInviteRepositoryImpl) Delete(ctx context.Context, id string) error {
+	if err := r.db.WithContext(ctx).Delete(&entities.EventInvite{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("error deleting event invite %s: %w", id, err)
+	}
+	return nil
+}
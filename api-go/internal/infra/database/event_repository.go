@@ -1,14 +1,17 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var errorLoadingEvent = "Error loading event: %v"
@@ -25,14 +28,22 @@ func NewEventRepository(db *gorm.DB, mapper mappers.EventMapper) repositories.IE
 	}
 }
 
-func (r eventRepositoryImpl) FindByID(id string) (models.Event, error) {
+func (r eventRepositoryImpl) FindByID(ctx context.Context, id string) (models.Event, error) {
+	return r.findByID(r.db.WithContext(ctx), id)
+}
+
+func (r eventRepositoryImpl) FindByIDForUpdate(ctx context.Context, id string) (models.Event, error) {
+	return r.findByID(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r eventRepositoryImpl) findByID(db *gorm.DB, id string) (models.Event, error) {
 	var event entities.Event
-	if err := r.db.First(&event, "id = ?", id).Error; err != nil {
+	if err := db.First(&event, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("event with ID %s not found", id)
+			return nil, exceptions.NewNotFoundException(fmt.Sprintf("event with ID %s not found", id))
 		}
 
-		return nil, fmt.Errorf("error retrieving event with ID %s: %v", id, err)
+		return nil, fmt.Errorf("error retrieving event with ID %s: %w", id, err)
 	}
 
 	domain, err := r.mapper.ModelToDomain(event)
@@ -43,15 +54,15 @@ func (r eventRepositoryImpl) FindByID(id string) (models.Event, error) {
 	return domain, nil
 }
 
-func (r eventRepositoryImpl) FindAll() ([]models.Event, error) {
+func (r eventRepositoryImpl) FindAll(ctx context.Context) ([]models.Event, error) {
 	var events []entities.Event
 
-	if err := r.db.Find(&events).Error; err != nil {
-		return nil, fmt.Errorf("error retrieving events: %v", err)
+	if err := r.db.WithContext(ctx).Where("unpublished_at IS NULL").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving events: %w", err)
 	}
 
 	if len(events) == 0 {
-		return nil, fmt.Errorf("no events found")
+		return nil, exceptions.NewNotFoundException("no events found")
 	}
 
 	var domainEvents []models.Event
@@ -68,7 +79,7 @@ func (r eventRepositoryImpl) FindAll() ([]models.Event, error) {
 	return domainEvents, nil
 }
 
-func (r eventRepositoryImpl) FindByAttendee(userID string) ([]models.Event, error) {
+func (r eventRepositoryImpl) FindByAttendee(ctx context.Context, userID string) ([]models.Event, error) {
 	var events []entities.Event
 
 	query := `
@@ -79,12 +90,12 @@ func (r eventRepositoryImpl) FindByAttendee(userID string) ([]models.Event, erro
         )
     `
 
-	if err := r.db.Raw(query, userID).Scan(&events).Error; err != nil {
-		return nil, fmt.Errorf("error retrieving events for user ID %s: %v", userID, err)
+	if err := r.db.WithContext(ctx).Raw(query, userID).Scan(&events).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving events for user ID %s: %w", userID, err)
 	}
 
 	if len(events) == 0 {
-		return nil, fmt.Errorf("no events found for user ID %s", userID)
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("no events found for user ID %s", userID))
 	}
 
 	var domainEvents []models.Event
@@ -101,21 +112,21 @@ func (r eventRepositoryImpl) FindByAttendee(userID string) ([]models.Event, erro
 	return domainEvents, nil
 }
 
-func (r eventRepositoryImpl) FindByOrganizerID(organizerID string) ([]models.Event, error) {
+func (r eventRepositoryImpl) FindByOrganizerID(ctx context.Context, organizerID string) ([]models.Event, error) {
 	var events []entities.Event
 
 	log.Printf("FindByOrganizerID - Searching for events with organizer_id = %s", organizerID)
 
-	if err := r.db.Where("organizer_id = ?", organizerID).Find(&events).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID).Find(&events).Error; err != nil {
 		log.Printf("FindByOrganizerID - Database error: %v", err)
-		return nil, fmt.Errorf("error retrieving events for organizer ID %s: %v", organizerID, err)
+		return nil, fmt.Errorf("error retrieving events for organizer ID %s: %w", organizerID, err)
 	}
 
 	log.Printf("FindByOrganizerID - Found %d events in database for organizer %s", len(events), organizerID)
 	
 	if len(events) == 0 {
 		log.Printf("FindByOrganizerID - No events found for organizer ID %s", organizerID)
-		return nil, fmt.Errorf("no events found for organizer ID %s", organizerID)
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("no events found for organizer ID %s", organizerID))
 	}
 
 	// Log details of each event found
@@ -137,14 +148,14 @@ func (r eventRepositoryImpl) FindByOrganizerID(organizerID string) ([]models.Eve
 	return domainEvents, nil
 }
 
-func (r eventRepositoryImpl) FindEventByOrganizerID(eventID, organizerID string) (models.Event, error) {
+func (r eventRepositoryImpl) FindEventByOrganizerID(ctx context.Context, eventID, organizerID string) (models.Event, error) {
 	var event entities.Event
 
-	if err := r.db.Where("id = ? AND organizer_id = ?", eventID, organizerID).First(&event).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND organizer_id = ?", eventID, organizerID).First(&event).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("Event with ID %s not found for organizer ID %s", eventID, organizerID)
+			return nil, exceptions.NewNotFoundException(fmt.Sprintf("Event with ID %s not found for organizer ID %s", eventID, organizerID))
 		}
-		return nil, fmt.Errorf("Error retrieving event with ID %s for organizer ID %s: %v", eventID, organizerID, err)
+		return nil, fmt.Errorf("Error retrieving event with ID %s for organizer ID %s: %w", eventID, organizerID, err)
 	}
 
 	domain, err := r.mapper.ModelToDomain(event)
@@ -155,15 +166,15 @@ func (r eventRepositoryImpl) FindEventByOrganizerID(eventID, organizerID string)
 	return domain, nil
 }
 
-func (r eventRepositoryImpl) FindByCategory(category string) ([]models.Event, error) {
+func (r eventRepositoryImpl) FindByCategory(ctx context.Context, category string) ([]models.Event, error) {
 	var events []entities.Event
 
-	if err := r.db.Where("category = ?", category).Find(&events).Error; err != nil {
-		return nil, fmt.Errorf("Error retrieving events for category %s: %v", category, err)
+	if err := r.db.WithContext(ctx).Where("unpublished_at IS NULL AND category = ?", category).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("Error retrieving events for category %s: %w", category, err)
 	}
 
 	if len(events) == 0 {
-		return nil, fmt.Errorf("No events found for category %s", category)
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("No events found for category %s", category))
 	}
 
 	var domainEvents []models.Event
@@ -180,15 +191,15 @@ func (r eventRepositoryImpl) FindByCategory(category string) ([]models.Event, er
 	return domainEvents, nil
 }
 
-func (r eventRepositoryImpl) FindByTerm(term string) ([]models.Event, error) {
+func (r eventRepositoryImpl) FindByTerm(ctx context.Context, term string) ([]models.Event, error) {
 	var events []entities.Event
 
-	if err := r.db.Where("name LIKE ? OR description LIKE ?", "%"+term+"%", "%"+term+"%").Find(&events).Error; err != nil {
-		return nil, fmt.Errorf("Error retrieving events by term %s: %v", term, err)
+	if err := r.db.WithContext(ctx).Where("unpublished_at IS NULL AND (name LIKE ? OR description LIKE ?)", "%"+term+"%", "%"+term+"%").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("Error retrieving events by term %s: %w", term, err)
 	}
 
 	if len(events) == 0 {
-		return nil, fmt.Errorf("No events found for term %s", term)
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("No events found for term %s", term))
 	}
 
 	var domainEvents []models.Event
@@ -205,26 +216,26 @@ func (r eventRepositoryImpl) FindByTerm(term string) ([]models.Event, error) {
 	return domainEvents, nil
 }
 
-func (r eventRepositoryImpl) Save(event models.Event) error {
+func (r eventRepositoryImpl) Save(ctx context.Context, event models.Event) error {
 	entity := r.mapper.DomainToModel(event)
-	if err := r.db.Save(&entity).Error; err != nil {
-		return fmt.Errorf("Error saving event: %v", err)
+	if err := r.db.WithContext(ctx).Save(&entity).Error; err != nil {
+		return fmt.Errorf("Error saving event: %w", err)
 	}
 
 	return nil
 }
 
-func (r eventRepositoryImpl) Delete(id string) error {
+func (r eventRepositoryImpl) Delete(ctx context.Context, id string) error {
 	var event entities.Event
-	if err := r.db.First(&event, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&event, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("Event with ID %s not found", id)
+			return exceptions.NewNotFoundException(fmt.Sprintf("Event with ID %s not found", id))
 		}
-		return fmt.Errorf("Error retrieving event with ID %s: %v", id, err)
+		return fmt.Errorf("Error retrieving event with ID %s: %w", id, err)
 	}
 
-	if err := r.db.Delete(&event).Error; err != nil {
-		return fmt.Errorf("Error deleting event with ID %s: %v", id, err)
+	if err := r.db.WithContext(ctx).Delete(&event).Error; err != nil {
+		return fmt.Errorf("Error deleting event with ID %s: %w", id, err)
 	}
 
 	return nil
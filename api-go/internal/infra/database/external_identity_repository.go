@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type externalIdentityRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.ExternalIdentityMapper
+}
+
+func NewExternalIdentityRepository(db *gorm.DB, mapper mappers.ExternalIdentityMapper) repositories.ExternalIdentityRepository {
+	return &externalIdentityRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *externalIdentityRepositoryImpl) FindByProviderSubject(ctx context.Context, provider, subject string) (models.ExternalIdentity, error) {
+	var entity entities.ExternalIdentity
+	err := r.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("identity not linked")
+		}
+		return nil, fmt.Errorf("error retrieving identity: %w", err)
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *externalIdentityRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]models.ExternalIdentity, error) {
+	var rows []entities.ExternalIdentity
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving identities: %w", err)
+	}
+
+	identities := make([]models.ExternalIdentity, 0, len(rows))
+	for i := range rows {
+		identity, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, nil
+}
+
+func (r *externalIdentityRepositoryImpl) Create(ctx context.Context, identity models.ExternalIdentity) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(identity)).Error; err != nil {
+		return fmt.Errorf("error creating identity: %w", err)
+	}
+	return nil
+}
+
+func (r *externalIdentityRepositoryImpl) DeleteForUser(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entities.ExternalIdentity{}).Error; err != nil {
+		return fmt.Errorf("error deleting identities: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type loginThrottleRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.LoginThrottleMapper
+}
+
+func NewLoginThrottleRepository(db *gorm.DB, mapper mappers.LoginThrottleMapper) repositories.LoginThrottleRepository {
+	return &loginThrottleRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *loginThrottleRepositoryImpl) Find(ctx context.Context, scope, key string) (models.LoginThrottle, error) {
+	return r.find(r.db.WithContext(ctx), scope, key)
+}
+
+func (r *loginThrottleRepositoryImpl) FindForUpdate(ctx context.Context, scope, key string) (models.LoginThrottle, error) {
+	return r.find(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), scope, key)
+}
+
+func (r *loginThrottleRepositoryImpl) find(db *gorm.DB, scope, key string) (models.LoginThrottle, error) {
+	var entity entities.LoginThrottle
+	if err := db.Where("scope = ? AND key = ?", scope, key).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("no failed login recorded")
+		}
+		return nil, fmt.Errorf("error retrieving login throttle: %w", err)
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+// Save upserts so two first failures racing for the same key do not fail.
+func (r *loginThrottleRepositoryImpl) Save(ctx context.Context, throttle models.LoginThrottle) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(r.mapper.DomainToModel(throttle)).Error
+	if err != nil {
+		return fmt.Errorf("error saving login throttle: %w", err)
+	}
+	return nil
+}
+
+func (r *loginThrottleRepositoryImpl) Delete(ctx context.Context, scope, key string) error {
+	err := r.db.WithContext(ctx).Where("scope = ? AND key = ?", scope, key).Delete(&entities.LoginThrottle{}).Error
+	if err != nil {
+		return fmt.Errorf("error deleting login throttle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+-- Baseline schema. Uses IF NOT EXISTS so databases previously created by
+-- gorm's AutoMigrate are adopted without changes.
+CREATE TABLE IF NOT EXISTS users (
+    id         text PRIMARY KEY,
+    name       varchar(255) NOT NULL,
+    email      varchar(255) NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS user_type varchar(50) NOT NULL DEFAULT 'participant';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conrelid = 'users'::regclass AND contype = 'u'
+    ) THEN
+        ALTER TABLE users ADD CONSTRAINT uni_users_email UNIQUE (email);
+    END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS events (
+    id           text PRIMARY KEY,
+    name         varchar(255) NOT NULL,
+    location     varchar(255) NOT NULL,
+    date         timestamptz NOT NULL,
+    description  text,
+    organizer_id varchar(255) NOT NULL,
+    attendees    json,
+    created_at   timestamptz NOT NULL,
+    category     varchar(255) NOT NULL,
+    "limit"      bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS auths (
+    id         text PRIMARY KEY,
+    email      text,
+    password   text,
+    created_at timestamptz
+);
@@ -0,0 +1,13 @@
+ALTER TABLE users ADD COLUMN password_changed_at timestamptz;
+
+CREATE TABLE user_tokens (
+    id         text PRIMARY KEY,
+    user_id    text NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose    varchar(50) NOT NULL,
+    token_hash varchar(64) NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    used_at    timestamptz,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_user_tokens_user_purpose ON user_tokens (user_id, purpose);
@@ -0,0 +1,17 @@
+CREATE TABLE user_two_factors (
+    user_id        text PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret         text NOT NULL,
+    confirmed_at   timestamptz,
+    last_used_step bigint NOT NULL DEFAULT 0,
+    created_at     timestamptz NOT NULL
+);
+
+CREATE TABLE recovery_codes (
+    id         text PRIMARY KEY,
+    user_id    text NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash  varchar(64) NOT NULL,
+    used_at    timestamptz,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_recovery_codes_user_id ON recovery_codes (user_id);
@@ -0,0 +1,23 @@
+CREATE TABLE login_throttles (
+    scope           varchar(20)  NOT NULL,
+    key             varchar(255) NOT NULL,
+    failures        integer      NOT NULL DEFAULT 0,
+    last_failure_at timestamptz  NOT NULL,
+    locked_until    timestamptz,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE TABLE audit_log (
+    id          text PRIMARY KEY,
+    actor_id    text,
+    action      varchar(100) NOT NULL,
+    target_type varchar(50)  NOT NULL,
+    target_id   varchar(255) NOT NULL,
+    ip          varchar(64)  NOT NULL,
+    details     jsonb,
+    created_at  timestamptz  NOT NULL
+);
+
+CREATE INDEX idx_audit_log_actor_id ON audit_log (actor_id);
+CREATE INDEX idx_audit_log_target ON audit_log (target_type, target_id);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
@@ -0,0 +1,10 @@
+CREATE TABLE user_identities (
+    provider   varchar(50)  NOT NULL,
+    subject    varchar(255) NOT NULL,
+    user_id    text         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      varchar(255) NOT NULL,
+    created_at timestamptz  NOT NULL,
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
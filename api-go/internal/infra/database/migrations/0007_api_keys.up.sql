@@ -0,0 +1,14 @@
+CREATE TABLE api_keys (
+    id           text         PRIMARY KEY,
+    user_id      text         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name         varchar(100) NOT NULL,
+    prefix       varchar(16)  NOT NULL,
+    key_hash     varchar(64)  NOT NULL UNIQUE,
+    scopes       jsonb        NOT NULL,
+    expires_at   timestamptz,
+    last_used_at timestamptz,
+    revoked_at   timestamptz,
+    created_at   timestamptz  NOT NULL
+);
+
+CREATE INDEX idx_api_keys_user_id ON api_keys (user_id);
@@ -0,0 +1,13 @@
+CREATE TABLE sessions (
+    id           text         PRIMARY KEY,
+    user_id      text         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    ip           varchar(45)  NOT NULL,
+    user_agent   varchar(512) NOT NULL,
+    device       varchar(100) NOT NULL,
+    created_at   timestamptz  NOT NULL,
+    last_seen_at timestamptz  NOT NULL,
+    expires_at   timestamptz  NOT NULL,
+    revoked_at   timestamptz
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions (user_id);
@@ -0,0 +1,6 @@
+ALTER TABLE users
+    ADD COLUMN pending_email varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url    varchar(500) NOT NULL DEFAULT '',
+    ADD COLUMN bio           varchar(500) NOT NULL DEFAULT '',
+    ADD COLUMN phone         varchar(20)  NOT NULL DEFAULT '',
+    ADD COLUMN company       varchar(255) NOT NULL DEFAULT '';
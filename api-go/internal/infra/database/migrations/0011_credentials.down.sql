@@ -0,0 +1,17 @@
+ALTER TABLE users
+    ADD COLUMN password            varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN password_changed_at timestamptz;
+
+UPDATE users u
+SET password = a.password_hash, password_changed_at = a.password_changed_at
+FROM auths a
+WHERE a.user_id = u.id;
+
+DROP TABLE auths;
+
+CREATE TABLE auths (
+    id         text PRIMARY KEY,
+    email      text,
+    password   text,
+    created_at timestamptz
+);
@@ -0,0 +1,33 @@
+-- Passwords move from users to auths, which becomes the credential store.
+-- The old auths rows were never written by the API; a bcrypt hash found
+-- there is only adopted for users left without a password.
+ALTER TABLE auths RENAME TO legacy_auths;
+
+CREATE TABLE auths (
+    user_id             text         PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    password_hash       varchar(255) NOT NULL DEFAULT '',
+    algorithm           varchar(20)  NOT NULL DEFAULT '',
+    password_changed_at timestamptz,
+    created_at          timestamptz  NOT NULL
+);
+
+INSERT INTO auths (user_id, password_hash, algorithm, password_changed_at, created_at)
+SELECT u.id,
+       COALESCE(NULLIF(u.password, ''), legacy.password, ''),
+       CASE WHEN COALESCE(NULLIF(u.password, ''), legacy.password, '') = '' THEN '' ELSE 'bcrypt' END,
+       u.password_changed_at,
+       u.created_at
+FROM users u
+LEFT JOIN LATERAL (
+    SELECT a.password
+    FROM legacy_auths a
+    WHERE a.email = u.email AND a.password LIKE '$2%'
+    ORDER BY a.created_at DESC NULLS LAST
+    LIMIT 1
+) legacy ON true;
+
+DROP TABLE legacy_auths;
+
+ALTER TABLE users
+    DROP COLUMN password,
+    DROP COLUMN password_changed_at;
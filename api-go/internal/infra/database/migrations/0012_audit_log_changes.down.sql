@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS audit_log_no_truncate ON audit_log;
+DROP TRIGGER IF EXISTS audit_log_no_update_or_delete ON audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+
+DROP INDEX IF EXISTS idx_audit_log_request_id;
+
+ALTER TABLE audit_log DROP COLUMN IF EXISTS changes;
+ALTER TABLE audit_log DROP COLUMN IF EXISTS request_id;
@@ -0,0 +1,20 @@
+ALTER TABLE audit_log ADD COLUMN request_id varchar(128) NOT NULL DEFAULT '';
+ALTER TABLE audit_log ADD COLUMN changes jsonb;
+
+CREATE INDEX idx_audit_log_request_id ON audit_log (request_id);
+
+-- The audit log is append-only: rows can be neither changed nor removed,
+-- not even by the API's own database user.
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update_or_delete
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,4 @@
+ALTER TABLE events DROP COLUMN IF EXISTS unpublished_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
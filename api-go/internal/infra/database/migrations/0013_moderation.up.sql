@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN suspended_at timestamptz;
+ALTER TABLE users ADD COLUMN suspension_reason varchar(500) NOT NULL DEFAULT '';
+
+ALTER TABLE events ADD COLUMN unpublished_at timestamptz;
@@ -0,0 +1,17 @@
+CREATE TABLE event_imports (
+    id           text         PRIMARY KEY,
+    organizer_id text         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    format       varchar(10)  NOT NULL,
+    mode         varchar(20)  NOT NULL,
+    dry_run      boolean      NOT NULL,
+    status       varchar(20)  NOT NULL,
+    total        integer      NOT NULL,
+    processed    integer      NOT NULL,
+    imported     integer      NOT NULL,
+    errors       jsonb,
+    failure      varchar(500) NOT NULL,
+    created_at   timestamptz  NOT NULL,
+    finished_at  timestamptz
+);
+
+CREATE INDEX idx_event_imports_organizer_id ON event_imports (organizer_id);
@@ -0,0 +1,13 @@
+CREATE TABLE event_invites (
+    id          text         PRIMARY KEY,
+    event_id    text         NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    email       varchar(255) NOT NULL,
+    invited_by  text         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    over_limit  boolean      NOT NULL,
+    created_at  timestamptz  NOT NULL,
+    accepted_at timestamptz
+);
+
+-- An address has at most one pending invite per event.
+CREATE UNIQUE INDEX idx_event_invites_pending ON event_invites (event_id, email) WHERE accepted_at IS NULL;
+CREATE INDEX idx_event_invites_email ON event_invites (email) WHERE accepted_at IS NULL;
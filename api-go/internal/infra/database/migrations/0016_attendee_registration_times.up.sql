@@ -0,0 +1,3 @@
+-- When each attendee registered, keyed by user ID. Registrations made before
+-- this migration have no entry.
+ALTER TABLE events ADD COLUMN attendees_registered_at json;
@@ -0,0 +1,13 @@
+ALTER TABLE events ADD COLUMN attendees_registered_at json;
+
+UPDATE events
+SET attendees_registered_at = (
+    SELECT json_object_agg(key, value -> 'registered_at')
+    FROM json_each(attendee_registrations)
+    WHERE value -> 'registered_at' IS NOT NULL
+)
+WHERE attendee_registrations IS NOT NULL;
+
+ALTER TABLE events DROP COLUMN IF EXISTS attendee_registrations;
+ALTER TABLE events DROP COLUMN IF EXISTS questions;
+ALTER TABLE events DROP COLUMN IF EXISTS ticket_types;
@@ -0,0 +1,13 @@
+ALTER TABLE events ADD COLUMN ticket_types json;
+ALTER TABLE events ADD COLUMN questions json;
+ALTER TABLE events ADD COLUMN attendee_registrations json;
+
+-- Keeps the registration times recorded since 0016.
+UPDATE events
+SET attendee_registrations = (
+    SELECT json_object_agg(key, json_build_object('registered_at', value))
+    FROM json_each(attendees_registered_at)
+)
+WHERE attendees_registered_at IS NOT NULL;
+
+ALTER TABLE events DROP COLUMN attendees_registered_at;
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type sessionRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.SessionMapper
+}
+
+func NewSessionRepository(db *gorm.DB, mapper mappers.SessionMapper) repositories.SessionRepository {
+	return &sessionRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *sessionRepositoryImpl) Create(ctx context.Context, session models.Session) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(session)).Error; err != nil {
+		return fmt.Errorf("error creating session: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRepositoryImpl) FindByID(ctx context.Context, id string) (models.Session, error) {
+	var entity entities.Session
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("Session not found")
+		}
+		return nil, fmt.Errorf("error retrieving session: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *sessionRepositoryImpl) FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]models.Session, error) {
+	var rows []entities.Session
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("last_seen_at DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sessions: %w", err)
+	}
+
+	sessions := make([]models.Session, 0, len(rows))
+	for i := range rows {
+		session, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (r *sessionRepositoryImpl) Save(ctx context.Context, session models.Session) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(session)).Error; err != nil {
+		return fmt.Errorf("error saving session %s: %w", session.GetID(), err)
+	}
+	return nil
+}
+
+func (r *sessionRepositoryImpl) RevokeForUser(ctx context.Context, userID string, now time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&entities.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error
+	if err != nil {
+		return fmt.Errorf("error revoking sessions: %w", err)
+	}
+	return nil
+}
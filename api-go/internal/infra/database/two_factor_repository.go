@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type twoFactorRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.TwoFactorMapper
+}
+
+func NewTwoFactorRepository(db *gorm.DB, mapper mappers.TwoFactorMapper) repositories.TwoFactorRepository {
+	return &twoFactorRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *twoFactorRepositoryImpl) FindByUserID(ctx context.Context, userID string) (models.TwoFactor, error) {
+	return r.findByUserID(r.db.WithContext(ctx), userID)
+}
+
+func (r *twoFactorRepositoryImpl) FindByUserIDForUpdate(ctx context.Context, userID string) (models.TwoFactor, error) {
+	return r.findByUserID(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), userID)
+}
+
+func (r *twoFactorRepositoryImpl) findByUserID(db *gorm.DB, userID string) (models.TwoFactor, error) {
+	var entity entities.TwoFactor
+	if err := db.Where("user_id = ?", userID).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("two-factor authentication is not set up")
+		}
+		return nil, fmt.Errorf("error retrieving two-factor settings: %w", err)
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *twoFactorRepositoryImpl) Save(ctx context.Context, twoFactor models.TwoFactor) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(twoFactor)).Error; err != nil {
+		return fmt.Errorf("error saving two-factor settings: %w", err)
+	}
+	return nil
+}
+
+func (r *twoFactorRepositoryImpl) Delete(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entities.TwoFactor{}).Error; err != nil {
+		return fmt.Errorf("error deleting two-factor settings: %w", err)
+	}
+	return nil
+}
+
+type recoveryCodeRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.RecoveryCodeMapper
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB, mapper mappers.RecoveryCodeMapper) repositories.RecoveryCodeRepository {
+	return &recoveryCodeRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *recoveryCodeRepositoryImpl) ReplaceForUser(ctx context.Context, userID string, codes []models.RecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+			return fmt.Errorf("error deleting recovery codes: %w", err)
+		}
+		if len(codes) == 0 {
+			return nil
+		}
+
+		rows := make([]*entities.RecoveryCode, 0, len(codes))
+		for _, code := range codes {
+			rows = append(rows, r.mapper.DomainToModel(code))
+		}
+		if err := tx.Create(rows).Error; err != nil {
+			return fmt.Errorf("error creating recovery codes: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *recoveryCodeRepositoryImpl) FindUnusedByUser(ctx context.Context, userID string) ([]models.RecoveryCode, error) {
+	var rows []entities.RecoveryCode
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND used_at IS NULL", userID).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving recovery codes: %w", err)
+	}
+
+	codes := make([]models.RecoveryCode, 0, len(rows))
+	for _, row := range rows {
+		code, err := r.mapper.ModelToDomain(&row)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func (r *recoveryCodeRepositoryImpl) Save(ctx context.Context, code models.RecoveryCode) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(code)).Error; err != nil {
+		return fmt.Errorf("error saving recovery code: %w", err)
+	}
+	return nil
+}
+
+func (r *recoveryCodeRepositoryImpl) DeleteForUser(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+)
+
+type unitOfWorkImpl struct {
+	db *gorm.DB
+}
+
+func NewUnitOfWork(db *gorm.DB) repositories.UnitOfWork {
+	return &unitOfWorkImpl{db: db}
+}
+
+func (u *unitOfWorkImpl) Do(ctx context.Context, fn func(ctx context.Context, repos repositories.Repositories) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, txRepositories{tx: tx})
+	})
+}
+
+type txRepositories struct {
+	tx *gorm.DB
+}
+
+func (r txRepositories) Events() repositories.IEventRepository {
+	return NewEventRepository(r.tx, mappers.EventMapper{})
+}
+
+func (r txRepositories) Users() repositories.UserRepository {
+	return NewUserRepository(r.tx, mappers.UserMapper{})
+}
+
+func (r txRepositories) Auths() repositories.AuthRepository {
+	return NewAuthRepository(r.tx, mappers.AuthMapper{})
+}
+
+func (r txRepositories) UserTokens() repositories.UserTokenRepository {
+	return NewUserTokenRepository(r.tx, mappers.UserTokenMapper{})
+}
+
+func (r txRepositories) TwoFactors() repositories.TwoFactorRepository {
+	return NewTwoFactorRepository(r.tx, mappers.TwoFactorMapper{})
+}
+
+func (r txRepositories) RecoveryCodes() repositories.RecoveryCodeRepository {
+	return NewRecoveryCodeRepository(r.tx, mappers.RecoveryCodeMapper{})
+}
+
+func (r txRepositories) LoginThrottles() repositories.LoginThrottleRepository {
+	return NewLoginThrottleRepository(r.tx, mappers.LoginThrottleMapper{})
+}
+
+func (r txRepositories) AuditLog() repositories.AuditLogRepository {
+	return NewAuditLogRepository(r.tx, mappers.AuditEntryMapper{})
+}
+
+func (r txRepositories) ExternalIdentities() repositories.ExternalIdentityRepository {
+	return NewExternalIdentityRepository(r.tx, mappers.ExternalIdentityMapper{})
+}
+
+func (r txRepositories) APIKeys() repositories.APIKeyRepository {
+	return NewAPIKeyRepository(r.tx, mappers.APIKeyMapper{})
+}
+
+func (r txRepositories) Sessions() repositories.SessionRepository {
+	return NewSessionRepository(r.tx, mappers.SessionMapper{})
+}
+
+func (r txRepositories) EventInvites() repositories.EventInviteRepository {
+	return NewEventInviteRepository(r.tx, mappers.EventInviteMapper{})
+}
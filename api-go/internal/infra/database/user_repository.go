@@ -1,6 +1,11 @@
 package database
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
@@ -17,14 +22,14 @@ func NewUserRepository(db *gorm.DB, mp mappers.UserMapper) repositories.UserRepo
 	return &userRepositoryImpl{db: db, mapper: mp}
 }
 
-func (r *userRepositoryImpl) Create(user models.User) error {
+func (r *userRepositoryImpl) Create(ctx context.Context, user models.User) error {
 	entity := r.mapper.DomainToModel(user)
-	return r.db.Create(entity).Error
+	return r.db.WithContext(ctx).Create(entity).Error
 }
 
-func (r *userRepositoryImpl) FindAll() ([]models.User, error) {
+func (r *userRepositoryImpl) FindAll(ctx context.Context) ([]models.User, error) {
 	var entities []entities.User
-	if err := r.db.Find(&entities).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&entities).Error; err != nil {
 		return nil, err
 	}
 	var users []models.User
@@ -34,20 +39,88 @@ func (r *userRepositoryImpl) FindAll() ([]models.User, error) {
 	return users, nil
 }
 
-func (r *userRepositoryImpl) FindByEmail(email string) (models.User, error) {
+func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (models.User, error) {
 	var entity entities.User
-	if err := r.db.Where("email = ?", email).First(&entity).Error; err != nil {
-		return models.NewUser(models.UserProps{}), err
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.NewUser(models.UserProps{}), exceptions.NewNotFoundException("user not found")
+		}
+		return models.NewUser(models.UserProps{}), fmt.Errorf("error retrieving user by email: %w", err)
 	}
 	user := r.mapper.ModelToDomain(&entity)
 	return user, nil
 }
 
-func (r *userRepositoryImpl) FindById(id string) (models.User, error) {
+func (r *userRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entities.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *userRepositoryImpl) FindById(ctx context.Context, id string) (models.User, error) {
 	var entity entities.User
-	if err := r.db.Where("id = ?", id).First(&entity).Error; err != nil {
-		return models.NewUser(models.UserProps{}), err
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.NewUser(models.UserProps{}), exceptions.NewNotFoundException(fmt.Sprintf("user with ID %s not found", id))
+		}
+		return models.NewUser(models.UserProps{}), fmt.Errorf("error retrieving user with ID %s: %w", id, err)
 	}
 	user := r.mapper.ModelToDomain(&entity)
 	return user, nil
 }
+
+func (r *userRepositoryImpl) FindByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	if len(ids) == 0 {
+		return []models.User{}, nil
+	}
+
+	var rows []entities.User
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving users by ID: %w", err)
+	}
+
+	users := make([]models.User, 0, len(rows))
+	for i := range rows {
+		users = append(users, r.mapper.ModelToDomain(&rows[i]))
+	}
+	return users, nil
+}
+
+func (r *userRepositoryImpl) Save(ctx context.Context, user models.User) error {
+	entity := r.mapper.DomainToModel(user)
+	if err := r.db.WithContext(ctx).Save(entity).Error; err != nil {
+		return fmt.Errorf("error saving user %s: %w", user.GetID(), err)
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) Search(ctx context.Context, filter repositories.UserFilter) ([]models.User, error) {
+	query := r.db.WithContext(ctx).Order("created_at DESC, id DESC").Limit(filter.Limit)
+	if filter.Term != "" {
+		query = query.Where("name ILIKE ? OR email ILIKE ?", "%"+filter.Term+"%", "%"+filter.Term+"%")
+	}
+	if filter.UserType != "" {
+		query = query.Where("user_type = ?", filter.UserType)
+	}
+	switch filter.Status {
+	case repositories.UserStatusActive:
+		query = query.Where("deleted_at IS NULL AND suspended_at IS NULL")
+	case repositories.UserStatusSuspended:
+		query = query.Where("deleted_at IS NULL AND suspended_at IS NOT NULL")
+	case repositories.UserStatusDeleted:
+		query = query.Where("deleted_at IS NOT NULL")
+	}
+
+	var rows []entities.User
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("error searching users: %w", err)
+	}
+
+	users := make([]models.User, 0, len(rows))
+	for i := range rows {
+		users = append(users, r.mapper.ModelToDomain(&rows[i]))
+	}
+	return users, nil
+}
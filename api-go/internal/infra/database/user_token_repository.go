@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userTokenRepositoryImpl struct {
+	db     *gorm.DB
+	mapper mappers.UserTokenMapper
+}
+
+func NewUserTokenRepository(db *gorm.DB, mapper mappers.UserTokenMapper) repositories.UserTokenRepository {
+	return &userTokenRepositoryImpl{db: db, mapper: mapper}
+}
+
+func (r *userTokenRepositoryImpl) Create(ctx context.Context, token models.UserToken) error {
+	if err := r.db.WithContext(ctx).Create(r.mapper.DomainToModel(token)).Error; err != nil {
+		return fmt.Errorf("error creating user token: %w", err)
+	}
+	return nil
+}
+
+func (r *userTokenRepositoryImpl) FindByHashForUpdate(ctx context.Context, purpose, hash string) (models.UserToken, error) {
+	var entity entities.UserToken
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("purpose = ? AND token_hash = ?", purpose, hash).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("token not found")
+		}
+		return nil, fmt.Errorf("error retrieving user token: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *userTokenRepositoryImpl) FindLatestForUser(ctx context.Context, userID, purpose string) (models.UserToken, error) {
+	var entity entities.UserToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND purpose = ?", userID, purpose).
+		Order("created_at DESC").
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundException("token not found")
+		}
+		return nil, fmt.Errorf("error retrieving user token: %w", err)
+	}
+
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *userTokenRepositoryImpl) Save(ctx context.Context, token models.UserToken) error {
+	if err := r.db.WithContext(ctx).Save(r.mapper.DomainToModel(token)).Error; err != nil {
+		return fmt.Errorf("error saving user token %s: %w", token.GetID(), err)
+	}
+	return nil
+}
+
+func (r *userTokenRepositoryImpl) RevokeForUser(ctx context.Context, userID, purpose string, at time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&entities.UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", at).Error
+	if err != nil {
+		return fmt.Errorf("error revoking user tokens: %w", err)
+	}
+	return nil
+}
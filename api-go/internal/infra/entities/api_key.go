@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type APIKey struct {
+	ID         string            `gorm:"primaryKey"`
+	UserID     string            `gorm:"not null"`
+	Name       string            `gorm:"not null;type:varchar(100)"`
+	Prefix     string            `gorm:"not null;type:varchar(16)"`
+	KeyHash    string            `gorm:"not null;unique;type:varchar(64)"`
+	Scopes     utils.StringArray `gorm:"not null;type:jsonb"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `gorm:"not null"`
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type AuditEntry struct {
+	ID         string `gorm:"primaryKey"`
+	ActorID    *string
+	Action     string          `gorm:"not null;type:varchar(100)"`
+	TargetType string          `gorm:"not null;type:varchar(50)"`
+	TargetID   string          `gorm:"not null;type:varchar(255)"`
+	IP         string          `gorm:"not null;type:varchar(64)"`
+	RequestID  string          `gorm:"not null;type:varchar(128)"`
+	Details    utils.StringMap `gorm:"type:jsonb"`
+	Changes    AuditChanges    `gorm:"type:jsonb"`
+	CreatedAt  time.Time       `gorm:"not null"`
+}
+
+func (AuditEntry) TableName() string { return "audit_log" }
+
+type AuditChange struct {
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+// AuditChanges stores the changed fields of an audit entry in a jsonb
+// column.
+type AuditChanges map[string]AuditChange
+
+func (m *AuditChanges) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("cannot scan %T into audit changes", value)
+	}
+}
+
+func (m AuditChanges) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(m)
+}
@@ -3,8 +3,9 @@ package entities
 import "time"
 
 type Auth struct {
-	ID        string    `gorm:"primaryKey"`
-	Email     string
-	Password  string
-	CreatedAt time.Time
+	UserID            string `gorm:"primaryKey"`
+	PasswordHash      string `gorm:"not null;type:varchar(255);default:''"`
+	Algorithm         string `gorm:"not null;type:varchar(20);default:''"`
+	PasswordChangedAt *time.Time
+	CreatedAt         time.Time `gorm:"not null"`
 }
@@ -1,6 +1,9 @@
 package entities
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/Gabriel-Schiestl/api-go/internal/utils"
@@ -14,7 +17,44 @@ type Event struct {
 	Description string `gorm:"type:text"`
 	OrganizerID string `gorm:"not null;type:varchar(255)"`
 	Attendees   utils.StringArray `gorm:"type:json"`
+	AttendeeRegistrations AttendeeRegistrations `gorm:"type:json"`
 	CreatedAt   time.Time `gorm:"autoCreateTime;not null"`
 	Category	string `gorm:"not null;type:varchar(255)"`
 	Limit       int `gorm:"not null;default:0"`
-}
\ No newline at end of file
+	UnpublishedAt *time.Time
+	TicketTypes utils.StringArray `gorm:"type:json"`
+	Questions   utils.StringArray `gorm:"type:json"`
+}
+
+type AttendeeRegistration struct {
+	RegisteredAt time.Time         `json:"registered_at,omitzero"`
+	TicketType   string            `json:"ticket_type,omitempty"`
+	Answers      map[string]string `json:"answers,omitempty"`
+	CheckedInAt  *time.Time        `json:"checked_in_at,omitempty"`
+}
+
+// AttendeeRegistrations stores the registrations of an event, keyed by
+// attendee, in a json column.
+type AttendeeRegistrations map[string]AttendeeRegistration
+
+func (r *AttendeeRegistrations) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("falha ao converter valor para bytes: %v", value)
+	}
+}
+
+func (r AttendeeRegistrations) Value() (driver.Value, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(r)
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type EventImport struct {
+	ID          string            `gorm:"primaryKey"`
+	OrganizerID string            `gorm:"not null"`
+	Format      string            `gorm:"not null;type:varchar(10)"`
+	Mode        string            `gorm:"not null;type:varchar(20)"`
+	DryRun      bool              `gorm:"not null"`
+	Status      string            `gorm:"not null;type:varchar(20)"`
+	Total       int               `gorm:"not null"`
+	Processed   int               `gorm:"not null"`
+	Imported    int               `gorm:"not null"`
+	Errors      EventImportErrors `gorm:"type:jsonb"`
+	Failure     string            `gorm:"not null;type:varchar(500)"`
+	CreatedAt   time.Time         `gorm:"not null"`
+	FinishedAt  *time.Time
+}
+
+type EventImportError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// EventImportErrors stores the rejected rows of an import in a jsonb column.
+type EventImportErrors []EventImportError
+
+func (e *EventImportErrors) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return fmt.Errorf("cannot scan %T into import errors", value)
+	}
+}
+
+func (e EventImportErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(e)
+}
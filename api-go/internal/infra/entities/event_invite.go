@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type EventInvite struct {
+	ID         string    `gorm:"primaryKey"`
+	EventID    string    `gorm:"not null"`
+	Email      string    `gorm:"not null;type:varchar(255)"`
+	InvitedBy  string    `gorm:"not null"`
+	OverLimit  bool      `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"not null"`
+	AcceptedAt *time.Time
+}
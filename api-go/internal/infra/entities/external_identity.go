@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type ExternalIdentity struct {
+	Provider  string    `gorm:"primaryKey;type:varchar(50)"`
+	Subject   string    `gorm:"primaryKey;type:varchar(255)"`
+	UserID    string    `gorm:"not null"`
+	Email     string    `gorm:"not null;type:varchar(255)"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+func (ExternalIdentity) TableName() string { return "user_identities" }
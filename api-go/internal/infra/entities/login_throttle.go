@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+type LoginThrottle struct {
+	Scope         string    `gorm:"primaryKey;type:varchar(20)"`
+	Key           string    `gorm:"primaryKey;type:varchar(255)"`
+	Failures      int       `gorm:"not null;default:0"`
+	LastFailureAt time.Time `gorm:"not null"`
+	LockedUntil   *time.Time
+}
@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+type Session struct {
+	ID         string    `gorm:"primaryKey"`
+	UserID     string    `gorm:"not null"`
+	IP         string    `gorm:"not null;type:varchar(45)"`
+	UserAgent  string    `gorm:"not null;type:varchar(512)"`
+	Device     string    `gorm:"not null;type:varchar(100)"`
+	CreatedAt  time.Time `gorm:"not null"`
+	LastSeenAt time.Time `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	RevokedAt  *time.Time
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+type TwoFactor struct {
+	UserID       string `gorm:"primaryKey"`
+	Secret       string `gorm:"not null"`
+	ConfirmedAt  *time.Time
+	LastUsedStep int64     `gorm:"not null;default:0"`
+	CreatedAt    time.Time `gorm:"not null"`
+}
+
+func (TwoFactor) TableName() string { return "user_two_factors" }
+
+type RecoveryCode struct {
+	ID        string `gorm:"primaryKey"`
+	UserID    string `gorm:"not null"`
+	CodeHash  string `gorm:"not null;type:varchar(64)"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null"`
+}
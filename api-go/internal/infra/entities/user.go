@@ -3,10 +3,18 @@ package entities
 import "time"
 
 type User struct {
-	ID        string    `gorm:"primaryKey"`
-	Name      string    `gorm:"not null;type:varchar(255)"`
-	Email     string    `gorm:"not null;unique;type:varchar(255)"`
-	Password  string    `gorm:"not null;type:varchar(255)"`
-	UserType  string    `gorm:"not null;type:varchar(50);default:'participant'"`
-	CreatedAt time.Time `gorm:"autoCreateTime;not null"`
+	ID               string    `gorm:"primaryKey"`
+	Name             string    `gorm:"not null;type:varchar(255)"`
+	Email            string    `gorm:"not null;unique;type:varchar(255)"`
+	UserType         string    `gorm:"not null;type:varchar(50);default:'participant'"`
+	CreatedAt        time.Time `gorm:"autoCreateTime;not null"`
+	EmailVerifiedAt  *time.Time
+	PendingEmail     string `gorm:"not null;type:varchar(255);default:''"`
+	AvatarURL        string `gorm:"not null;type:varchar(500);default:''"`
+	Bio              string `gorm:"not null;type:varchar(500);default:''"`
+	Phone            string `gorm:"not null;type:varchar(20);default:''"`
+	Company          string `gorm:"not null;type:varchar(255);default:''"`
+	DeletedAt        *time.Time
+	SuspendedAt      *time.Time
+	SuspensionReason string `gorm:"not null;type:varchar(500);default:''"`
 }
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type UserToken struct {
+	ID        string    `gorm:"primaryKey"`
+	UserID    string    `gorm:"not null"`
+	Purpose   string    `gorm:"not null;type:varchar(50)"`
+	TokenHash string    `gorm:"not null;unique;type:varchar(64)"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null"`
+}
@@ -0,0 +1,39 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+type APIKeyMapper struct{}
+
+func (m APIKeyMapper) DomainToModel(key models.APIKey) *entities.APIKey {
+	return &entities.APIKey{
+		ID:         key.GetID(),
+		UserID:     key.GetUserID(),
+		Name:       key.GetName(),
+		Prefix:     key.GetPrefix(),
+		KeyHash:    key.GetKeyHash(),
+		Scopes:     utils.StringArray(key.GetScopes()),
+		ExpiresAt:  key.GetExpiresAt(),
+		LastUsedAt: key.GetLastUsedAt(),
+		RevokedAt:  key.GetRevokedAt(),
+		CreatedAt:  key.GetCreatedAt(),
+	}
+}
+
+func (m APIKeyMapper) ModelToDomain(entity *entities.APIKey) (models.APIKey, error) {
+	return models.NewAPIKey(models.APIKeyProps{
+		ID:         &entity.ID,
+		UserID:     &entity.UserID,
+		Name:       &entity.Name,
+		Prefix:     &entity.Prefix,
+		KeyHash:    &entity.KeyHash,
+		Scopes:     entity.Scopes,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  entity.RevokedAt,
+		CreatedAt:  &entity.CreatedAt,
+	})
+}
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type AuditEntryMapper struct{}
+
+func (m AuditEntryMapper) DomainToModel(entry models.AuditEntry) *entities.AuditEntry {
+	var changes entities.AuditChanges
+	if entry.GetChanges() != nil {
+		changes = entities.AuditChanges{}
+		for field, change := range entry.GetChanges() {
+			changes[field] = entities.AuditChange{Before: change.Before, After: change.After}
+		}
+	}
+
+	return &entities.AuditEntry{
+		ID:         entry.GetID(),
+		ActorID:    entry.GetActorID(),
+		Action:     entry.GetAction(),
+		TargetType: entry.GetTargetType(),
+		TargetID:   entry.GetTargetID(),
+		IP:         entry.GetIP(),
+		RequestID:  entry.GetRequestID(),
+		Details:    entry.GetDetails(),
+		Changes:    changes,
+		CreatedAt:  entry.GetCreatedAt(),
+	}
+}
+
+func (m AuditEntryMapper) ModelToDomain(entity *entities.AuditEntry) (models.AuditEntry, error) {
+	var changes map[string]models.AuditChange
+	if entity.Changes != nil {
+		changes = map[string]models.AuditChange{}
+		for field, change := range entity.Changes {
+			changes[field] = models.AuditChange{Before: change.Before, After: change.After}
+		}
+	}
+
+	return models.NewAuditEntry(models.AuditEntryProps{
+		ID:         &entity.ID,
+		ActorID:    entity.ActorID,
+		Action:     &entity.Action,
+		TargetType: &entity.TargetType,
+		TargetID:   &entity.TargetID,
+		IP:         &entity.IP,
+		RequestID:  &entity.RequestID,
+		Details:    entity.Details,
+		Changes:    changes,
+		CreatedAt:  &entity.CreatedAt,
+	})
+}
@@ -9,18 +9,20 @@ type AuthMapper struct{}
 
 func (m AuthMapper) DomainToModel(auth models.Auth) *entities.Auth {
 	return &entities.Auth{
-		ID:        auth.GetID(),
-		Email:     auth.GetEmail(),
-		Password:  auth.GetPassword(),
-		CreatedAt: auth.GetCreatedAt(),
+		UserID:            auth.GetUserID(),
+		PasswordHash:      auth.GetPasswordHash(),
+		Algorithm:         auth.GetAlgorithm(),
+		PasswordChangedAt: auth.GetPasswordChangedAt(),
+		CreatedAt:         auth.GetCreatedAt(),
 	}
 }
 
-func (m AuthMapper) ModelToDomain(entity *entities.Auth) models.Auth {
+func (m AuthMapper) ModelToDomain(entity *entities.Auth) (models.Auth, error) {
 	return models.NewAuth(models.AuthProps{
-		ID:        &entity.ID,
-		Email:     &entity.Email,
-		Password:  &entity.Password,
-		CreatedAt: &entity.CreatedAt,
+		UserID:            &entity.UserID,
+		PasswordHash:      &entity.PasswordHash,
+		Algorithm:         &entity.Algorithm,
+		PasswordChangedAt: entity.PasswordChangedAt,
+		CreatedAt:         &entity.CreatedAt,
 	})
 }
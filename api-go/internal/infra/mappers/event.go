@@ -16,9 +16,13 @@ func (m EventMapper) DomainToModel(event models.Event) entities.Event {
 		Description: event.Description(),
 		OrganizerID: event.OrganizerID(),
 		Attendees:   event.Attendees(),
+		AttendeeRegistrations: registrationsToModel(event.Registrations()),
 		CreatedAt:   event.CreatedAt(),
 		Category:    event.Category(),
 		Limit: 	 event.Limit(),
+		UnpublishedAt: event.UnpublishedAt(),
+		TicketTypes: event.TicketTypes(),
+		Questions:   event.Questions(),
 	}
 }
 
@@ -31,13 +35,51 @@ func (m EventMapper) ModelToDomain(event entities.Event) (models.Event, error) {
 		Description: &event.Description,
 		OrganizerID: &event.OrganizerID,
 		Attendees:   event.Attendees,
+		Registrations: registrationsToDomain(event.AttendeeRegistrations),
 		CreatedAt:   &event.CreatedAt,
 		Category:    &event.Category,
 		Limit:       &event.Limit,
+		UnpublishedAt: event.UnpublishedAt,
+		TicketTypes: event.TicketTypes,
+		Questions:   event.Questions,
 	})
 	if err != nil {
 		return nil, err
 	}
 	
 	return domainEvent, nil
-}
\ No newline at end of file
+}
+
+func registrationsToModel(registrations map[string]models.AttendeeRegistration) entities.AttendeeRegistrations {
+	if registrations == nil {
+		return nil
+	}
+
+	result := make(entities.AttendeeRegistrations, len(registrations))
+	for attendee, r := range registrations {
+		result[attendee] = entities.AttendeeRegistration{
+			RegisteredAt: r.RegisteredAt,
+			TicketType:   r.TicketType,
+			Answers:      r.Answers,
+			CheckedInAt:  r.CheckedInAt,
+		}
+	}
+	return result
+}
+
+func registrationsToDomain(registrations entities.AttendeeRegistrations) map[string]models.AttendeeRegistration {
+	if registrations == nil {
+		return nil
+	}
+
+	result := make(map[string]models.AttendeeRegistration, len(registrations))
+	for attendee, r := range registrations {
+		result[attendee] = models.AttendeeRegistration{
+			RegisteredAt: r.RegisteredAt,
+			TicketType:   r.TicketType,
+			Answers:      r.Answers,
+			CheckedInAt:  r.CheckedInAt,
+		}
+	}
+	return result
+}
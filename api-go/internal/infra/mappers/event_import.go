@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type EventImportMapper struct{}
+
+func (m EventImportMapper) DomainToModel(eventImport models.EventImport) *entities.EventImport {
+	var errs entities.EventImportErrors
+	for _, rowErr := range eventImport.GetErrors() {
+		errs = append(errs, entities.EventImportError{Row: rowErr.Row, Message: rowErr.Message})
+	}
+
+	return &entities.EventImport{
+		ID:          eventImport.GetID(),
+		OrganizerID: eventImport.GetOrganizerID(),
+		Format:      eventImport.GetFormat(),
+		Mode:        eventImport.GetMode(),
+		DryRun:      eventImport.IsDryRun(),
+		Status:      eventImport.GetStatus(),
+		Total:       eventImport.GetTotal(),
+		Processed:   eventImport.GetProcessed(),
+		Imported:    eventImport.GetImported(),
+		Errors:      errs,
+		Failure:     eventImport.GetFailure(),
+		CreatedAt:   eventImport.GetCreatedAt(),
+		FinishedAt:  eventImport.GetFinishedAt(),
+	}
+}
+
+func (m EventImportMapper) ModelToDomain(entity *entities.EventImport) (models.EventImport, error) {
+	var errs []models.EventImportRowError
+	for _, rowErr := range entity.Errors {
+		errs = append(errs, models.EventImportRowError{Row: rowErr.Row, Message: rowErr.Message})
+	}
+
+	return models.NewEventImport(models.EventImportProps{
+		ID:          &entity.ID,
+		OrganizerID: &entity.OrganizerID,
+		Format:      &entity.Format,
+		Mode:        &entity.Mode,
+		DryRun:      entity.DryRun,
+		Status:      &entity.Status,
+		Total:       entity.Total,
+		Processed:   entity.Processed,
+		Imported:    entity.Imported,
+		Errors:      errs,
+		Failure:     &entity.Failure,
+		CreatedAt:   &entity.CreatedAt,
+		FinishedAt:  entity.FinishedAt,
+	})
+}
@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type EventInviteMapper struct{}
+
+func (m EventInviteMapper) DomainToModel(invite models.EventInvite) *entities.EventInvite {
+	return &entities.EventInvite{
+		ID:         invite.GetID(),
+		EventID:    invite.GetEventID(),
+		Email:      invite.GetEmail(),
+		InvitedBy:  invite.GetInvitedBy(),
+		OverLimit:  invite.IsOverLimit(),
+		CreatedAt:  invite.GetCreatedAt(),
+		AcceptedAt: invite.GetAcceptedAt(),
+	}
+}
+
+func (m EventInviteMapper) ModelToDomain(entity *entities.EventInvite) (models.EventInvite, error) {
+	return models.NewEventInvite(models.EventInviteProps{
+		ID:         &entity.ID,
+		EventID:    &entity.EventID,
+		Email:      &entity.Email,
+		InvitedBy:  &entity.InvitedBy,
+		OverLimit:  entity.OverLimit,
+		CreatedAt:  &entity.CreatedAt,
+		AcceptedAt: entity.AcceptedAt,
+	})
+}
@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type ExternalIdentityMapper struct{}
+
+func (m ExternalIdentityMapper) DomainToModel(identity models.ExternalIdentity) *entities.ExternalIdentity {
+	return &entities.ExternalIdentity{
+		Provider:  identity.GetProvider(),
+		Subject:   identity.GetSubject(),
+		UserID:    identity.GetUserID(),
+		Email:     identity.GetEmail(),
+		CreatedAt: identity.GetCreatedAt(),
+	}
+}
+
+func (m ExternalIdentityMapper) ModelToDomain(entity *entities.ExternalIdentity) (models.ExternalIdentity, error) {
+	return models.NewExternalIdentity(models.ExternalIdentityProps{
+		Provider:  &entity.Provider,
+		Subject:   &entity.Subject,
+		UserID:    &entity.UserID,
+		Email:     &entity.Email,
+		CreatedAt: &entity.CreatedAt,
+	})
+}
@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type LoginThrottleMapper struct{}
+
+func (m LoginThrottleMapper) DomainToModel(throttle models.LoginThrottle) *entities.LoginThrottle {
+	return &entities.LoginThrottle{
+		Scope:         throttle.GetScope(),
+		Key:           throttle.GetKey(),
+		Failures:      throttle.GetFailures(),
+		LastFailureAt: throttle.GetLastFailureAt(),
+		LockedUntil:   throttle.GetLockedUntil(),
+	}
+}
+
+func (m LoginThrottleMapper) ModelToDomain(entity *entities.LoginThrottle) (models.LoginThrottle, error) {
+	return models.NewLoginThrottle(models.LoginThrottleProps{
+		Scope:         &entity.Scope,
+		Key:           &entity.Key,
+		Failures:      &entity.Failures,
+		LastFailureAt: &entity.LastFailureAt,
+		LockedUntil:   entity.LockedUntil,
+	})
+}
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type SessionMapper struct{}
+
+func (m SessionMapper) DomainToModel(session models.Session) *entities.Session {
+	return &entities.Session{
+		ID:         session.GetID(),
+		UserID:     session.GetUserID(),
+		IP:         session.GetIP(),
+		UserAgent:  session.GetUserAgent(),
+		Device:     session.GetDevice(),
+		CreatedAt:  session.GetCreatedAt(),
+		LastSeenAt: session.GetLastSeenAt(),
+		ExpiresAt:  session.GetExpiresAt(),
+		RevokedAt:  session.GetRevokedAt(),
+	}
+}
+
+func (m SessionMapper) ModelToDomain(entity *entities.Session) (models.Session, error) {
+	return models.NewSession(models.SessionProps{
+		ID:         &entity.ID,
+		UserID:     &entity.UserID,
+		IP:         &entity.IP,
+		UserAgent:  &entity.UserAgent,
+		Device:     &entity.Device,
+		CreatedAt:  &entity.CreatedAt,
+		LastSeenAt: &entity.LastSeenAt,
+		ExpiresAt:  &entity.ExpiresAt,
+		RevokedAt:  entity.RevokedAt,
+	})
+}
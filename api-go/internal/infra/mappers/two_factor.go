@@ -0,0 +1,50 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type TwoFactorMapper struct{}
+
+func (m TwoFactorMapper) DomainToModel(twoFactor models.TwoFactor) *entities.TwoFactor {
+	return &entities.TwoFactor{
+		UserID:       twoFactor.GetUserID(),
+		Secret:       twoFactor.GetSecret(),
+		ConfirmedAt:  twoFactor.GetConfirmedAt(),
+		LastUsedStep: twoFactor.GetLastUsedStep(),
+		CreatedAt:    twoFactor.GetCreatedAt(),
+	}
+}
+
+func (m TwoFactorMapper) ModelToDomain(entity *entities.TwoFactor) (models.TwoFactor, error) {
+	return models.NewTwoFactor(models.TwoFactorProps{
+		UserID:       &entity.UserID,
+		Secret:       &entity.Secret,
+		ConfirmedAt:  entity.ConfirmedAt,
+		LastUsedStep: &entity.LastUsedStep,
+		CreatedAt:    &entity.CreatedAt,
+	})
+}
+
+type RecoveryCodeMapper struct{}
+
+func (m RecoveryCodeMapper) DomainToModel(code models.RecoveryCode) *entities.RecoveryCode {
+	return &entities.RecoveryCode{
+		ID:        code.GetID(),
+		UserID:    code.GetUserID(),
+		CodeHash:  code.GetCodeHash(),
+		UsedAt:    code.GetUsedAt(),
+		CreatedAt: code.GetCreatedAt(),
+	}
+}
+
+func (m RecoveryCodeMapper) ModelToDomain(entity *entities.RecoveryCode) (models.RecoveryCode, error) {
+	return models.NewRecoveryCode(models.RecoveryCodeProps{
+		ID:        &entity.ID,
+		UserID:    &entity.UserID,
+		CodeHash:  &entity.CodeHash,
+		UsedAt:    entity.UsedAt,
+		CreatedAt: &entity.CreatedAt,
+	})
+}
@@ -7,23 +7,48 @@ import (
 
 type UserMapper struct{}
 func (m UserMapper) DomainToModel(user models.User) *entities.User {
-	return &entities.User{
+	profile := user.GetProfile()
+	entity := &entities.User{
 		ID:        user.GetID(),
 		Name:      user.GetName(),
 		Email:     user.GetEmail(),
-		Password:  user.GetPassword(),
 		UserType:  user.GetUserType(),
 		CreatedAt: user.GetCreatedAt(),
+		EmailVerifiedAt: user.GetEmailVerifiedAt(),
+		PendingEmail:    user.GetPendingEmail(),
+		AvatarURL:       profile.AvatarURL,
+		Bio:             profile.Bio,
+		Phone:           profile.Phone,
+		Company:         profile.Company,
+		DeletedAt:       user.GetDeletedAt(),
 	}
+	if suspension := user.GetSuspension(); suspension != nil {
+		entity.SuspendedAt = &suspension.At
+		entity.SuspensionReason = suspension.Reason
+	}
+	return entity
 }
 
 func (m UserMapper) ModelToDomain(entity *entities.User) models.User {
+	var suspension *models.UserSuspension
+	if entity.SuspendedAt != nil {
+		suspension = &models.UserSuspension{At: *entity.SuspendedAt, Reason: entity.SuspensionReason}
+	}
 	return models.NewUser(models.UserProps{
 		ID:        &entity.ID,
 		Name:      &entity.Name,
 		Email:     &entity.Email,
-		Password:  &entity.Password,
 		UserType:  &entity.UserType,
 		CreatedAt: &entity.CreatedAt,
+		EmailVerifiedAt: entity.EmailVerifiedAt,
+		PendingEmail:    &entity.PendingEmail,
+		Profile: &models.UserProfile{
+			AvatarURL: entity.AvatarURL,
+			Bio:       entity.Bio,
+			Phone:     entity.Phone,
+			Company:   entity.Company,
+		},
+		DeletedAt:  entity.DeletedAt,
+		Suspension: suspension,
 	})
 }
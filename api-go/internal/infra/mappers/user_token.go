@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+)
+
+type UserTokenMapper struct{}
+
+func (m UserTokenMapper) DomainToModel(token models.UserToken) *entities.UserToken {
+	return &entities.UserToken{
+		ID:        token.GetID(),
+		UserID:    token.GetUserID(),
+		Purpose:   token.GetPurpose(),
+		TokenHash: token.GetTokenHash(),
+		ExpiresAt: token.GetExpiresAt(),
+		UsedAt:    token.GetUsedAt(),
+		CreatedAt: token.GetCreatedAt(),
+	}
+}
+
+func (m UserTokenMapper) ModelToDomain(entity *entities.UserToken) (models.UserToken, error) {
+	return models.NewUserToken(models.UserTokenProps{
+		ID:        &entity.ID,
+		UserID:    &entity.UserID,
+		Purpose:   &entity.Purpose,
+		TokenHash: &entity.TokenHash,
+		ExpiresAt: &entity.ExpiresAt,
+		UsedAt:    entity.UsedAt,
+		CreatedAt: &entity.CreatedAt,
+	})
+}
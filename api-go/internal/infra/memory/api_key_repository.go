@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.APIKeyRepository = (*APIKeyRepository)(nil)
+
+// APIKeyRepository is a thread-safe in-memory repositories.APIKeyRepository.
+type APIKeyRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.APIKeyMapper
+	keys   map[string]entities.APIKey
+}
+
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{keys: map[string]entities.APIKey{}}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key models.APIKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(key)
+	if _, ok := r.keys[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"api_keys_pkey\"")
+	}
+	for _, existing := range r.keys {
+		if existing.KeyHash == entity.KeyHash {
+			return fmt.Errorf("duplicate key value violates unique constraint \"api_keys_key_hash_key\"")
+		}
+	}
+
+	r.keys[entity.ID] = *entity
+	return nil
+}
+
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.keys[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("API key not found")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entity := range r.keys {
+		if entity.KeyHash == hash {
+			return r.mapper.ModelToDomain(&entity)
+		}
+	}
+	return nil, exceptions.NewNotFoundException("API key not found")
+}
+
+func (r *APIKeyRepository) FindByUserID(ctx context.Context, userID string) ([]models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.APIKey
+	for _, entity := range r.keys {
+		if entity.UserID == userID {
+			rows = append(rows, entity)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CreatedAt.After(rows[j].CreatedAt) })
+
+	keys := make([]models.APIKey, 0, len(rows))
+	for i := range rows {
+		key, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Save(ctx context.Context, key models.APIKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(key)
+	r.keys[entity.ID] = *entity
+	return nil
+}
+
+func (r *APIKeyRepository) RevokeForUser(ctx context.Context, userID string, now time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, entity := range r.keys {
+		if entity.UserID == userID && entity.RevokedAt == nil {
+			revokedAt := now
+			entity.RevokedAt = &revokedAt
+			r.keys[id] = entity
+		}
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) snapshot() map[string]entities.APIKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.keys)
+}
+
+func (r *APIKeyRepository) restore(keys map[string]entities.APIKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys = keys
+}
@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.AuditLogRepository = (*AuditLogRepository)(nil)
+
+// AuditLogRepository is a thread-safe in-memory
+// repositories.AuditLogRepository that keeps entries in append order, so
+// Find lists the most recently appended first.
+type AuditLogRepository struct {
+	mu      sync.RWMutex
+	mapper  mappers.AuditEntryMapper
+	entries []entities.AuditEntry
+}
+
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{}
+}
+
+func (r *AuditLogRepository) Append(ctx context.Context, entry models.AuditEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, *r.mapper.DomainToModel(entry))
+	return nil
+}
+
+func (r *AuditLogRepository) Find(ctx context.Context, filter repositories.AuditLogFilter) ([]models.AuditEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.AuditEntry
+	for i := len(r.entries) - 1; i >= 0 && len(rows) < filter.Limit; i-- {
+		entity := r.entries[i]
+		if matchesAuditFilter(entity, filter) {
+			rows = append(rows, entity)
+		}
+	}
+
+	entries := make([]models.AuditEntry, 0, len(rows))
+	for i := range rows {
+		entry, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func matchesAuditFilter(entity entities.AuditEntry, filter repositories.AuditLogFilter) bool {
+	switch {
+	case filter.ActorID != "" && (entity.ActorID == nil || *entity.ActorID != filter.ActorID):
+		return false
+	case filter.TargetType != "" && entity.TargetType != filter.TargetType:
+		return false
+	case filter.TargetID != "" && entity.TargetID != filter.TargetID:
+		return false
+	case filter.Action != "" && entity.Action != filter.Action:
+		return false
+	case filter.From != nil && entity.CreatedAt.Before(*filter.From):
+		return false
+	case filter.To != nil && !entity.CreatedAt.Before(*filter.To):
+		return false
+	}
+	return true
+}
+
+// All returns every entry in append order, for assertions in tests.
+func (r *AuditLogRepository) All() []models.AuditEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []models.AuditEntry
+	for _, entity := range r.entries {
+		entry, err := r.mapper.ModelToDomain(&entity)
+		if err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (r *AuditLogRepository) snapshot() []entities.AuditEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]entities.AuditEntry(nil), r.entries...)
+}
+
+func (r *AuditLogRepository) restore(entries []entities.AuditEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = entries
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.AuthRepository = (*AuthRepository)(nil)
+
+// AuthRepository is a thread-safe in-memory repositories.AuthRepository
+// keyed by user ID.
+type AuthRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.AuthMapper
+	auths  map[string]entities.Auth
+}
+
+func NewAuthRepository() *AuthRepository {
+	return &AuthRepository{auths: map[string]entities.Auth{}}
+}
+
+func (r *AuthRepository) Create(ctx context.Context, auth models.Auth) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(auth)
+	if _, ok := r.auths[entity.UserID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"auths_pkey\"")
+	}
+	r.auths[entity.UserID] = *entity
+	return nil
+}
+
+func (r *AuthRepository) FindByUserID(ctx context.Context, userID string) (models.Auth, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.auths[userID]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("credentials not found")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *AuthRepository) Save(ctx context.Context, auth models.Auth) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(auth)
+	r.auths[entity.UserID] = *entity
+	return nil
+}
+
+func (r *AuthRepository) snapshot() map[string]entities.Auth {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.auths)
+}
+
+func (r *AuthRepository) restore(auths map[string]entities.Auth) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.auths = auths
+}
@@ -0,0 +1,10 @@
+package memory
+
+// copyMap returns a shallow copy of m, used to snapshot repositories.
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	copied := make(map[K]V, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
+}
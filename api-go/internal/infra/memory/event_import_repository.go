@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.EventImportRepository = (*EventImportRepository)(nil)
+
+// EventImportRepository is a thread-safe in-memory
+// repositories.EventImportRepository. Like the imports stored in the
+// database, it stays out of the units of work.
+type EventImportRepository struct {
+	mu      sync.RWMutex
+	mapper  mappers.EventImportMapper
+	imports map[string]entities.EventImport
+}
+
+func NewEventImportRepository() *EventImportRepository {
+	return &EventImportRepository{imports: map[string]entities.EventImport{}}
+}
+
+func (r *EventImportRepository) Create(ctx context.Context, eventImport models.EventImport) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(eventImport)
+	if _, ok := r.imports[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"event_imports_pkey\"")
+	}
+
+	r.imports[entity.ID] = *entity
+	return nil
+}
+
+func (r *EventImportRepository) FindByID(ctx context.Context, id string) (models.EventImport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.imports[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Import not found")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *EventImportRepository) FindUnfinished(ctx context.Context) ([]models.EventImport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.EventImport
+	for _, entity := range r.imports {
+		if entity.Status == models.EventImportPending || entity.Status == models.EventImportRunning {
+			rows = append(rows, entity)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CreatedAt.Before(rows[j].CreatedAt) })
+
+	imports := make([]models.EventImport, 0, len(rows))
+	for i := range rows {
+		eventImport, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, eventImport)
+	}
+	return imports, nil
+}
+
+// Save replaces the stored import, or inserts it like gorm's Save when the
+// ID is unknown.
+func (r *EventImportRepository) Save(ctx context.Context, eventImport models.EventImport) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(eventImport)
+	r.imports[entity.ID] = *entity
+	return nil
+}
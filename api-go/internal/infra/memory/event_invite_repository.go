@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.EventInviteRepository = (*EventInviteRepository)(nil)
+
+// EventInviteRepository is a thread-safe in-memory
+// repositories.EventInviteRepository. Like the unique index of the
+// database, it rejects a second pending invite for the same event and
+// address.
+type EventInviteRepository struct {
+	mu      sync.RWMutex
+	mapper  mappers.EventInviteMapper
+	invites map[string]entities.EventInvite
+}
+
+func NewEventInviteRepository() *EventInviteRepository {
+	return &EventInviteRepository{invites: map[string]entities.EventInvite{}}
+}
+
+func (r *EventInviteRepository) Create(ctx context.Context, invite models.EventInvite) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(invite)
+	if _, ok := r.invites[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"event_invites_pkey\"")
+	}
+	for _, existing := range r.invites {
+		if existing.AcceptedAt == nil && existing.EventID == entity.EventID && existing.Email == entity.Email {
+			return fmt.Errorf("duplicate key value violates unique constraint \"idx_event_invites_pending\"")
+		}
+	}
+
+	r.invites[entity.ID] = *entity
+	return nil
+}
+
+func (r *EventInviteRepository) FindByID(ctx context.Context, id string) (models.EventInvite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.invites[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Invite not found")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *EventInviteRepository) FindPendingByEvent(ctx context.Context, eventID string) ([]models.EventInvite, error) {
+	return r.findPending(ctx, func(e entities.EventInvite) bool { return e.EventID == eventID })
+}
+
+func (r *EventInviteRepository) FindPendingByEmail(ctx context.Context, email string) ([]models.EventInvite, error) {
+	email = models.NormalizeInviteEmail(email)
+	return r.findPending(ctx, func(e entities.EventInvite) bool { return e.Email == email })
+}
+
+func (r *EventInviteRepository) findPending(ctx context.Context, match func(entities.EventInvite) bool) ([]models.EventInvite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.EventInvite
+	for _, entity := range r.invites {
+		if entity.AcceptedAt == nil && match(entity) {
+			rows = append(rows, entity)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].CreatedAt.Equal(rows[j].CreatedAt) {
+			return rows[i].CreatedAt.Before(rows[j].CreatedAt)
+		}
+		return rows[i].ID < rows[j].ID
+	})
+
+	invites := make([]models.EventInvite, 0, len(rows))
+	for i := range rows {
+		invite, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
+
+func (r *EventInviteRepository) Save(ctx context.Context, invite models.EventInvite) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(invite)
+	r.invites[entity.ID] = *entity
+	return nil
+}
+
+func (r *EventInviteRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.invites, id)
+	return nil
+}
+
+func (r *EventInviteRepository) snapshot() map[string]entities.EventInvite {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.invites)
+}
+
+func (r *EventInviteRepository) restore(invites map[string]entities.EventInvite) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.invites = invites
+}
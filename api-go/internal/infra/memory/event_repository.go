@@ -0,0 +1,229 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.IEventRepository = (*EventRepository)(nil)
+
+// EventRepository is a thread-safe in-memory repositories.IEventRepository
+// returning the same errors as the gorm implementation.
+type EventRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.EventMapper
+	events map[string]entities.Event
+	order  []string
+}
+
+func NewEventRepository() *EventRepository {
+	return &EventRepository{events: map[string]entities.Event{}}
+}
+
+func (r *EventRepository) FindByID(ctx context.Context, id string) (models.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	event, ok := r.events[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("event with ID %s not found", id))
+	}
+
+	return r.mapper.ModelToDomain(copyEvent(event))
+}
+
+func (r *EventRepository) FindByIDForUpdate(ctx context.Context, id string) (models.Event, error) {
+	return r.FindByID(ctx, id)
+}
+
+func (r *EventRepository) FindAll(ctx context.Context) ([]models.Event, error) {
+	events, err := r.filter(ctx, func(e entities.Event) bool { return e.UnpublishedAt == nil })
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, exceptions.NewNotFoundException("no events found")
+	}
+	return events, nil
+}
+
+func (r *EventRepository) FindByAttendee(ctx context.Context, userID string) ([]models.Event, error) {
+	events, err := r.filter(ctx, func(e entities.Event) bool {
+		for _, attendee := range e.Attendees {
+			if attendee == userID {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("no events found for user ID %s", userID))
+	}
+	return events, nil
+}
+
+func (r *EventRepository) FindByOrganizerID(ctx context.Context, organizerID string) ([]models.Event, error) {
+	events, err := r.filter(ctx, func(e entities.Event) bool { return e.OrganizerID == organizerID })
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("no events found for organizer ID %s", organizerID))
+	}
+	return events, nil
+}
+
+func (r *EventRepository) FindEventByOrganizerID(ctx context.Context, eventID, organizerID string) (models.Event, error) {
+	event, err := r.FindByID(ctx, eventID)
+	if err != nil || event.OrganizerID() != organizerID {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("Event with ID %s not found for organizer ID %s", eventID, organizerID))
+	}
+	return event, nil
+}
+
+func (r *EventRepository) FindByCategory(ctx context.Context, category string) ([]models.Event, error) {
+	events, err := r.filter(ctx, func(e entities.Event) bool {
+		return e.UnpublishedAt == nil && e.Category == category
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("No events found for category %s", category))
+	}
+	return events, nil
+}
+
+// FindByTerm matches like the SQL LIKE used by the gorm implementation, so
+// it is case-sensitive.
+func (r *EventRepository) FindByTerm(ctx context.Context, term string) ([]models.Event, error) {
+	events, err := r.filter(ctx, func(e entities.Event) bool {
+		return e.UnpublishedAt == nil && (strings.Contains(e.Name, term) || strings.Contains(e.Description, term))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, exceptions.NewNotFoundException(fmt.Sprintf("No events found for term %s", term))
+	}
+	return events, nil
+}
+
+func (r *EventRepository) Save(ctx context.Context, event models.Event) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := copyEvent(r.mapper.DomainToModel(event))
+	if _, ok := r.events[entity.ID]; !ok {
+		r.order = append(r.order, entity.ID)
+	}
+	r.events[entity.ID] = entity
+
+	return nil
+}
+
+func (r *EventRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.events[id]; !ok {
+		return exceptions.NewNotFoundException(fmt.Sprintf("Event with ID %s not found", id))
+	}
+
+	delete(r.events, id)
+	for i, eventID := range r.order {
+		if eventID == id {
+			r.order = append(r.order[:i:i], r.order[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *EventRepository) filter(ctx context.Context, match func(entities.Event) bool) ([]models.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []models.Event
+	for _, id := range r.order {
+		entity := r.events[id]
+		if !match(entity) {
+			continue
+		}
+
+		event, err := r.mapper.ModelToDomain(copyEvent(entity))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+type eventSnapshot struct {
+	events map[string]entities.Event
+	order  []string
+}
+
+func (r *EventRepository) snapshot() eventSnapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make(map[string]entities.Event, len(r.events))
+	for id, event := range r.events {
+		events[id] = copyEvent(event)
+	}
+	return eventSnapshot{events: events, order: append([]string(nil), r.order...)}
+}
+
+func (r *EventRepository) restore(s eventSnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = s.events
+	r.order = s.order
+}
+
+// copyEvent detaches the attendee slice so stored rows never share memory
+// with domain objects handed to callers.
+func copyEvent(event entities.Event) entities.Event {
+	if event.Attendees != nil {
+		event.Attendees = append([]string(nil), event.Attendees...)
+	}
+	if event.AttendeeRegistrations != nil {
+		event.AttendeeRegistrations = copyMap(event.AttendeeRegistrations)
+	}
+	return event
+}
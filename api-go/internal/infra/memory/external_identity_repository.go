@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.ExternalIdentityRepository = (*ExternalIdentityRepository)(nil)
+
+type identityKey struct {
+	provider, subject string
+}
+
+// ExternalIdentityRepository is a thread-safe in-memory
+// repositories.ExternalIdentityRepository.
+type ExternalIdentityRepository struct {
+	mu         sync.RWMutex
+	mapper     mappers.ExternalIdentityMapper
+	identities map[identityKey]entities.ExternalIdentity
+}
+
+func NewExternalIdentityRepository() *ExternalIdentityRepository {
+	return &ExternalIdentityRepository{identities: map[identityKey]entities.ExternalIdentity{}}
+}
+
+func (r *ExternalIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (models.ExternalIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.identities[identityKey{provider, subject}]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("identity not linked")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *ExternalIdentityRepository) FindByUserID(ctx context.Context, userID string) ([]models.ExternalIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.ExternalIdentity
+	for _, entity := range r.identities {
+		if entity.UserID == userID {
+			rows = append(rows, entity)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CreatedAt.Before(rows[j].CreatedAt) })
+
+	identities := make([]models.ExternalIdentity, 0, len(rows))
+	for i := range rows {
+		identity, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, nil
+}
+
+func (r *ExternalIdentityRepository) Create(ctx context.Context, identity models.ExternalIdentity) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := identityKey{identity.GetProvider(), identity.GetSubject()}
+	if _, ok := r.identities[key]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"user_identities_pkey\"")
+	}
+	r.identities[key] = *r.mapper.DomainToModel(identity)
+	return nil
+}
+
+func (r *ExternalIdentityRepository) DeleteForUser(ctx context.Context, userID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, entity := range r.identities {
+		if entity.UserID == userID {
+			delete(r.identities, key)
+		}
+	}
+	return nil
+}
+
+func (r *ExternalIdentityRepository) snapshot() map[identityKey]entities.ExternalIdentity {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.identities)
+}
+
+func (r *ExternalIdentityRepository) restore(identities map[identityKey]entities.ExternalIdentity) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.identities = identities
+}
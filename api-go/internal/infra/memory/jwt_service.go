@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+const (
+	fakeTokenPrefix          = "fake-token:"
+	fakeChallengeTokenPrefix = "fake-challenge:"
+)
+
+var _ services.IJWTService = (*JWTService)(nil)
+
+// JWTService is a fake services.IJWTService whose tokens are simply
+// "fake-token:<userID>:<sessionID>", and "fake-challenge:<userID>" for
+// two-factor challenges. Set Err to make token generation fail.
+type JWTService struct {
+	Err error
+}
+
+func NewJWTService() *JWTService {
+	return &JWTService{}
+}
+
+func (s *JWTService) GenerateToken(userID, sessionID string) (*string, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	token := fakeTokenPrefix + userID + ":" + sessionID
+	return &token, nil
+}
+
+func (s *JWTService) ExtractClaims(token string) (map[string]interface{}, error) {
+	subject, ok := strings.CutPrefix(token, fakeTokenPrefix)
+	userID, sessionID, _ := strings.Cut(subject, ":")
+	if !ok || userID == "" {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return map[string]interface{}{"sub": userID, "sid": sessionID}, nil
+}
+
+func (s *JWTService) GenerateChallengeToken(userID string) (*string, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	token := fakeChallengeTokenPrefix + userID
+	return &token, nil
+}
+
+func (s *JWTService) ExtractChallengeSubject(token string) (string, error) {
+	userID, ok := strings.CutPrefix(token, fakeChallengeTokenPrefix)
+	if !ok || userID == "" {
+		return "", fmt.Errorf("invalid challenge token")
+	}
+
+	return userID, nil
+}
+
+// PublicKeys is empty, as fake tokens are not signed.
+func (s *JWTService) PublicKeys() services.JSONWebKeySet {
+	return services.JSONWebKeySet{Keys: []services.JSONWebKey{}}
+}
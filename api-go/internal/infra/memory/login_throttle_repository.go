@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.LoginThrottleRepository = (*LoginThrottleRepository)(nil)
+
+type throttleKey struct {
+	scope, key string
+}
+
+// LoginThrottleRepository is a thread-safe in-memory
+// repositories.LoginThrottleRepository.
+type LoginThrottleRepository struct {
+	mu        sync.RWMutex
+	mapper    mappers.LoginThrottleMapper
+	throttles map[throttleKey]entities.LoginThrottle
+}
+
+func NewLoginThrottleRepository() *LoginThrottleRepository {
+	return &LoginThrottleRepository{throttles: map[throttleKey]entities.LoginThrottle{}}
+}
+
+func (r *LoginThrottleRepository) Find(ctx context.Context, scope, key string) (models.LoginThrottle, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.throttles[throttleKey{scope, key}]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("no failed login recorded")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *LoginThrottleRepository) FindForUpdate(ctx context.Context, scope, key string) (models.LoginThrottle, error) {
+	return r.Find(ctx, scope, key)
+}
+
+func (r *LoginThrottleRepository) Save(ctx context.Context, throttle models.LoginThrottle) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.throttles[throttleKey{throttle.GetScope(), throttle.GetKey()}] = *r.mapper.DomainToModel(throttle)
+	return nil
+}
+
+func (r *LoginThrottleRepository) Delete(ctx context.Context, scope, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.throttles, throttleKey{scope, key})
+	return nil
+}
+
+func (r *LoginThrottleRepository) snapshot() map[throttleKey]entities.LoginThrottle {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.throttles)
+}
+
+func (r *LoginThrottleRepository) restore(throttles map[throttleKey]entities.LoginThrottle) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.throttles = throttles
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+var _ services.IMailer = (*Mailer)(nil)
+
+// Mailer records every mail instead of sending it. Set Err to make Send fail.
+type Mailer struct {
+	mu   sync.Mutex
+	sent []services.Mail
+	Err  error
+}
+
+func NewMailer() *Mailer {
+	return &Mailer{}
+}
+
+func (m *Mailer) Send(ctx context.Context, mail services.Mail) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.Err != nil {
+		return m.Err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+// Sent returns a copy of the mails sent so far.
+func (m *Mailer) Sent() []services.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]services.Mail(nil), m.sent...)
+}
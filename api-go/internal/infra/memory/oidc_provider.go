@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+var _ services.IOIDCProvider = (*OIDCProvider)(nil)
+
+// OIDCProvider is an identity provider that redeems the codes registered
+// with Authorize, provided the matching PKCE verifier is sent.
+type OIDCProvider struct {
+	mu     sync.Mutex
+	grants map[string]oidcGrant
+}
+
+type oidcGrant struct {
+	claims   services.OIDCClaims
+	verifier string
+}
+
+func NewOIDCProvider() *OIDCProvider {
+	return &OIDCProvider{grants: make(map[string]oidcGrant)}
+}
+
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	query := url.Values{"state": {state}, "nonce": {nonce}}
+	return "https://idp.example.com/authorize?" + query.Encode(), nil
+}
+
+// Authorize makes code redeemable once, with codeVerifier, for claims.
+func (p *OIDCProvider) Authorize(code, codeVerifier string, claims services.OIDCClaims) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.grants[code] = oidcGrant{claims: claims, verifier: codeVerifier}
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier string) (*services.OIDCClaims, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	grant, ok := p.grants[code]
+	if !ok || grant.verifier != codeVerifier {
+		return nil, errors.New("invalid_grant")
+	}
+	delete(p.grants, code)
+
+	claims := grant.claims
+	return &claims, nil
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+var _ services.IPasswordHasher = (*PasswordHasher)(nil)
+
+// PasswordHasher is a fast fake services.IPasswordHasher whose hashes are
+// the SHA-256 of the password, tagged with Algorithm. Changing Algorithm
+// makes the hashes computed before it need a rehash.
+type PasswordHasher struct {
+	Algorithm string
+}
+
+func NewPasswordHasher() *PasswordHasher {
+	return &PasswordHasher{Algorithm: "fake"}
+}
+
+func (h *PasswordHasher) Hash(password string) (string, string, error) {
+	return fakeHash(h.Algorithm, password), h.Algorithm, nil
+}
+
+func (h *PasswordHasher) Verify(password, hash, algorithm string) bool {
+	return hash != "" && hash == fakeHash(algorithm, password)
+}
+
+func (h *PasswordHasher) NeedsRehash(hash, algorithm string) bool {
+	return algorithm != h.Algorithm
+}
+
+func fakeHash(algorithm, password string) string {
+	sum := sha256.Sum256([]byte(algorithm + ":" + password))
+	return algorithm + "$" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.SessionRepository = (*SessionRepository)(nil)
+
+// SessionRepository is a thread-safe in-memory repositories.SessionRepository.
+type SessionRepository struct {
+	mu       sync.RWMutex
+	mapper   mappers.SessionMapper
+	sessions map[string]entities.Session
+}
+
+func NewSessionRepository() *SessionRepository {
+	return &SessionRepository{sessions: map[string]entities.Session{}}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, session models.Session) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(session)
+	if _, ok := r.sessions[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"sessions_pkey\"")
+	}
+
+	r.sessions[entity.ID] = *entity
+	return nil
+}
+
+func (r *SessionRepository) FindByID(ctx context.Context, id string) (models.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.sessions[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Session not found")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *SessionRepository) FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]models.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rows []entities.Session
+	for _, entity := range r.sessions {
+		if entity.UserID == userID && entity.RevokedAt == nil && entity.ExpiresAt.After(now) {
+			rows = append(rows, entity)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].LastSeenAt.After(rows[j].LastSeenAt) })
+
+	sessions := make([]models.Session, 0, len(rows))
+	for i := range rows {
+		session, err := r.mapper.ModelToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (r *SessionRepository) Save(ctx context.Context, session models.Session) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(session)
+	r.sessions[entity.ID] = *entity
+	return nil
+}
+
+func (r *SessionRepository) RevokeForUser(ctx context.Context, userID string, now time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, entity := range r.sessions {
+		if entity.UserID == userID && entity.RevokedAt == nil {
+			revokedAt := now
+			entity.RevokedAt = &revokedAt
+			r.sessions[id] = entity
+		}
+	}
+	return nil
+}
+
+func (r *SessionRepository) snapshot() map[string]entities.Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.sessions)
+}
+
+func (r *SessionRepository) restore(sessions map[string]entities.Session) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions = sessions
+}
@@ -0,0 +1,182 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var (
+	_ repositories.TwoFactorRepository    = (*TwoFactorRepository)(nil)
+	_ repositories.RecoveryCodeRepository = (*RecoveryCodeRepository)(nil)
+)
+
+// TwoFactorRepository is a thread-safe in-memory
+// repositories.TwoFactorRepository keyed by user ID.
+type TwoFactorRepository struct {
+	mu         sync.RWMutex
+	mapper     mappers.TwoFactorMapper
+	twoFactors map[string]entities.TwoFactor
+}
+
+func NewTwoFactorRepository() *TwoFactorRepository {
+	return &TwoFactorRepository{twoFactors: map[string]entities.TwoFactor{}}
+}
+
+func (r *TwoFactorRepository) FindByUserID(ctx context.Context, userID string) (models.TwoFactor, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entity, ok := r.twoFactors[userID]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("two-factor authentication is not set up")
+	}
+	return r.mapper.ModelToDomain(&entity)
+}
+
+func (r *TwoFactorRepository) FindByUserIDForUpdate(ctx context.Context, userID string) (models.TwoFactor, error) {
+	return r.FindByUserID(ctx, userID)
+}
+
+func (r *TwoFactorRepository) Save(ctx context.Context, twoFactor models.TwoFactor) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.twoFactors[twoFactor.GetUserID()] = *r.mapper.DomainToModel(twoFactor)
+	return nil
+}
+
+func (r *TwoFactorRepository) Delete(ctx context.Context, userID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.twoFactors, userID)
+	return nil
+}
+
+func (r *TwoFactorRepository) snapshot() map[string]entities.TwoFactor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.twoFactors)
+}
+
+func (r *TwoFactorRepository) restore(twoFactors map[string]entities.TwoFactor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.twoFactors = twoFactors
+}
+
+// RecoveryCodeRepository is a thread-safe in-memory
+// repositories.RecoveryCodeRepository.
+type RecoveryCodeRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.RecoveryCodeMapper
+	codes  map[string]entities.RecoveryCode
+}
+
+func NewRecoveryCodeRepository() *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{codes: map[string]entities.RecoveryCode{}}
+}
+
+func (r *RecoveryCodeRepository) ReplaceForUser(ctx context.Context, userID string, codes []models.RecoveryCode) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deleteForUser(userID)
+	for _, code := range codes {
+		entity := r.mapper.DomainToModel(code)
+		r.codes[entity.ID] = *entity
+	}
+	return nil
+}
+
+func (r *RecoveryCodeRepository) FindUnusedByUser(ctx context.Context, userID string) ([]models.RecoveryCode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codes := []models.RecoveryCode{}
+	for _, entity := range r.codes {
+		if entity.UserID != userID || entity.UsedAt != nil {
+			continue
+		}
+		code, err := r.mapper.ModelToDomain(&entity)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func (r *RecoveryCodeRepository) Save(ctx context.Context, code models.RecoveryCode) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.codes[code.GetID()] = *r.mapper.DomainToModel(code)
+	return nil
+}
+
+func (r *RecoveryCodeRepository) DeleteForUser(ctx context.Context, userID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deleteForUser(userID)
+	return nil
+}
+
+func (r *RecoveryCodeRepository) deleteForUser(userID string) {
+	for id, entity := range r.codes {
+		if entity.UserID == userID {
+			delete(r.codes, id)
+		}
+	}
+}
+
+func (r *RecoveryCodeRepository) snapshot() map[string]entities.RecoveryCode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.codes)
+}
+
+func (r *RecoveryCodeRepository) restore(codes map[string]entities.RecoveryCode) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.codes = codes
+}
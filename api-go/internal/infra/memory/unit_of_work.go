@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+)
+
+var _ repositories.UnitOfWork = (*UnitOfWork)(nil)
+
+// Stores groups the in-memory repositories a UnitOfWork coordinates.
+type Stores struct {
+	Events         *EventRepository
+	Users          *UserRepository
+	Auths          *AuthRepository
+	UserTokens     *UserTokenRepository
+	TwoFactors     *TwoFactorRepository
+	RecoveryCodes  *RecoveryCodeRepository
+	LoginThrottles *LoginThrottleRepository
+	AuditLog       *AuditLogRepository
+	Identities     *ExternalIdentityRepository
+	APIKeys        *APIKeyRepository
+	Sessions       *SessionRepository
+	EventInvites   *EventInviteRepository
+}
+
+// NewStores returns a set of empty repositories.
+func NewStores() Stores {
+	return Stores{
+		Events:         NewEventRepository(),
+		Users:          NewUserRepository(),
+		Auths:          NewAuthRepository(),
+		UserTokens:     NewUserTokenRepository(),
+		TwoFactors:     NewTwoFactorRepository(),
+		RecoveryCodes:  NewRecoveryCodeRepository(),
+		LoginThrottles: NewLoginThrottleRepository(),
+		AuditLog:       NewAuditLogRepository(),
+		Identities:     NewExternalIdentityRepository(),
+		APIKeys:        NewAPIKeyRepository(),
+		Sessions:       NewSessionRepository(),
+		EventInvites:   NewEventInviteRepository(),
+	}
+}
+
+// UnitOfWork serializes units of work over the in-memory repositories and
+// restores their previous contents when fn fails or panics, mimicking a
+// transaction rollback.
+type UnitOfWork struct {
+	mu     sync.Mutex
+	stores Stores
+}
+
+func NewUnitOfWork(stores Stores) *UnitOfWork {
+	return &UnitOfWork{stores: stores}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos repositories.Repositories) error) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	rollback := u.snapshot()
+	defer func() {
+		if r := recover(); r != nil {
+			rollback()
+			panic(r)
+		}
+		if err != nil {
+			rollback()
+		}
+	}()
+
+	return fn(ctx, u)
+}
+
+// snapshot copies every repository and returns a func restoring the copies.
+func (u *UnitOfWork) snapshot() func() {
+	s := u.stores
+	events, users, auths := s.Events.snapshot(), s.Users.snapshot(), s.Auths.snapshot()
+	userTokens, twoFactors, recoveryCodes := s.UserTokens.snapshot(), s.TwoFactors.snapshot(), s.RecoveryCodes.snapshot()
+	loginThrottles, auditLog, identities := s.LoginThrottles.snapshot(), s.AuditLog.snapshot(), s.Identities.snapshot()
+	apiKeys, sessions, eventInvites := s.APIKeys.snapshot(), s.Sessions.snapshot(), s.EventInvites.snapshot()
+
+	return func() {
+		s.Events.restore(events)
+		s.Users.restore(users)
+		s.Auths.restore(auths)
+		s.UserTokens.restore(userTokens)
+		s.TwoFactors.restore(twoFactors)
+		s.RecoveryCodes.restore(recoveryCodes)
+		s.LoginThrottles.restore(loginThrottles)
+		s.AuditLog.restore(auditLog)
+		s.Identities.restore(identities)
+		s.APIKeys.restore(apiKeys)
+		s.Sessions.restore(sessions)
+		s.EventInvites.restore(eventInvites)
+	}
+}
+
+func (u *UnitOfWork) Events() repositories.IEventRepository        { return u.stores.Events }
+func (u *UnitOfWork) Users() repositories.UserRepository           { return u.stores.Users }
+func (u *UnitOfWork) Auths() repositories.AuthRepository           { return u.stores.Auths }
+func (u *UnitOfWork) UserTokens() repositories.UserTokenRepository { return u.stores.UserTokens }
+func (u *UnitOfWork) TwoFactors() repositories.TwoFactorRepository { return u.stores.TwoFactors }
+func (u *UnitOfWork) RecoveryCodes() repositories.RecoveryCodeRepository {
+	return u.stores.RecoveryCodes
+}
+func (u *UnitOfWork) LoginThrottles() repositories.LoginThrottleRepository {
+	return u.stores.LoginThrottles
+}
+func (u *UnitOfWork) AuditLog() repositories.AuditLogRepository { return u.stores.AuditLog }
+func (u *UnitOfWork) ExternalIdentities() repositories.ExternalIdentityRepository {
+	return u.stores.Identities
+}
+func (u *UnitOfWork) APIKeys() repositories.APIKeyRepository   { return u.stores.APIKeys }
+func (u *UnitOfWork) Sessions() repositories.SessionRepository { return u.stores.Sessions }
+func (u *UnitOfWork) EventInvites() repositories.EventInviteRepository {
+	return u.stores.EventInvites
+}
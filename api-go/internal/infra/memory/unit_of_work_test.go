@@ -0,0 +1,97 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+)
+
+func newEvent(t *testing.T, name string) models.Event {
+	t.Helper()
+
+	location, description, organizer, category, limit := "Online", "", "organizer-1", "tech", 0
+	date := time.Now()
+	event, err := models.NewEvent(models.EventProps{
+		Name:        &name,
+		Description: &description,
+		Location:    &location,
+		Date:        &date,
+		OrganizerID: &organizer,
+		Category:    &category,
+		Limit:       &limit,
+	})
+	if err != nil {
+		t.Fatalf("creating event: %v", err)
+	}
+	return event
+}
+
+func TestUnitOfWorkRollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	stores := memory.NewStores()
+	events := stores.Events
+	uow := memory.NewUnitOfWork(stores)
+
+	kept := newEvent(t, "kept")
+	if err := events.Save(ctx, kept); err != nil {
+		t.Fatal(err)
+	}
+
+	boom := errors.New("boom")
+	err := uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		if err := repos.Events().Save(ctx, newEvent(t, "discarded")); err != nil {
+			return err
+		}
+		if err := repos.Events().Delete(ctx, kept.ID()); err != nil {
+			return err
+		}
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+
+	all, err := events.FindAll(ctx)
+	if err != nil || len(all) != 1 || all[0].ID() != kept.ID() {
+		t.Fatalf("expected only the original event after rollback, got %v (%v)", all, err)
+	}
+}
+
+func TestUnitOfWorkCommitsOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	stores := memory.NewStores()
+	events := stores.Events
+	uow := memory.NewUnitOfWork(stores)
+
+	event := newEvent(t, "committed")
+	err := uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		return repos.Events().Save(ctx, event)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := events.FindByID(ctx, event.ID()); err != nil {
+		t.Fatalf("event was not committed: %v", err)
+	}
+}
+
+func TestUnitOfWorkHonoursCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	uow := memory.NewUnitOfWork(memory.NewStores())
+	called := false
+	err := uow.Do(ctx, func(ctx context.Context, repos repositories.Repositories) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || called {
+		t.Fatalf("expected context.Canceled without running fn, got %v (called=%v)", err, called)
+	}
+}
@@ -0,0 +1,215 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.UserRepository = (*UserRepository)(nil)
+
+// UserRepository is a thread-safe in-memory repositories.UserRepository.
+// Like the gorm implementation it returns a NotFoundException (alongside an
+// empty user) for unknown IDs or emails and rejects duplicate emails.
+type UserRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.UserMapper
+	users  map[string]entities.User
+	order  []string
+}
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: map[string]entities.User{}}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user models.User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(user)
+	if _, ok := r.users[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"users_pkey\"")
+	}
+	for _, existing := range r.users {
+		if existing.Email == entity.Email {
+			return fmt.Errorf("duplicate key value violates unique constraint \"uni_users_email\"")
+		}
+	}
+
+	r.users[entity.ID] = *entity
+	r.order = append(r.order, entity.ID)
+
+	return nil
+}
+
+func (r *UserRepository) FindAll(ctx context.Context) ([]models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var users []models.User
+	for _, id := range r.order {
+		entity := r.users[id]
+		users = append(users, r.mapper.ModelToDomain(&entity))
+	}
+	return users, nil
+}
+
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (models.User, error) {
+	return r.find(ctx, "user not found", func(u entities.User) bool { return u.Email == email })
+}
+
+func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	_, err := r.FindByEmail(ctx, email)
+	var notFound *exceptions.NotFoundException
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *UserRepository) FindById(ctx context.Context, id string) (models.User, error) {
+	return r.find(ctx, fmt.Sprintf("user with ID %s not found", id), func(u entities.User) bool { return u.ID == id })
+}
+
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]models.User, 0, len(ids))
+	for _, id := range ids {
+		if entity, ok := r.users[id]; ok {
+			users = append(users, r.mapper.ModelToDomain(&entity))
+		}
+	}
+	return users, nil
+}
+
+// Save replaces the stored user, or inserts it like gorm's Save when the ID
+// is unknown.
+func (r *UserRepository) Save(ctx context.Context, user models.User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(user)
+	for id, existing := range r.users {
+		if id != entity.ID && existing.Email == entity.Email {
+			return fmt.Errorf("duplicate key value violates unique constraint \"uni_users_email\"")
+		}
+	}
+
+	if _, ok := r.users[entity.ID]; !ok {
+		r.order = append(r.order, entity.ID)
+	}
+	r.users[entity.ID] = *entity
+
+	return nil
+}
+
+// Search walks the users from the most recently created, like the gorm
+// implementation's ILIKE on names and emails.
+func (r *UserRepository) Search(ctx context.Context, filter repositories.UserFilter) ([]models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := []models.User{}
+	for i := len(r.order) - 1; i >= 0 && len(users) < filter.Limit; i-- {
+		entity := r.users[r.order[i]]
+		if matchesUserFilter(entity, filter) {
+			users = append(users, r.mapper.ModelToDomain(&entity))
+		}
+	}
+	return users, nil
+}
+
+func matchesUserFilter(entity entities.User, filter repositories.UserFilter) bool {
+	term := strings.ToLower(filter.Term)
+	switch {
+	case term != "" && !strings.Contains(strings.ToLower(entity.Name), term) && !strings.Contains(strings.ToLower(entity.Email), term):
+		return false
+	case filter.UserType != "" && entity.UserType != filter.UserType:
+		return false
+	}
+
+	switch filter.Status {
+	case repositories.UserStatusActive:
+		return entity.DeletedAt == nil && entity.SuspendedAt == nil
+	case repositories.UserStatusSuspended:
+		return entity.DeletedAt == nil && entity.SuspendedAt != nil
+	case repositories.UserStatusDeleted:
+		return entity.DeletedAt != nil
+	}
+	return true
+}
+
+func (r *UserRepository) find(ctx context.Context, notFound string, match func(entities.User) bool) (models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return models.NewUser(models.UserProps{}), err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, id := range r.order {
+		entity := r.users[id]
+		if match(entity) {
+			return r.mapper.ModelToDomain(&entity), nil
+		}
+	}
+
+	return models.NewUser(models.UserProps{}), exceptions.NewNotFoundException(notFound)
+}
+
+type userSnapshot struct {
+	users map[string]entities.User
+	order []string
+}
+
+func (r *UserRepository) snapshot() userSnapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make(map[string]entities.User, len(r.users))
+	for id, user := range r.users {
+		users[id] = user
+	}
+	return userSnapshot{users: users, order: append([]string(nil), r.order...)}
+}
+
+func (r *UserRepository) restore(s userSnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users = s.users
+	r.order = s.order
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/entities"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
+)
+
+var _ repositories.UserTokenRepository = (*UserTokenRepository)(nil)
+
+// UserTokenRepository is a thread-safe in-memory
+// repositories.UserTokenRepository. Row locks are not emulated; the
+// UnitOfWork already serializes transactions.
+type UserTokenRepository struct {
+	mu     sync.RWMutex
+	mapper mappers.UserTokenMapper
+	tokens map[string]entities.UserToken
+}
+
+func NewUserTokenRepository() *UserTokenRepository {
+	return &UserTokenRepository{tokens: map[string]entities.UserToken{}}
+}
+
+func (r *UserTokenRepository) Create(ctx context.Context, token models.UserToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(token)
+	if _, ok := r.tokens[entity.ID]; ok {
+		return fmt.Errorf("duplicate key value violates unique constraint \"user_tokens_pkey\"")
+	}
+	for _, existing := range r.tokens {
+		if existing.TokenHash == entity.TokenHash {
+			return fmt.Errorf("duplicate key value violates unique constraint \"user_tokens_token_hash_key\"")
+		}
+	}
+
+	r.tokens[entity.ID] = *entity
+	return nil
+}
+
+func (r *UserTokenRepository) FindByHashForUpdate(ctx context.Context, purpose, hash string) (models.UserToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entity := range r.tokens {
+		if entity.Purpose == purpose && entity.TokenHash == hash {
+			return r.mapper.ModelToDomain(&entity)
+		}
+	}
+
+	return nil, exceptions.NewNotFoundException("token not found")
+}
+
+func (r *UserTokenRepository) FindLatestForUser(ctx context.Context, userID, purpose string) (models.UserToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest *entities.UserToken
+	for _, entity := range r.tokens {
+		if entity.UserID == userID && entity.Purpose == purpose && (latest == nil || entity.CreatedAt.After(latest.CreatedAt)) {
+			latest = &entity
+		}
+	}
+	if latest == nil {
+		return nil, exceptions.NewNotFoundException("token not found")
+	}
+
+	return r.mapper.ModelToDomain(latest)
+}
+
+func (r *UserTokenRepository) Save(ctx context.Context, token models.UserToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity := r.mapper.DomainToModel(token)
+	r.tokens[entity.ID] = *entity
+	return nil
+}
+
+func (r *UserTokenRepository) RevokeForUser(ctx context.Context, userID, purpose string, at time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, entity := range r.tokens {
+		if entity.UserID == userID && entity.Purpose == purpose && entity.UsedAt == nil {
+			usedAt := at
+			entity.UsedAt = &usedAt
+			r.tokens[id] = entity
+		}
+	}
+	return nil
+}
+
+// All returns every stored token, for assertions in tests.
+func (r *UserTokenRepository) All() []models.UserToken {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tokens []models.UserToken
+	for _, entity := range r.tokens {
+		token, err := r.mapper.ModelToDomain(&entity)
+		if err == nil {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func (r *UserTokenRepository) snapshot() map[string]entities.UserToken {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.tokens)
+}
+
+func (r *UserTokenRepository) restore(tokens map[string]entities.UserToken) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens = tokens
+}
@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed breached_passwords.txt
+var defaultBreachedPasswords string
+
+// LoadBreachedPasswords reads the file at path, one password per line, into
+// a set of lowercased passwords. Blank lines and lines starting with # are
+// skipped. Without a path the list shipped with the API is used.
+func LoadBreachedPasswords(path string) (map[string]struct{}, error) {
+	if path == "" {
+		return readBreachedPasswords(strings.NewReader(defaultBreachedPasswords))
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening breached password list: %w", err)
+	}
+	defer file.Close()
+
+	passwords, err := readBreachedPasswords(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading breached password list %s: %w", path, err)
+	}
+	return passwords, nil
+}
+
+func readBreachedPasswords(r io.Reader) (map[string]struct{}, error) {
+	passwords := map[string]struct{}{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+	return passwords, scanner.Err()
+}
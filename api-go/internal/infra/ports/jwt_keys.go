@@ -0,0 +1,214 @@
+package ports
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSAKeyBits = 2048
+
+// SigningKey is a private key identified by the kid header of the tokens it
+// signs. It signs from ActiveFrom until the next key becomes active.
+type SigningKey struct {
+	ID         string
+	ActiveFrom time.Time
+	method     jwt.SigningMethod
+	private    crypto.Signer
+}
+
+// NewSigningKey accepts RSA keys of at least 2048 bits, used with RS256, and
+// Ed25519 keys, used with EdDSA.
+func NewSigningKey(id string, activeFrom time.Time, private crypto.Signer) (SigningKey, error) {
+	if id == "" {
+		return SigningKey{}, errors.New("signing key without kid")
+	}
+
+	key := SigningKey{ID: id, ActiveFrom: activeFrom, private: private}
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return SigningKey{}, fmt.Errorf("signing key %s: RSA keys need at least %d bits", id, minRSAKeyBits)
+		}
+		key.method = jwt.SigningMethodRS256
+	case ed25519.PrivateKey:
+		key.method = jwt.SigningMethodEdDSA
+	default:
+		return SigningKey{}, fmt.Errorf("signing key %s: unsupported key type %T", id, private)
+	}
+	return key, nil
+}
+
+// NewEphemeralSigningKey generates an Ed25519 key that lives as long as the
+// process, for development setups without a key manifest.
+func NewEphemeralSigningKey() (SigningKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("error generating signing key: %w", err)
+	}
+
+	var kid [9]byte
+	if _, err := rand.Read(kid[:]); err != nil {
+		return SigningKey{}, fmt.Errorf("error generating key ID: %w", err)
+	}
+	return NewSigningKey("ephemeral-"+base64.RawURLEncoding.EncodeToString(kid[:]), time.Time{}, private)
+}
+
+type keyManifest struct {
+	Keys []struct {
+		ID             string    `json:"kid"`
+		PrivateKeyFile string    `json:"private_key_file"`
+		ActiveFrom     time.Time `json:"active_from"`
+	} `json:"keys"`
+}
+
+// LoadSigningKeys reads a JSON manifest listing the keys, such as
+//
+//	{"keys": [{"kid": "2026-10", "private_key_file": "2026-10.pem", "active_from": "2026-10-01T00:00:00Z"}]}
+//
+// Key files hold a PEM encoded PKCS #8 (or PKCS #1 RSA) private key; relative
+// paths start at the manifest's directory.
+func LoadSigningKeys(path string) ([]SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key manifest: %w", err)
+	}
+
+	var manifest keyManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid key manifest %s: %w", path, err)
+	}
+	if len(manifest.Keys) == 0 {
+		return nil, fmt.Errorf("key manifest %s lists no keys", path)
+	}
+
+	keys := make([]SigningKey, 0, len(manifest.Keys))
+	for _, entry := range manifest.Keys {
+		file := entry.PrivateKeyFile
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(path), file)
+		}
+		private, err := readPrivateKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %s: %w", entry.ID, err)
+		}
+		key, err := NewSigningKey(entry.ID, entry.ActiveFrom, private)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func readPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not PEM encoded", path)
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key %s: %w", path, err)
+	}
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %s", path)
+	}
+	return signer, nil
+}
+
+// keyRing schedules the rotation of signing keys. A replaced key is still
+// accepted, and published, for overlap after its successor became active,
+// so the tokens it signed last can expire normally; keys not active yet are
+// published in advance so verifiers can cache them.
+type keyRing struct {
+	keys    []SigningKey
+	overlap time.Duration
+}
+
+func newKeyRing(keys []SigningKey, overlap time.Duration) (*keyRing, error) {
+	sorted := append([]SigningKey(nil), keys...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ActiveFrom.Before(sorted[j].ActiveFrom) })
+
+	seen := make(map[string]bool, len(sorted))
+	for _, key := range sorted {
+		if seen[key.ID] {
+			return nil, fmt.Errorf("duplicate signing key %s", key.ID)
+		}
+		seen[key.ID] = true
+	}
+	if len(sorted) == 0 {
+		return nil, errors.New("no signing keys")
+	}
+	return &keyRing{keys: sorted, overlap: overlap}, nil
+}
+
+// signing returns the key that signs at now: the last one already active.
+func (r *keyRing) signing(now time.Time) (SigningKey, error) {
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if !r.keys[i].ActiveFrom.After(now) {
+			return r.keys[i], nil
+		}
+	}
+	return SigningKey{}, errors.New("no signing key is active yet")
+}
+
+// published returns the keys tokens may still be verified with at now.
+func (r *keyRing) published(now time.Time) []SigningKey {
+	var keys []SigningKey
+	for i, key := range r.keys {
+		if i+1 < len(r.keys) && !r.keys[i+1].ActiveFrom.Add(r.overlap).After(now) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (r *keyRing) find(kid string, now time.Time) (SigningKey, bool) {
+	for _, key := range r.published(now) {
+		if key.ID == kid {
+			return key, true
+		}
+	}
+	return SigningKey{}, false
+}
+
+func (k SigningKey) publicJWK() services.JSONWebKey {
+	jwk := services.JSONWebKey{KeyID: k.ID, Algorithm: k.method.Alg(), Use: "sig"}
+	switch public := k.private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
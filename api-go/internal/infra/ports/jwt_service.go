@@ -2,49 +2,139 @@ package ports
 
 import (
 	"fmt"
-	"os"
 	"time"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
 	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	accessTokenTTL     = models.SessionTTL
+	challengeTokenType = "2fa_challenge"
+	challengeTokenTTL  = 5 * time.Minute
+)
+
+// JWTSettings are the issuer and audience written to, and required in,
+// every token, and the keys signing them.
+type JWTSettings struct {
+	Issuer   string
+	Audience string
+	Keys     []SigningKey
+}
+
 type jwtService struct {
-	secretKey []byte
+	issuer   string
+	audience string
+	keys     *keyRing
+	now      func() time.Time
 }
 
-func NewJWTService() services.IJWTService {
-	return &jwtService{
-		secretKey: []byte(os.Getenv("JWT_SECRET_KEY")),
-	}
+// NewJWTService keeps replaced keys valid for the lifetime of an access
+// token. At least one of the keys must already be active.
+func NewJWTService(settings JWTSettings) (services.IJWTService, error) {
+	return newJWTService(settings, time.Now)
 }
 
-func (s *jwtService) GenerateToken(userID string) (*string, error) {
-	claims := jwt.MapClaims{
-        "sub":  userID, 
-        "iat":  time.Now().Unix(),
-        "exp":  time.Now().Add(time.Hour * 24).Unix(),
-    }
+func newJWTService(settings JWTSettings, now func() time.Time) (*jwtService, error) {
+	if settings.Issuer == "" || settings.Audience == "" {
+		return nil, fmt.Errorf("JWT issuer and audience are required")
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	keys, err := newKeyRing(settings.Keys, accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := keys.signing(now()); err != nil {
+		return nil, err
+	}
 
-    tokenString, err := token.SignedString(s.secretKey)
-    if err != nil {
-        fmt.Println("Erro ao criar o token:", err)
-        return nil, fmt.Errorf("error creating token: %w", err)
-    }
+	return &jwtService{issuer: settings.Issuer, audience: settings.Audience, keys: keys, now: now}, nil
+}
 
+func (s *jwtService) GenerateToken(userID, sessionID string) (*string, error) {
+	tokenString, err := s.sign(jwt.MapClaims{"sub": userID, "sid": sessionID}, accessTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("error creating token: %w", err)
+	}
 	return &tokenString, nil
 }
 
 func (s *jwtService) ExtractClaims(token string) (map[string]interface{}, error) {
+	claims, err := s.parse(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims["typ"] == challengeTokenType {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return claims, nil
+}
+
+func (s *jwtService) GenerateChallengeToken(userID string) (*string, error) {
+	tokenString, err := s.sign(jwt.MapClaims{"sub": userID, "typ": challengeTokenType}, challengeTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("error creating challenge token: %w", err)
+	}
+	return &tokenString, nil
+}
+
+func (s *jwtService) ExtractChallengeSubject(token string) (string, error) {
+	claims, err := s.parse(token)
+	if err != nil {
+		return "", err
+	}
+
+	subject, _ := claims["sub"].(string)
+	if claims["typ"] != challengeTokenType || subject == "" {
+		return "", fmt.Errorf("invalid challenge token")
+	}
+	return subject, nil
+}
+
+func (s *jwtService) PublicKeys() services.JSONWebKeySet {
+	set := services.JSONWebKeySet{Keys: []services.JSONWebKey{}}
+	for _, key := range s.keys.published(s.now()) {
+		set.Keys = append(set.Keys, key.publicJWK())
+	}
+	return set
+}
+
+func (s *jwtService) sign(claims jwt.MapClaims, ttl time.Duration) (string, error) {
+	now := s.now()
+	key, err := s.keys.signing(now)
+	if err != nil {
+		return "", err
+	}
+
+	claims["iss"] = s.issuer
+	claims["aud"] = s.audience
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(ttl).Unix()
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.private)
+}
+
+func (s *jwtService) parse(token string) (jwt.MapClaims, error) {
 	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := s.keys.find(kid, s.now())
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return s.secretKey, nil
-	})
-
+		return key.private.Public(), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing token: %w", err)
 	}
@@ -55,4 +145,3 @@ func (s *jwtService) ExtractClaims(token string) (map[string]interface{}, error)
 
 	return nil, fmt.Errorf("invalid token")
 }
-
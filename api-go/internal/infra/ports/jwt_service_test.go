@@ -0,0 +1,256 @@
+package ports
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	rsaKeyOnce sync.Once
+	rsaKey     *rsa.PrivateKey
+)
+
+// testRSAKey shares one key across tests, as generating them is slow.
+func testRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	rsaKeyOnce.Do(func() {
+		rsaKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+	})
+	if rsaKey == nil {
+		t.Fatalf("generating RSA key failed")
+	}
+	return rsaKey
+}
+
+func testEd25519Key(t *testing.T, id string, activeFrom time.Time) SigningKey {
+	t.Helper()
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	key, err := NewSigningKey(id, activeFrom, private)
+	if err != nil {
+		t.Fatalf("NewSigningKey: %v", err)
+	}
+	return key
+}
+
+// clock is a settable time source.
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newTestJWTService(t *testing.T, c *clock, audience string, keys ...SigningKey) *jwtService {
+	t.Helper()
+
+	service, err := newJWTService(JWTSettings{Issuer: "https://api.example.com", Audience: audience, Keys: keys}, c.Now)
+	if err != nil {
+		t.Fatalf("newJWTService: %v", err)
+	}
+	return service
+}
+
+func TestJWTServiceRoundTrip(t *testing.T) {
+	rsaSigning, err := NewSigningKey("rsa", time.Time{}, testRSAKey(t))
+	if err != nil {
+		t.Fatalf("NewSigningKey: %v", err)
+	}
+	keys := map[string]SigningKey{
+		"RS256": rsaSigning,
+		"EdDSA": testEd25519Key(t, "ed", time.Time{}),
+	}
+
+	for alg, key := range keys {
+		t.Run(alg, func(t *testing.T) {
+			c := &clock{now: time.Now()}
+			service := newTestJWTService(t, c, "eventhub", key)
+
+			token, err := service.GenerateToken("user-1", "session-1")
+			if err != nil {
+				t.Fatalf("GenerateToken: %v", err)
+			}
+			parsed, _, err := jwt.NewParser().ParseUnverified(*token, jwt.MapClaims{})
+			if err != nil {
+				t.Fatalf("invalid token: %v", err)
+			}
+			if parsed.Header["alg"] != alg || parsed.Header["kid"] != key.ID {
+				t.Fatalf("unexpected header %v", parsed.Header)
+			}
+
+			claims, err := service.ExtractClaims(*token)
+			if err != nil {
+				t.Fatalf("ExtractClaims: %v", err)
+			}
+			if claims["sub"] != "user-1" || claims["sid"] != "session-1" || claims["iss"] != "https://api.example.com" || claims["aud"] != "eventhub" {
+				t.Fatalf("unexpected claims %v", claims)
+			}
+
+			if jwks := service.PublicKeys(); len(jwks.Keys) != 1 || jwks.Keys[0].KeyID != key.ID || jwks.Keys[0].Algorithm != alg {
+				t.Fatalf("unexpected key set %+v", jwks)
+			}
+		})
+	}
+}
+
+func TestJWTServiceRejectsForeignTokens(t *testing.T) {
+	c := &clock{now: time.Now()}
+	key := testEd25519Key(t, "k1", time.Time{})
+	service := newTestJWTService(t, c, "eventhub", key)
+
+	otherAudience, err := newTestJWTService(t, c, "another-api", key).GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if _, err := service.ExtractClaims(*otherAudience); err == nil {
+		t.Fatalf("expected a token for another audience to be refused")
+	}
+
+	unknownKey, err := newTestJWTService(t, c, "eventhub", testEd25519Key(t, "k1", time.Time{})).GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if _, err := service.ExtractClaims(*unknownKey); err == nil {
+		t.Fatalf("expected a token signed by another key with the same kid to be refused")
+	}
+
+	// HS256 signed with the public key must not pass for EdDSA.
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "user-1", "iss": "https://api.example.com", "aud": "eventhub", "exp": c.now.Add(time.Hour).Unix(),
+	})
+	hmac.Header["kid"] = "k1"
+	forged, err := hmac.SignedString([]byte(key.private.Public().(ed25519.PublicKey)))
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+	if _, err := service.ExtractClaims(forged); err == nil {
+		t.Fatalf("expected an HS256 token to be refused")
+	}
+
+	challenge, err := service.GenerateChallengeToken("user-1")
+	if err != nil {
+		t.Fatalf("GenerateChallengeToken: %v", err)
+	}
+	if _, err := service.ExtractClaims(*challenge); err == nil {
+		t.Fatalf("expected a challenge token to be refused as access token")
+	}
+}
+
+func TestJWTServiceRotatesKeys(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	rotation := start.Add(30 * 24 * time.Hour)
+	c := &clock{now: rotation.Add(-time.Hour)}
+	oldKey := testEd25519Key(t, "old", start)
+	newKey := testEd25519Key(t, "new", rotation)
+	service := newTestJWTService(t, c, "eventhub", newKey, oldKey)
+
+	kids := func() []string {
+		var ids []string
+		for _, key := range service.PublicKeys().Keys {
+			ids = append(ids, key.KeyID)
+		}
+		return ids
+	}
+	signedBy := func(token string) string {
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		if err != nil {
+			t.Fatalf("invalid token: %v", err)
+		}
+		return parsed.Header["kid"].(string)
+	}
+
+	oldToken, err := service.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if kid := signedBy(*oldToken); kid != "old" {
+		t.Fatalf("expected the old key to sign before the rotation, got %s", kid)
+	}
+	if ids := kids(); len(ids) != 2 {
+		t.Fatalf("expected the next key to be published in advance, got %v", ids)
+	}
+
+	c.now = rotation.Add(time.Hour)
+	newToken, err := service.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if kid := signedBy(*newToken); kid != "new" {
+		t.Fatalf("expected the new key to sign after the rotation, got %s", kid)
+	}
+	if _, err := service.ExtractClaims(*oldToken); err != nil {
+		t.Fatalf("expected tokens of the old key to stay valid during the overlap, got %v", err)
+	}
+
+	c.now = rotation.Add(accessTokenTTL)
+	if ids := kids(); len(ids) != 1 || ids[0] != "new" {
+		t.Fatalf("expected the old key to be retired after the overlap, got %v", ids)
+	}
+	if _, err := service.ExtractClaims(*newToken); err != nil {
+		t.Fatalf("ExtractClaims: %v", err)
+	}
+}
+
+func TestNewJWTServiceRequiresActiveKey(t *testing.T) {
+	future := testEd25519Key(t, "future", time.Now().Add(time.Hour))
+	if _, err := NewJWTService(JWTSettings{Issuer: "iss", Audience: "aud", Keys: []SigningKey{future}}); err == nil {
+		t.Fatalf("expected an error without an active key")
+	}
+
+	key := testEd25519Key(t, "k1", time.Time{})
+	if _, err := NewJWTService(JWTSettings{Issuer: "iss", Audience: "aud", Keys: []SigningKey{key, key}}); err == nil {
+		t.Fatalf("expected an error for duplicate key IDs")
+	}
+}
+
+func TestLoadSigningKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatalf("encoding key: %v", err)
+	}
+	files := map[string]*pem.Block{
+		"ed.pem":  {Type: "PRIVATE KEY", Bytes: pkcs8},
+		"rsa.pem": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testRSAKey(t))},
+	}
+	for name, block := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+	manifest := `{"keys": [
+		{"kid": "rsa", "private_key_file": "rsa.pem", "active_from": "2026-01-01T00:00:00Z"},
+		{"kid": "ed", "private_key_file": "ed.pem", "active_from": "2026-02-01T00:00:00Z"}
+	]}`
+	path := filepath.Join(dir, "keys.json")
+	if err := os.WriteFile(path, []byte(manifest), 0o600); err != nil {
+		t.Fatalf("writing manifest: %v", err)
+	}
+
+	keys, err := LoadSigningKeys(path)
+	if err != nil {
+		t.Fatalf("LoadSigningKeys: %v", err)
+	}
+	if len(keys) != 2 || keys[0].method != jwt.SigningMethodRS256 || keys[1].method != jwt.SigningMethodEdDSA {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+	if want := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC); !keys[1].ActiveFrom.Equal(want) {
+		t.Fatalf("expected ed to activate at %s, got %s", want, keys[1].ActiveFrom)
+	}
+}
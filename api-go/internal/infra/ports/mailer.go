@@ -0,0 +1,106 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+)
+
+const mailSendTimeout = 30 * time.Second
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+type logMailer struct{}
+
+// asyncMailer hands mails to a goroutine so that callers answer in the same
+// time whether or not a mail was sent; failures are only logged.
+type asyncMailer struct {
+	inner services.IMailer
+}
+
+// NewMailer sends through SMTP_HOST/SMTP_PORT (authenticating with
+// SMTP_USER/SMTP_PASSWORD when set) from MAIL_FROM. Without SMTP_HOST mails
+// are written to the log, which is enough for local development.
+func NewMailer() services.IMailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return &asyncMailer{inner: logMailer{}}
+	}
+
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+
+	var auth smtp.Auth
+	if user := os.Getenv("SMTP_USER"); user != "" {
+		auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+
+	return &asyncMailer{inner: &smtpMailer{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: os.Getenv("MAIL_FROM"),
+	}}
+}
+
+func (m *asyncMailer) Send(ctx context.Context, mail services.Mail) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), mailSendTimeout)
+	go func() {
+		defer cancel()
+		if err := m.inner.Send(ctx, mail); err != nil {
+			log.Printf("Error sending mail %q: %v", mail.Subject, err)
+		}
+	}()
+	return nil
+}
+
+func (m *smtpMailer) Send(ctx context.Context, mail services.Mail) error {
+	// MAIL_FROM may carry a display name; the envelope needs the bare address
+	sender, err := netmail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("invalid MAIL_FROM %q: %w", m.from, err)
+	}
+
+	msg := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + mail.To,
+		"Subject: " + mail.Subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		mail.Body,
+	}, "\r\n")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(m.addr, m.auth, sender.Address, []string{mail.To}, []byte(msg))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("error sending mail: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (logMailer) Send(ctx context.Context, mail services.Mail) error {
+	log.Printf("Mail to %s - %s\n%s", mail.To, mail.Subject, mail.Body)
+	return nil
+}
@@ -0,0 +1,135 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+const oidcDiscoveryTimeout = 10 * time.Second
+
+// OIDCProviderSettings describes a client registered at an OpenID Connect
+// provider.
+type OIDCProviderSettings struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// oidcProvider fetches the discovery document on first use, so the API
+// still starts while a provider is unreachable, and retries until it
+// succeeds. go-oidc caches the JWKS and refetches it when an unknown key ID
+// shows up, which covers key rotation at the provider.
+type oidcProvider struct {
+	settings OIDCProviderSettings
+
+	mu       sync.Mutex
+	config   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDCProvider(settings OIDCProviderSettings) services.IOIDCProvider {
+	return &oidcProvider{settings: settings}
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.config != nil {
+		return p.config, p.verifier, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oidcDiscoveryTimeout)
+	defer cancel()
+
+	provider, err := oidc.NewProvider(ctx, p.settings.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error discovering OIDC provider %s: %w", p.settings.Issuer, err)
+	}
+
+	scopes := p.settings.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+	p.config = &oauth2.Config{
+		ClientID:     p.settings.ClientID,
+		ClientSecret: p.settings.ClientSecret,
+		RedirectURL:  p.settings.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.settings.ClientID})
+	return p.config, p.verifier, nil
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	config, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, codeVerifier string) (*services.OIDCClaims, error) {
+	config, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging authorization code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying id_token: %w", err)
+	}
+
+	var claims struct {
+		Email         string       `json:"email"`
+		EmailVerified flexibleBool `json:"email_verified"`
+		Name          string       `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("error decoding id_token claims: %w", err)
+	}
+
+	return &services.OIDCClaims{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Nonce:         idToken.Nonce,
+	}, nil
+}
+
+// flexibleBool accepts both true and "true", as some providers send
+// email_verified as a string.
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	if unquoted, err := strconv.Unquote(string(data)); err == nil {
+		data = []byte(unquoted)
+	}
+	v, err := strconv.ParseBool(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	*b = flexibleBool(v)
+	return nil
+}
@@ -0,0 +1,171 @@
+package ports
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockIssuer is an OpenID Connect provider that redeems the code "code" when
+// the PKCE verifier matches the challenge of the last authorization URL.
+type mockIssuer struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	challenge string
+	// signingKey signs the ID tokens; it is key unless a test forges them.
+	signingKey *rsa.PrivateKey
+	claims     jwt.MapClaims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	m := &mockIssuer{key: key, signingKey: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                m.server.URL,
+			"authorization_endpoint":                m.server.URL + "/authorize",
+			"token_endpoint":                        m.server.URL + "/token",
+			"jwks_uri":                              m.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if r.PostFormValue("code") != "code" || base64.RawURLEncoding.EncodeToString(sum[:]) != m.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     m.sign(t, m.signingKey),
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+
+	return m
+}
+
+func (m *mockIssuer) sign(t *testing.T, key *rsa.PrivateKey) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, m.claims)
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("signing id_token: %v", err)
+	}
+	return signed
+}
+
+// authorize builds the login URL and keeps its PKCE challenge, as the
+// provider would when the user logs in.
+func (m *mockIssuer) authorize(t *testing.T, provider *oidcProvider, nonce, verifier string) {
+	t.Helper()
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", nonce, verifier)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid URL %q: %v", authURL, err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("nonce") != nonce || query.Get("state") != "state" {
+		t.Fatalf("unexpected authorization URL %s", authURL)
+	}
+	m.challenge = query.Get("code_challenge")
+}
+
+func (m *mockIssuer) baseClaims(audience string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            m.server.URL,
+		"sub":            "sub-1",
+		"aud":            audience,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          "nonce",
+		"email":          "user@example.com",
+		"email_verified": "true",
+		"name":           "User",
+	}
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+	m := newMockIssuer(t)
+	provider := NewOIDCProvider(OIDCProviderSettings{Issuer: m.server.URL, ClientID: "client"}).(*oidcProvider)
+	m.claims = m.baseClaims("client")
+	m.authorize(t, provider, "nonce", "verifier-verifier-verifier-verifier-verifier")
+
+	claims, err := provider.Exchange(context.Background(), "code", "verifier-verifier-verifier-verifier-verifier")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if claims.Subject != "sub-1" || claims.Email != "user@example.com" || !claims.EmailVerified || claims.Nonce != "nonce" || claims.Name != "User" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	if _, err := provider.Exchange(context.Background(), "code", "another-verifier-another-verifier-another"); err == nil {
+		t.Fatalf("expected a wrong PKCE verifier to be refused")
+	}
+}
+
+func TestOIDCProviderRejectsInvalidIDTokens(t *testing.T) {
+	m := newMockIssuer(t)
+	provider := NewOIDCProvider(OIDCProviderSettings{Issuer: m.server.URL, ClientID: "client"}).(*oidcProvider)
+	verifier := "verifier-verifier-verifier-verifier-verifier"
+	m.authorize(t, provider, "nonce", verifier)
+
+	m.claims = m.baseClaims("another-client")
+	if _, err := provider.Exchange(context.Background(), "code", verifier); err == nil {
+		t.Fatalf("expected a token for another audience to be refused")
+	}
+
+	m.claims = m.baseClaims("client")
+	forger, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	m.signingKey = forger
+	if _, err := provider.Exchange(context.Background(), "code", verifier); err == nil {
+		t.Fatalf("expected a token signed by another key to be refused")
+	}
+
+	m.signingKey = m.key
+	m.claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	if _, err := provider.Exchange(context.Background(), "code", verifier); err == nil {
+		t.Fatalf("expected an expired token to be refused")
+	}
+}
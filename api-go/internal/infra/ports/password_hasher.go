@@ -0,0 +1,141 @@
+package ports
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+var _ services.IPasswordHasher = (*PasswordHasher)(nil)
+
+// Argon2Params are the costs of Argon2id: Memory in KiB, Iterations over it
+// and the Parallelism (lanes) of each hash.
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// PasswordHasherSettings chooses the Algorithm new passwords are hashed with.
+// Hashes of the other algorithm are still verified, and reported as needing
+// a rehash.
+type PasswordHasherSettings struct {
+	Algorithm  string
+	BcryptCost int
+	Argon2     Argon2Params
+}
+
+// PasswordHasher hashes passwords with bcrypt or Argon2id. Argon2id hashes
+// use the PHC string format, "$argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>",
+// so they keep verifying after the settings change.
+type PasswordHasher struct {
+	settings PasswordHasherSettings
+}
+
+func NewPasswordHasher(settings PasswordHasherSettings) (*PasswordHasher, error) {
+	switch settings.Algorithm {
+	case models.PasswordAlgorithmBcrypt:
+		if settings.BcryptCost < bcrypt.MinCost || settings.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case models.PasswordAlgorithmArgon2id:
+		p := settings.Argon2
+		if p.Memory < 8*uint32(p.Parallelism) || p.Iterations == 0 || p.Parallelism == 0 {
+			return nil, errors.New("argon2id needs at least one iteration and lane, and 8 KiB of memory per lane")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported password algorithm %q", settings.Algorithm)
+	}
+	return &PasswordHasher{settings: settings}, nil
+}
+
+func (h *PasswordHasher) Hash(password string) (string, string, error) {
+	if h.settings.Algorithm == models.PasswordAlgorithmBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.settings.BcryptCost)
+		if err != nil {
+			return "", "", err
+		}
+		return string(hash), models.PasswordAlgorithmBcrypt, nil
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", "", err
+	}
+	p := h.settings.Argon2
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, argon2KeyLength)
+	hash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+	return hash, models.PasswordAlgorithmArgon2id, nil
+}
+
+func (h *PasswordHasher) Verify(password, hash, algorithm string) bool {
+	switch algorithm {
+	case models.PasswordAlgorithmBcrypt:
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	case models.PasswordAlgorithmArgon2id:
+		p, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return false
+		}
+		computed := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+		return subtle.ConstantTimeCompare(computed, key) == 1
+	default:
+		return false
+	}
+}
+
+func (h *PasswordHasher) NeedsRehash(hash, algorithm string) bool {
+	if algorithm != h.settings.Algorithm {
+		return true
+	}
+
+	if algorithm == models.PasswordAlgorithmBcrypt {
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.settings.BcryptCost
+	}
+	p, salt, key, err := decodeArgon2id(hash)
+	return err != nil || p != h.settings.Argon2 || len(salt) != argon2SaltLength || len(key) != argon2KeyLength
+}
+
+func decodeArgon2id(hash string) (Argon2Params, []byte, []byte, error) {
+	var p Argon2Params
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != models.PasswordAlgorithmArgon2id {
+		return p, nil, nil, errors.New("malformed argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2id version %q", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id parameters %q: %w", parts[3], err)
+	}
+	if p.Iterations == 0 || p.Parallelism == 0 {
+		return p, nil, nil, fmt.Errorf("invalid argon2id parameters %q", parts[3])
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, errors.New("malformed argon2id key")
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,136 @@
+package ports
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap settings keep the tests fast; only their shape matters here.
+var (
+	testArgon2   = Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1}
+	argon2Hasher = PasswordHasherSettings{Algorithm: models.PasswordAlgorithmArgon2id, Argon2: testArgon2}
+	bcryptHasher = PasswordHasherSettings{Algorithm: models.PasswordAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}
+)
+
+func newTestHasher(t *testing.T, settings PasswordHasherSettings) *PasswordHasher {
+	t.Helper()
+
+	hasher, err := NewPasswordHasher(settings)
+	if err != nil {
+		t.Fatalf("NewPasswordHasher: %v", err)
+	}
+	return hasher
+}
+
+func TestPasswordHasherRoundTrip(t *testing.T) {
+	for _, settings := range []PasswordHasherSettings{argon2Hasher, bcryptHasher} {
+		t.Run(settings.Algorithm, func(t *testing.T) {
+			hasher := newTestHasher(t, settings)
+
+			hash, algorithm, err := hasher.Hash("secret123")
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if algorithm != settings.Algorithm {
+				t.Fatalf("expected algorithm %q, got %q", settings.Algorithm, algorithm)
+			}
+			if !hasher.Verify("secret123", hash, algorithm) {
+				t.Fatalf("expected the password to verify against %q", hash)
+			}
+			if hasher.Verify("secret124", hash, algorithm) {
+				t.Fatalf("expected another password to be rejected")
+			}
+			if hasher.NeedsRehash(hash, algorithm) {
+				t.Fatalf("expected a fresh hash to be current")
+			}
+		})
+	}
+}
+
+func TestArgon2idHashFormat(t *testing.T) {
+	hasher := newTestHasher(t, argon2Hasher)
+
+	first, _, _ := hasher.Hash("secret123")
+	second, _, _ := hasher.Hash("secret123")
+	if first == second {
+		t.Fatalf("expected every hash to use a new salt")
+	}
+	if !strings.HasPrefix(first, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("expected a PHC string with the parameters, got %q", first)
+	}
+
+	for _, malformed := range []string{"", "$argon2id$v=19$m=64,t=1,p=1$c2FsdA", "$argon2id$v=18$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5", "$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHQ$a2V5"} {
+		if hasher.Verify("secret123", malformed, models.PasswordAlgorithmArgon2id) {
+			t.Fatalf("expected %q to be rejected", malformed)
+		}
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	oldBcrypt, _, _ := newTestHasher(t, bcryptHasher).Hash("secret123")
+	oldArgon2, _, _ := newTestHasher(t, argon2Hasher).Hash("secret123")
+
+	stronger := argon2Hasher
+	stronger.Argon2.Iterations = 2
+	hasher := newTestHasher(t, stronger)
+
+	if !hasher.NeedsRehash(oldBcrypt, models.PasswordAlgorithmBcrypt) {
+		t.Fatalf("expected bcrypt hashes to be upgraded to argon2id")
+	}
+	if !hasher.NeedsRehash(oldArgon2, models.PasswordAlgorithmArgon2id) {
+		t.Fatalf("expected hashes with other parameters to be upgraded")
+	}
+	if !hasher.Verify("secret123", oldBcrypt, models.PasswordAlgorithmBcrypt) || !hasher.Verify("secret123", oldArgon2, models.PasswordAlgorithmArgon2id) {
+		t.Fatalf("expected outdated hashes to keep verifying")
+	}
+
+	costlier := bcryptHasher
+	costlier.BcryptCost++
+	if !newTestHasher(t, costlier).NeedsRehash(oldBcrypt, models.PasswordAlgorithmBcrypt) {
+		t.Fatalf("expected bcrypt hashes of a lower cost to be upgraded")
+	}
+}
+
+func TestNewPasswordHasherRejectsInvalidSettings(t *testing.T) {
+	for _, settings := range []PasswordHasherSettings{
+		{Algorithm: "md5"},
+		{Algorithm: models.PasswordAlgorithmBcrypt, BcryptCost: bcrypt.MaxCost + 1},
+		{Algorithm: models.PasswordAlgorithmArgon2id, Argon2: Argon2Params{Memory: 64, Iterations: 0, Parallelism: 1}},
+		{Algorithm: models.PasswordAlgorithmArgon2id, Argon2: Argon2Params{Memory: 8, Iterations: 1, Parallelism: 2}},
+	} {
+		if _, err := NewPasswordHasher(settings); err == nil {
+			t.Fatalf("expected %+v to be rejected", settings)
+		}
+	}
+}
+
+func TestLoadBreachedPasswords(t *testing.T) {
+	defaults, err := LoadBreachedPasswords("")
+	if err != nil {
+		t.Fatalf("loading the default list: %v", err)
+	}
+	if _, ok := defaults["password1"]; !ok {
+		t.Fatalf("expected the default list to be loaded, got %d passwords", len(defaults))
+	}
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte("# leaked\n\n  Hunter22 \n"), 0o600); err != nil {
+		t.Fatalf("writing list: %v", err)
+	}
+	custom, err := LoadBreachedPasswords(path)
+	if err != nil {
+		t.Fatalf("loading %s: %v", path, err)
+	}
+	if _, ok := custom["hunter22"]; !ok || len(custom) != 1 {
+		t.Fatalf("expected only the lowercased entry, got %v", custom)
+	}
+
+	if _, err := LoadBreachedPasswords(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatalf("expected a missing file to fail")
+	}
+}
@@ -0,0 +1,55 @@
+package middlewares
+
+import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyScopes are the only routes API keys may call, keyed by
+// "METHOD /full/path", with the scope each one requires.
+var apiKeyScopes = map[string]string{
+	"GET /events/":                                     models.APIKeyScopeEventsRead,
+	"GET /events/registered":                           models.APIKeyScopeEventsRead,
+	"GET /events/:eventID":                             models.APIKeyScopeEventsRead,
+	"GET /events/:eventID/organizer":                   models.APIKeyScopeEventsRead,
+	"GET /events/:eventID/attendees/export":            models.APIKeyScopeEventsRead,
+	"GET /events/organizer":                            models.APIKeyScopeEventsRead,
+	"GET /events/category":                             models.APIKeyScopeEventsRead,
+	"GET /events/search":                               models.APIKeyScopeEventsRead,
+	"GET /events/import/:importID":                     models.APIKeyScopeEventsRead,
+	"POST /events/import":                              models.APIKeyScopeEventsWrite,
+	"POST /events/":                                    models.APIKeyScopeEventsWrite,
+	"PUT /events/:eventID":                             models.APIKeyScopeEventsWrite,
+	"DELETE /events/:eventID":                          models.APIKeyScopeEventsWrite,
+	"POST /events/:eventID/attendees":                  models.APIKeyScopeEventsWrite,
+	"POST /events/:eventID/attendees/remove":           models.APIKeyScopeEventsWrite,
+	"POST /events/:eventID/attendees/move":             models.APIKeyScopeEventsWrite,
+	"POST /events/:eventID/attendees/:userID/check-in": models.APIKeyScopeEventsWrite,
+	"GET /events/:eventID/invites":                     models.APIKeyScopeEventsRead,
+	"DELETE /events/:eventID/invites/:inviteID":        models.APIKeyScopeEventsWrite,
+	"POST /events/:eventID/register":                   models.APIKeyScopeRegistrationsWrite,
+	"DELETE /events/:eventID/register":                 models.APIKeyScopeRegistrationsWrite,
+}
+
+// authenticateAPIKey resolves the user of the key in the X-API-Key header.
+// It aborts the request and returns false when the key is invalid or lacks
+// the scope of the route.
+func authenticateAPIKey(c *gin.Context, apiKeys usecase.UseCaseWithProps[usecases.AuthenticateAPIKeyProps, string]) bool {
+	userID, err := apiKeys.Execute(usecases.AuthenticateAPIKeyProps{
+		Ctx:   c.Request.Context(),
+		Key:   c.GetHeader(apiKeyHeader),
+		Scope: apiKeyScopes[c.Request.Method+" "+c.FullPath()],
+	})
+	if err != nil {
+		c.Error(err)
+		c.Abort()
+		return false
+	}
+
+	c.Set("userID", userID)
+	return true
+}
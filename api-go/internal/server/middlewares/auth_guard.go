@@ -4,35 +4,72 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/database"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/database/connection"
 	"github.com/Gabriel-Schiestl/api-go/internal/infra/mappers"
-	"github.com/Gabriel-Schiestl/api-go/internal/infra/ports"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
-	service := ports.NewJWTService()
+// publicRoutes are reachable without a token, keyed by "METHOD /full/path"
+// or by path alone for every method.
+var publicRoutes = map[string]bool{
+	"/auth/login":                       true,
+	"POST /auth/login/2fa":              true,
+	"POST /users/":                      true,
+	"POST /auth/forgot-password":        true,
+	"POST /auth/reset-password":         true,
+	"GET /auth/verify":                  true,
+	"GET /auth/unlock":                  true,
+	"GET /auth/confirm-email":           true,
+	"GET /auth/oidc/:provider/login":    true,
+	"GET /auth/oidc/:provider/callback": true,
+	"GET /.well-known/jwks.json":        true,
+}
 
+func isPublicRoute(c *gin.Context) bool {
+	return publicRoutes[c.FullPath()] || publicRoutes[c.Request.Method+" "+c.FullPath()]
+}
+
+// AuthMiddleware accepts a token verified by service, from the Authorization
+// header or the session cookie, whose session sessions confirms is still
+// open, or an API key checked by apiKeys.
+func AuthMiddleware(service services.IJWTService, sessions usecase.UseCaseWithProps[usecases.AuthenticateSessionProps, struct{}], apiKeys usecase.UseCaseWithProps[usecases.AuthenticateAPIKeyProps, string]) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if c.FullPath() == "/auth/login" || c.FullPath() == "/auth/logout" || (c.FullPath() == "/users/" && c.Request.Method == "POST") {
+		if isPublicRoute(c) {
 			c.Next()
 			return
 		}
 
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token not provided"})
-			c.Abort()
+		if c.GetHeader(apiKeyHeader) != "" {
+			if authenticateAPIKey(c, apiKeys) {
+				c.Next()
+			}
 			return
 		}
 
-		// Extract token from "Bearer <token>" format
+		// The header wins over the session cookie, which alone needs a CSRF
+		// token on state-changing requests.
 		var authToken string
-		if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
-			authToken = authHeader[7:]
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
+				authToken = authHeader[7:]
+			} else {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token format"})
+				c.Abort()
+				return
+			}
+		} else if cookie, err := c.Cookie(SessionCookie); err == nil && cookie != "" {
+			if !validCSRF(c) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Invalid CSRF token"})
+				c.Abort()
+				return
+			}
+			authToken = cookie
 		} else {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token format"})
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token not provided"})
 			c.Abort()
 			return
 		}
@@ -49,16 +86,28 @@ func AuthMiddleware() gin.HandlerFunc {
 		userID := claims["sub"].(string)
 		log.Printf("Extracted user ID: %s", userID)
 
-		user, err := database.NewUserRepository(connection.Db, mappers.UserMapper{}).FindById(userID)
+		sessionID, _ := claims["sid"].(string)
+		if _, err := sessions.Execute(usecases.AuthenticateSessionProps{
+			Ctx:       c.Request.Context(),
+			SessionID: sessionID,
+			UserID:    userID,
+			IP:        c.ClientIP(),
+		}); err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		auth, err := database.NewAuthRepository(connection.Db, mappers.AuthMapper{}).FindByUserID(c.Request.Context(), userID)
 		if err != nil {
-			log.Printf("Error finding user by ID %s: %v", userID, err)
+			log.Printf("Error finding credentials of user %s: %v", userID, err)
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
 			c.Abort()
 			return
 		}
 
-		log.Printf("Found user: %s (ID: %s)", user.GetName(), user.GetID())
-		c.Set("userID", user.GetID())
+		c.Set("userID", auth.GetUserID())
+		c.Set("sessionID", sessionID)
 		c.Next()
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,162 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+// scopeRecorder accepts the key "valid" and records the scope asked for.
+type scopeRecorder struct {
+	scope string
+}
+
+func (r *scopeRecorder) Execute(props usecases.AuthenticateAPIKeyProps) (string, error) {
+	r.scope = props.Scope
+	if props.Key != "valid" {
+		return "", exceptions.NewUnauthorizedException("Invalid or expired API key")
+	}
+	if props.Scope == "" {
+		return "", exceptions.NewForbiddenException("API keys cannot access this route")
+	}
+	return "user-1", nil
+}
+
+// revokedSessions rejects every session, recording the last one checked.
+type revokedSessions struct {
+	props usecases.AuthenticateSessionProps
+}
+
+func (s *revokedSessions) Execute(props usecases.AuthenticateSessionProps) (struct{}, error) {
+	s.props = props
+	return struct{}{}, exceptions.NewUnauthorizedException("Session expired, please log in again")
+}
+
+func TestAuthMiddlewareAcceptsAPIKeys(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		key        string
+		wantScope  string
+		wantStatus int
+	}{
+		{name: "read", method: http.MethodGet, path: "/events/42", key: "valid", wantScope: models.APIKeyScopeEventsRead, wantStatus: http.StatusOK},
+		{name: "write", method: http.MethodPost, path: "/events/", key: "valid", wantScope: models.APIKeyScopeEventsWrite, wantStatus: http.StatusOK},
+		{name: "registration", method: http.MethodDelete, path: "/events/42/register", key: "valid", wantScope: models.APIKeyScopeRegistrationsWrite, wantStatus: http.StatusOK},
+		{name: "account routes", method: http.MethodPost, path: "/users/me/api-keys", key: "valid", wantStatus: http.StatusForbidden},
+		{name: "invalid key", method: http.MethodGet, path: "/events/42", key: "wrong", wantScope: models.APIKeyScopeEventsRead, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &scopeRecorder{}
+			router := gin.New()
+			router.Use(middlewares.ErrorMiddleware(), middlewares.AuthMiddleware(nil, &revokedSessions{}, recorder))
+			handler := func(c *gin.Context) { c.String(http.StatusOK, c.GetString("userID")) }
+			router.GET("/events/:eventID", handler)
+			router.POST("/events/", handler)
+			router.DELETE("/events/:eventID/register", handler)
+			router.POST("/users/me/api-keys", handler)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", tt.key)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body)
+			}
+			if recorder.scope != tt.wantScope {
+				t.Fatalf("expected scope %q, got %q", tt.wantScope, recorder.scope)
+			}
+			if tt.wantStatus == http.StatusOK && rec.Body.String() != "user-1" {
+				t.Fatalf("expected the key's user in the context, got %q", rec.Body)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareRequiresCSRFTokenWithSessionCookie(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		method     string
+		bearer     bool
+		csrfCookie string
+		csrfHeader string
+		wantStatus int
+	}{
+		{name: "safe method", method: http.MethodGet, wantStatus: http.StatusUnauthorized},
+		{name: "missing token", method: http.MethodPost, csrfCookie: "csrf", wantStatus: http.StatusForbidden},
+		{name: "mismatched token", method: http.MethodDelete, csrfCookie: "csrf", csrfHeader: "other", wantStatus: http.StatusForbidden},
+		{name: "matching token", method: http.MethodPost, csrfCookie: "csrf", csrfHeader: "csrf", wantStatus: http.StatusUnauthorized},
+		{name: "bearer header", method: http.MethodPost, bearer: true, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(middlewares.AuthMiddleware(memory.NewJWTService(), &revokedSessions{}, &scopeRecorder{}))
+			router.Handle(tt.method, "/events/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(tt.method, "/events/", nil)
+			// The fake service rejects the token, so requests passing the
+			// CSRF check end with 401.
+			if tt.bearer {
+				req.Header.Set("Authorization", "Bearer expired")
+			} else {
+				req.AddCookie(&http.Cookie{Name: middlewares.SessionCookie, Value: "expired"})
+			}
+			if tt.csrfCookie != "" {
+				req.AddCookie(&http.Cookie{Name: middlewares.CSRFCookie, Value: tt.csrfCookie})
+			}
+			if tt.csrfHeader != "" {
+				req.Header.Set(middlewares.CSRFHeader, tt.csrfHeader)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareRejectsRevokedSessions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jwt := memory.NewJWTService()
+	token, err := jwt.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	sessions := &revokedSessions{}
+	router := gin.New()
+	router.Use(middlewares.ErrorMiddleware(), middlewares.AuthMiddleware(jwt, sessions, &scopeRecorder{}))
+	router.GET("/events/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/events/", nil)
+	req.Header.Set("Authorization", "Bearer "+*token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusUnauthorized, rec.Code, rec.Body)
+	}
+	if sessions.props.SessionID != "session-1" || sessions.props.UserID != "user-1" {
+		t.Fatalf("expected the token's session to be checked, got %+v", sessions.props)
+	}
+}
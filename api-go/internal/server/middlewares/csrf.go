@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Cookies of browser sessions. SessionCookie holds the access token and is
+// HttpOnly; CSRFCookie is readable by the frontend, which echoes it in
+// CSRFHeader.
+const (
+	SessionCookie = "Authorization"
+	CSRFCookie    = "csrf_token"
+	CSRFHeader    = "X-CSRF-Token"
+)
+
+// validCSRF is the double-submit check of requests authenticated by the
+// session cookie: another site can make the browser send the cookies, but
+// cannot read them to copy the token into the header.
+func validCSRF(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	cookie, err := c.Cookie(CSRFCookie)
+	if err != nil || cookie == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie), []byte(c.GetHeader(CSRFHeader))) == 1
+}
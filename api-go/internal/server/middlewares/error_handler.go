@@ -0,0 +1,159 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/validation"
+	clarch "github.com/Gabriel-Schiestl/go-clarch/domain/exceptions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const problemContentType = "application/problem+json"
+
+// statusClientClosedRequest is the nginx convention for requests the client
+// abandoned before a response was written.
+const statusClientClosedRequest = 499
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Errors lists every invalid field when request validation fails.
+	Errors []validation.FieldError `json:"errors,omitempty"`
+}
+
+// retryAfterError is implemented by exceptions that tell the caller when to
+// try again, sent as the Retry-After header.
+type retryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// ErrorMiddleware turns the last error attached with c.Error into a
+// problem+json response, unless the handler already wrote a body.
+func ErrorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		last := c.Errors.Last()
+		err := last.Err
+
+		var problem Problem
+		if last.IsType(gin.ErrorTypeBind) {
+			problem = NewBindProblem(err, c.Request.URL.Path, c.GetHeader("Accept-Language"))
+		} else {
+			problem = NewProblem(err, c.Request.URL.Path)
+		}
+		if problem.Status >= http.StatusInternalServerError {
+			log.Printf("%s %s failed: %v", c.Request.Method, c.Request.URL.Path, err)
+		}
+
+		var retry retryAfterError
+		if errors.As(err, &retry) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retry.RetryAfter().Seconds()))))
+		}
+
+		c.Header("Content-Type", problemContentType)
+		c.JSON(problem.Status, problem)
+	}
+}
+
+// NewProblem maps domain errors to HTTP statuses. Unknown errors become a
+// 500 whose detail does not leak the underlying message.
+func NewProblem(err error, instance string) Problem {
+	status, detail := statusFor(err)
+
+	title := http.StatusText(status)
+	if status == statusClientClosedRequest {
+		title = "Client Closed Request"
+	}
+
+	return Problem{
+		Type:     "about:blank",
+		Title:    title,
+		Status:   status,
+		Detail:   detail,
+		Instance: instance,
+	}
+}
+
+// NewBindProblem describes a request body that could not be bound: 422 with
+// one localized message per field when validation failed, 400 when the body
+// itself could not be decoded.
+func NewBindProblem(err error, instance, acceptLanguage string) Problem {
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return Problem{
+			Type:     "about:blank",
+			Title:    http.StatusText(http.StatusBadRequest),
+			Status:   http.StatusBadRequest,
+			Detail:   "The request body is malformed",
+			Instance: instance,
+		}
+	}
+
+	return Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(http.StatusUnprocessableEntity),
+		Status:   http.StatusUnprocessableEntity,
+		Detail:   "One or more fields are invalid",
+		Instance: instance,
+		Errors:   validation.Translate(fieldErrs, acceptLanguage),
+	}
+}
+
+func statusFor(err error) (int, string) {
+	var (
+		notFound     *exceptions.NotFoundException
+		forbidden    *exceptions.ForbiddenException
+		conflict     *exceptions.ConflictException
+		validation   *exceptions.ValidationException
+		unauthorized *exceptions.UnauthorizedException
+		tooMany      *exceptions.TooManyRequestsException
+		locked       *exceptions.LockedException
+		business     *clarch.BusinessException
+		noData       *clarch.RepositoryNoDataFoundException
+	)
+
+	switch {
+	case errors.As(err, &notFound):
+		return http.StatusNotFound, err.Error()
+	case errors.As(err, &noData):
+		return http.StatusNotFound, err.Error()
+	case errors.As(err, &forbidden):
+		return http.StatusForbidden, err.Error()
+	case errors.As(err, &conflict):
+		return http.StatusConflict, err.Error()
+	case errors.As(err, &validation):
+		return http.StatusUnprocessableEntity, err.Error()
+	case errors.As(err, &business):
+		return http.StatusUnprocessableEntity, err.Error()
+	case errors.As(err, &unauthorized):
+		return http.StatusUnauthorized, err.Error()
+	case errors.As(err, &tooMany):
+		return http.StatusTooManyRequests, err.Error()
+	case errors.As(err, &locked):
+		return http.StatusLocked, err.Error()
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, "The request took too long to complete"
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest, "The request was cancelled"
+	default:
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
@@ -0,0 +1,87 @@
+package middlewares_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/exceptions"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func TestErrorMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantDetail string
+	}{
+		{name: "not found", err: exceptions.NewNotFoundException("event with ID 1 not found"), wantStatus: http.StatusNotFound, wantDetail: "event with ID 1 not found"},
+		{name: "forbidden", err: exceptions.NewForbiddenException("not your event"), wantStatus: http.StatusForbidden, wantDetail: "not your event"},
+		{name: "conflict", err: exceptions.NewConflictException("Event attendee limit reached"), wantStatus: http.StatusConflict, wantDetail: "Event attendee limit reached"},
+		{name: "validation", err: exceptions.NewValidationException("Event name is required"), wantStatus: http.StatusUnprocessableEntity, wantDetail: "Event name is required"},
+		{name: "too many requests", err: exceptions.NewTooManyRequestsException("slow down", 1500*time.Millisecond), wantStatus: http.StatusTooManyRequests, wantDetail: "slow down"},
+		{name: "locked", err: exceptions.NewLockedException("Account locked", 90*time.Second), wantStatus: http.StatusLocked, wantDetail: "Account locked"},
+		{name: "wrapped", err: fmt.Errorf("loading: %w", exceptions.NewNotFoundException("gone")), wantStatus: http.StatusNotFound, wantDetail: "loading: gone"},
+		{name: "deadline", err: fmt.Errorf("query: %w", context.DeadlineExceeded), wantStatus: http.StatusGatewayTimeout, wantDetail: "The request took too long to complete"},
+		{name: "unknown errors are hidden", err: errors.New("pq: connection refused"), wantStatus: http.StatusInternalServerError, wantDetail: "An unexpected error occurred"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(middlewares.ErrorMiddleware())
+			router.GET("/events/:id", func(c *gin.Context) { c.Error(tt.err) })
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events/1", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+				t.Fatalf("expected problem+json, got %q", ct)
+			}
+
+			var problem middlewares.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("invalid body: %v", err)
+			}
+			if tt.wantStatus == http.StatusTooManyRequests && rec.Header().Get("Retry-After") != "2" {
+				t.Fatalf("expected Retry-After rounded up to 2, got %q", rec.Header().Get("Retry-After"))
+			}
+			if tt.wantStatus == http.StatusLocked && rec.Header().Get("Retry-After") != "90" {
+				t.Fatalf("expected Retry-After 90, got %q", rec.Header().Get("Retry-After"))
+			}
+			if problem.Status != tt.wantStatus || problem.Detail != tt.wantDetail || problem.Instance != "/events/1" || problem.Title == "" {
+				t.Fatalf("unexpected problem: %+v", problem)
+			}
+		})
+	}
+}
+
+func TestErrorMiddlewareKeepsWrittenResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(middlewares.ErrorMiddleware())
+	router.GET("/", func(c *gin.Context) {
+		c.Error(errors.New("logged only"))
+		c.JSON(http.StatusAccepted, gin.H{"ok": true})
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected the handler's status, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"regexp"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// validRequestID keeps IDs set by clients or proxies short and printable, as
+// they end up in logs and in the audit log.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestIDMiddleware tags every request with an ID, reusing the one in the
+// X-Request-ID header when valid. The ID is echoed in the response and
+// stored in the request context.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		c.Header(RequestIDHeader, id)
+		c.Set("requestID", id)
+		c.Request = c.Request.WithContext(utils.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
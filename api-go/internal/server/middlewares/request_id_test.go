@@ -0,0 +1,54 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "generated without a header", header: ""},
+		{name: "kept from the client", header: "req-42.a:b", want: "req-42.a:b"},
+		{name: "replaced when unsafe", header: "bad id\r\nX-Injected: 1"},
+		{name: "replaced when too long", header: strings.Repeat("a", 129)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var inContext string
+			router := gin.New()
+			router.Use(middlewares.RequestIDMiddleware())
+			router.GET("/", func(c *gin.Context) { inContext = utils.RequestIDFromContext(c.Request.Context()) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(middlewares.RequestIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(middlewares.RequestIDHeader)
+			if got == "" || got != inContext {
+				t.Fatalf("expected the response and the context to share the ID, got %q and %q", got, inContext)
+			}
+			if tt.want != "" && got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+			if tt.want == "" && got == tt.header {
+				t.Fatalf("expected %q to be replaced", tt.header)
+			}
+		})
+	}
+}
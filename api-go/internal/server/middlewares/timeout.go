@@ -0,0 +1,26 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// TimeoutMiddleware puts a deadline on the request context so database calls
+// made with c.Request.Context() are cancelled once the route's budget is spent.
+func TimeoutMiddleware(cfg *config.TimeoutConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := cfg.For(c.Request.Method, c.FullPath())
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
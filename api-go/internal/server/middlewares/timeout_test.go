@@ -0,0 +1,62 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func TestTimeoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		routes       string
+		path         string
+		wantDeadline bool
+		wantAtLeast  time.Duration
+		wantAtMost   time.Duration
+	}{
+		{name: "default deadline", path: "/events/e1", wantDeadline: true, wantAtMost: time.Second},
+		{name: "exports outlast the default", path: "/events/e1/attendees/export", wantDeadline: true, wantAtLeast: 5 * time.Minute},
+		{name: "configured route wins", routes: "GET /events/:eventID/attendees/export=2s", path: "/events/e1/attendees/export", wantDeadline: true, wantAtMost: 2 * time.Second},
+		{name: "zero disables the deadline", routes: "GET /events/:eventID/attendees/export=0", path: "/events/e1/attendees/export"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.NewTimeoutConfig("1s", tt.routes)
+			if err != nil {
+				t.Fatalf("config: %v", err)
+			}
+
+			var (
+				deadline    time.Time
+				hasDeadline bool
+			)
+			handler := func(c *gin.Context) { deadline, hasDeadline = c.Request.Context().Deadline() }
+			router := gin.New()
+			router.Use(middlewares.TimeoutMiddleware(cfg))
+			router.GET("/events/:eventID", handler)
+			router.GET("/events/:eventID/attendees/export", handler)
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if hasDeadline != tt.wantDeadline {
+				t.Fatalf("expected deadline %v, got %v", tt.wantDeadline, hasDeadline)
+			}
+			if !hasDeadline {
+				return
+			}
+			left := time.Until(deadline)
+			if left < tt.wantAtLeast || (tt.wantAtMost > 0 && left > tt.wantAtMost) {
+				t.Fatalf("expected a deadline between %v and %v, got %v", tt.wantAtLeast, tt.wantAtMost, left)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package server
 
 import (
+	"github.com/Gabriel-Schiestl/api-go/internal/application/usecases"
+	appconfig "github.com/Gabriel-Schiestl/api-go/internal/config"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/services"
 	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/Gabriel-Schiestl/go-clarch/application/usecase"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
@@ -10,14 +14,30 @@ var Router *gin.Engine
 
 func init() {
 	Router = gin.New()
+}
+
+// Setup registers the global middlewares. It must run after the environment
+// is loaded and before the controllers register their routes. Only
+// trustedProxies may set the client IP through X-Forwarded-For, which login
+// throttling relies on. jwtService verifies the access tokens, sessions
+// whether their session is still open, and apiKeys the API keys.
+func Setup(timeouts *appconfig.TimeoutConfig, trustedProxies []string, jwtService services.IJWTService, sessions usecase.UseCaseWithProps[usecases.AuthenticateSessionProps, struct{}], apiKeys usecase.UseCaseWithProps[usecases.AuthenticateAPIKeyProps, string]) error {
+	if err := Router.SetTrustedProxies(trustedProxies); err != nil {
+		return err
+	}
 
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{"http://localhost:5173"}
-	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	config.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", middlewares.CSRFHeader, middlewares.RequestIDHeader}
+	config.ExposeHeaders = []string{middlewares.RequestIDHeader}
 	config.AllowCredentials = true
 
 	Router.Use(gin.Recovery())
+	Router.Use(middlewares.RequestIDMiddleware())
+	Router.Use(middlewares.ErrorMiddleware())
 	Router.Use(cors.New(config))
-	Router.Use(middlewares.AuthMiddleware())
-}
\ No newline at end of file
+	Router.Use(middlewares.TimeoutMiddleware(timeouts))
+	Router.Use(middlewares.AuthMiddleware(jwtService, sessions, apiKeys))
+	return nil
+}
@@ -0,0 +1,222 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/repositories"
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/pt_BR"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	pt_BR_translations "github.com/go-playground/validator/v10/translations/pt_BR"
+)
+
+const emailLookupTimeout = 2 * time.Second
+
+var universal *ut.UniversalTranslator
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type customTranslation struct {
+	tag string
+	en  string
+	pt  string
+}
+
+var customTranslations = []customTranslation{
+	{tag: "eventdate", en: "{0} must use the format YYYY-MM-DDTHH:MM", pt: "{0} deve usar o formato AAAA-MM-DDTHH:MM"},
+	{tag: "futuredate", en: "{0} must be a date in the future", pt: "{0} deve ser uma data futura"},
+	{tag: "ianazone", en: "{0} must be an IANA time zone such as America/Sao_Paulo", pt: "{0} deve ser um fuso horário IANA, como America/Sao_Paulo"},
+	{tag: "strongpassword", en: "{0} must contain at least one letter and one digit", pt: "{0} deve conter ao menos uma letra e um número"},
+	{tag: "emailavailable", en: "{0} is already registered", pt: "{0} já está cadastrado"},
+}
+
+// Setup registers the custom validators and the en/pt_BR translations on
+// gin's validator engine. It must run before any request is bound.
+func Setup(users repositories.UserRepository) error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("gin validator engine is not go-playground/validator")
+	}
+
+	v.RegisterTagNameFunc(jsonFieldName)
+
+	validators := map[string]validator.Func{
+		"eventdate":      isEventDate,
+		"futuredate":     isFutureDate,
+		"ianazone":       isIANAZone,
+		"strongpassword": isStrongPassword,
+		"emailavailable": emailAvailable(users),
+	}
+	for tag, fn := range validators {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+
+	enLocale := en.New()
+	universal = ut.New(enLocale, enLocale, pt_BR.New())
+
+	enTrans, _ := universal.GetTranslator("en")
+	if err := en_translations.RegisterDefaultTranslations(v, enTrans); err != nil {
+		return err
+	}
+	ptTrans, _ := universal.GetTranslator("pt_BR")
+	if err := pt_BR_translations.RegisterDefaultTranslations(v, ptTrans); err != nil {
+		return err
+	}
+
+	for _, ct := range customTranslations {
+		if err := registerTranslation(v, enTrans, ct.tag, ct.en); err != nil {
+			return err
+		}
+		if err := registerTranslation(v, ptTrans, ct.tag, ct.pt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Translate turns validator errors into one message per invalid field, in the
+// best language offered by the Accept-Language header (English by default).
+func Translate(errs validator.ValidationErrors, acceptLanguage string) []FieldError {
+	var trans ut.Translator
+	if universal != nil {
+		trans, _ = universal.FindTranslator(parseAcceptLanguage(acceptLanguage)...)
+	}
+
+	fields := make([]FieldError, 0, len(errs))
+	for _, fe := range errs {
+		message := fe.Error()
+		if trans != nil {
+			message = fe.Translate(trans)
+		}
+		fields = append(fields, FieldError{Field: fe.Field(), Message: message})
+	}
+
+	return fields
+}
+
+func registerTranslation(v *validator.Validate, trans ut.Translator, tag, text string) error {
+	return v.RegisterTranslation(tag, trans,
+		func(t ut.Translator) error { return t.Add(tag, text, true) },
+		func(t ut.Translator, fe validator.FieldError) string {
+			message, err := t.T(tag, fe.Field())
+			if err != nil {
+				return fe.Error()
+			}
+			return message
+		},
+	)
+}
+
+func parseAcceptLanguage(header string) []string {
+	var locales []string
+	for _, part := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		locales = append(locales, strings.ReplaceAll(tag, "-", "_"))
+		if base, _, ok := strings.Cut(tag, "-"); ok {
+			locales = append(locales, base)
+		}
+	}
+	// "pt" alone should still resolve to the Brazilian translations.
+	for i, locale := range locales {
+		if locale == "pt" {
+			locales[i] = "pt_BR"
+		}
+	}
+
+	return append(locales, "en")
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func isEventDate(fl validator.FieldLevel) bool {
+	_, err := time.Parse(utils.EventDateLayout, fl.Field().String())
+	return err == nil
+}
+
+// isFutureDate reads the sibling Timezone field, when present and valid, to
+// interpret the date in the organizer's zone.
+func isFutureDate(fl validator.FieldLevel) bool {
+	zone := ""
+	if parent := fl.Parent(); parent.Kind() == reflect.Struct {
+		if tz := parent.FieldByName("Timezone"); tz.IsValid() && tz.Kind() == reflect.String {
+			zone = tz.String()
+		}
+	}
+
+	date, err := utils.ParseEventDate(fl.Field().String(), zone)
+	if err != nil {
+		date, err = utils.ParseEventDate(fl.Field().String(), "")
+		if err != nil {
+			return false
+		}
+	}
+
+	return date.After(time.Now())
+}
+
+// isIANAZone only accepts "UTC" or Area/Location names, rejecting
+// abbreviations like "EST" and "Local" that time.LoadLocation also accepts.
+func isIANAZone(fl validator.FieldLevel) bool {
+	zone := fl.Field().String()
+	if zone != "UTC" && !strings.Contains(zone, "/") {
+		return false
+	}
+
+	_, err := time.LoadLocation(zone)
+	return err == nil
+}
+
+func isStrongPassword(fl validator.FieldLevel) bool {
+	var hasLetter, hasDigit bool
+	for _, r := range fl.Field().String() {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	return hasLetter && hasDigit
+}
+
+// emailAvailable fails only when the repository confirms the email is taken;
+// lookup errors are left for the use case to surface.
+func emailAvailable(users repositories.UserRepository) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		ctx, cancel := context.WithTimeout(context.Background(), emailLookupTimeout)
+		defer cancel()
+
+		exists, err := users.ExistsByEmail(ctx, fl.Field().String())
+		if err != nil {
+			return true
+		}
+		return !exists
+	}
+}
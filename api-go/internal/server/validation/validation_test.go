@@ -0,0 +1,199 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/application/dtos"
+	"github.com/Gabriel-Schiestl/api-go/internal/domain/models"
+	"github.com/Gabriel-Schiestl/api-go/internal/infra/memory"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/middlewares"
+	"github.com/Gabriel-Schiestl/api-go/internal/server/validation"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+
+	users := memory.NewUserRepository()
+	name, email := "Taken", "taken@example.com"
+	if err := users.Create(context.Background(), models.NewUser(models.UserProps{Name: &name, Email: &email})); err != nil {
+		panic(err)
+	}
+	if err := validation.Setup(users); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(middlewares.ErrorMiddleware())
+
+	bind := func(body any) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			if err := c.ShouldBindJSON(body); err != nil {
+				c.Error(err).SetType(gin.ErrorTypeBind)
+				return
+			}
+			c.Status(http.StatusNoContent)
+		}
+	}
+	router.POST("/users", bind(&dtos.CreateUserDTO{}))
+	router.POST("/events", bind(&dtos.CreateEventProps{}))
+	router.PUT("/events", bind(&dtos.UpdateEventProps{}))
+
+	return router
+}
+
+func post(t *testing.T, method, path, body, language string) (int, middlewares.Problem) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if language != "" {
+		req.Header.Set("Accept-Language", language)
+	}
+	rec := httptest.NewRecorder()
+	newRouter().ServeHTTP(rec, req)
+
+	var problem middlewares.Problem
+	if rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("invalid body %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec.Code, problem
+}
+
+func fieldsOf(problem middlewares.Problem) map[string]string {
+	fields := map[string]string{}
+	for _, fe := range problem.Errors {
+		fields[fe.Field] = fe.Message
+	}
+	return fields
+}
+
+func TestCreateUserValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantFields []string
+	}{
+		{name: "valid", body: `{"name":"Ana","email":"ana@example.com","password":"secret123"}`},
+		{name: "every field invalid", body: `{"email":"not-an-email","password":"short","userType":"admin"}`, wantFields: []string{"name", "email", "password", "userType"}},
+		{name: "weak password", body: `{"name":"Ana","email":"ana@example.com","password":"onlyletters"}`, wantFields: []string{"password"}},
+		{name: "email already registered", body: `{"name":"Ana","email":"taken@example.com","password":"secret123"}`, wantFields: []string{"email"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, problem := post(t, http.MethodPost, "/users", tt.body, "")
+
+			if len(tt.wantFields) == 0 {
+				if status != http.StatusNoContent {
+					t.Fatalf("expected the body to be accepted, got %d %+v", status, problem)
+				}
+				return
+			}
+
+			if status != http.StatusUnprocessableEntity {
+				t.Fatalf("expected 422, got %d", status)
+			}
+			fields := fieldsOf(problem)
+			if len(fields) != len(tt.wantFields) {
+				t.Fatalf("expected errors for %v, got %+v", tt.wantFields, problem.Errors)
+			}
+			for _, field := range tt.wantFields {
+				if fields[field] == "" {
+					t.Fatalf("expected an error for %q, got %+v", field, problem.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestEventDateValidation(t *testing.T) {
+	future := time.Now().AddDate(0, 1, 0).Format("2006-01-02T15:04")
+	past := time.Now().AddDate(0, -1, 0).Format("2006-01-02T15:04")
+	event := func(date, timezone string) string {
+		return `{"name":"Go Meetup","location":"Room 1","category":"tech","limit":10,"date":"` + date + `","timezone":"` + timezone + `"}`
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantFields []string
+	}{
+		{name: "future date in a named zone", method: http.MethodPost, body: event(future, "America/Sao_Paulo")},
+		{name: "past date on create", method: http.MethodPost, body: event(past, ""), wantFields: []string{"date"}},
+		{name: "past date on update", method: http.MethodPut, body: event(past, "")},
+		{name: "unparseable date", method: http.MethodPut, body: event("next friday", ""), wantFields: []string{"date"}},
+		{name: "zone abbreviation", method: http.MethodPost, body: event(future, "EST"), wantFields: []string{"timezone"}},
+		{name: "unknown zone", method: http.MethodPost, body: event(future, "Mars/Olympus"), wantFields: []string{"timezone"}},
+		{name: "negative limit and missing name", method: http.MethodPost, body: `{"location":"Room 1","category":"tech","limit":-1,"date":"` + future + `"}`, wantFields: []string{"name", "limit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, problem := post(t, tt.method, "/events", tt.body, "")
+
+			if len(tt.wantFields) == 0 {
+				if status != http.StatusNoContent {
+					t.Fatalf("expected the body to be accepted, got %d %+v", status, problem)
+				}
+				return
+			}
+
+			fields := fieldsOf(problem)
+			if status != http.StatusUnprocessableEntity || len(fields) != len(tt.wantFields) {
+				t.Fatalf("expected 422 for %v, got %d %+v", tt.wantFields, status, problem.Errors)
+			}
+			for _, field := range tt.wantFields {
+				if fields[field] == "" {
+					t.Fatalf("expected an error for %q, got %+v", field, problem.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestMessagesFollowAcceptLanguage(t *testing.T) {
+	body := `{"name":"Ana","email":"ana@example.com","password":"onlyletters"}`
+
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "", want: "password must contain at least one letter and one digit"},
+		{language: "pt-BR,pt;q=0.9,en;q=0.8", want: "password deve conter ao menos uma letra e um número"},
+		{language: "pt", want: "password deve conter ao menos uma letra e um número"},
+		{language: "fr-FR", want: "password must contain at least one letter and one digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			_, problem := post(t, http.MethodPost, "/users", body, tt.language)
+
+			if got := fieldsOf(problem)["password"]; got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMalformedBodyIsBadRequest(t *testing.T) {
+	status, problem := post(t, http.MethodPost, "/users", `{"name":`, "")
+
+	if status != http.StatusBadRequest || len(problem.Errors) != 0 {
+		t.Fatalf("expected a plain 400, got %d %+v", status, problem)
+	}
+}
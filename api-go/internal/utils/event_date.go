@@ -0,0 +1,20 @@
+package utils
+
+import "time"
+
+// EventDateLayout is the format sent by the frontend's datetime-local inputs.
+const EventDateLayout = "2006-01-02T15:04"
+
+// ParseEventDate parses date in the given IANA zone, or UTC when zone is empty.
+func ParseEventDate(date, zone string) (time.Time, error) {
+	location := time.UTC
+	if zone != "" {
+		loaded, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		location = loaded
+	}
+
+	return time.ParseInLocation(EventDateLayout, date, location)
+}
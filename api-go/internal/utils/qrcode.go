@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"encoding/base64"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// QRCodeDataURI renders content as a PNG QR code embedded in a data URI,
+// ready to be used as an <img> source.
+func QRCodeDataURI(content string) (string, error) {
+	png, err := qrcode.Encode(content, qrcode.Medium, 256)
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StringMap stores a map[string]string in a json/jsonb column.
+type StringMap map[string]string
+
+func (m *StringMap) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("falha ao converter valor para bytes: %v", value)
+	}
+}
+
+func (m StringMap) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,103 @@
+package utils_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+func TestCSVTableWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := utils.NewCSVTableWriter(&out)
+	for _, row := range [][]string{
+		{"name", "email"},
+		{"Ana, \"Jr\"", "ana@example.com"},
+		{"=HYPERLINK(\"http://evil\")", "-1+1"},
+	} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatalf("writing row: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("closing: %v", err)
+	}
+
+	want := "name,email\n\"Ana, \"\"Jr\"\"\",ana@example.com\n\"'=HYPERLINK(\"\"http://evil\"\")\",'-1+1\n"
+	if out.String() != want {
+		t.Fatalf("got %q, want %q", out.String(), want)
+	}
+}
+
+func TestXLSXTableWriter(t *testing.T) {
+	var out bytes.Buffer
+	w, err := utils.NewXLSXTableWriter(&out, "Attendees: [day 1]")
+	if err != nil {
+		t.Fatalf("creating writer: %v", err)
+	}
+	for _, row := range [][]string{{"name", "email"}, {"Ana <&>", "ana@example.com"}} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatalf("writing row: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("closing: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatalf("reading archive: %v", err)
+	}
+	parts := map[string]string{}
+	for _, file := range archive.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatalf("opening %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("reading %s: %v", file.Name, err)
+		}
+		parts[file.Name] = string(content)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/workbook.xml", "xl/worksheets/sheet1.xml"} {
+		content, ok := parts[name]
+		if !ok {
+			t.Fatalf("missing part %s", name)
+		}
+		if err := xml.Unmarshal([]byte(content), new(struct{})); err != nil {
+			t.Fatalf("part %s is not well-formed: %v", name, err)
+		}
+	}
+	if !strings.Contains(parts["xl/workbook.xml"], `name="Attendees day 1"`) {
+		t.Fatalf("expected the sheet name to be sanitized, got %s", parts["xl/workbook.xml"])
+	}
+
+	var sheet struct {
+		Rows []struct {
+			R     string `xml:"r,attr"`
+			Cells []struct {
+				R    string `xml:"r,attr"`
+				Text string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal([]byte(parts["xl/worksheets/sheet1.xml"]), &sheet); err != nil {
+		t.Fatalf("decoding sheet: %v", err)
+	}
+	if len(sheet.Rows) != 2 || sheet.Rows[1].R != "2" {
+		t.Fatalf("expected two rows, got %+v", sheet.Rows)
+	}
+	if cell := sheet.Rows[1].Cells[1]; cell.R != "B2" || cell.Text != "ana@example.com" {
+		t.Fatalf("unexpected cell %+v", cell)
+	}
+	if text := sheet.Rows[1].Cells[0].Text; text != "Ana <&>" {
+		t.Fatalf("expected escaped text to round-trip, got %q", text)
+	}
+}
@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Gabriel-Schiestl/api-go/internal/utils"
+)
+
+// RFC 6238 appendix B vectors for SHA1, secret "12345678901234567890".
+func TestTOTPCodeMatchesRFC6238(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := utils.TOTPCode(secret, utils.TOTPStep(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Fatalf("at %d expected %s, got %s", tt.unix, tt.want, got)
+		}
+	}
+}
+
+func TestMatchTOTPAcceptsOneStepOfDrift(t *testing.T) {
+	secret, err := utils.NewTOTPSecret()
+	if err != nil {
+		t.Fatalf("generating secret: %v", err)
+	}
+	now := time.Now()
+	step := utils.TOTPStep(now)
+
+	for _, offset := range []int64{-1, 0, 1} {
+		code, _ := utils.TOTPCode(secret, step+offset)
+		if matched, ok := utils.MatchTOTP(secret, code, now); !ok || matched != step+offset {
+			t.Fatalf("expected offset %d to match, got %d %v", offset, matched, ok)
+		}
+	}
+
+	code, _ := utils.TOTPCode(secret, step+2)
+	if _, ok := utils.MatchTOTP(secret, code, now); ok {
+		t.Fatalf("expected a code two steps ahead to be rejected")
+	}
+}
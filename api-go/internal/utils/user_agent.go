@@ -0,0 +1,56 @@
+package utils
+
+import "strings"
+
+// userAgentBrowsers and userAgentSystems are checked in order, so tokens
+// other browsers also send ("Chrome", "Safari", "Linux") come last.
+var (
+	userAgentBrowsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	userAgentSystems = []struct{ token, name string }{
+		{"Windows", "Windows"},
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iOS"},
+		{"CrOS", "ChromeOS"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DescribeUserAgent summarizes a User-Agent header for people, such as
+// "Firefox on Linux". Clients that are not browsers are named by their first
+// product, such as "curl".
+func DescribeUserAgent(userAgent string) string {
+	var browser, system string
+	for _, b := range userAgentBrowsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range userAgentSystems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	}
+
+	product, _, _ := strings.Cut(strings.TrimSpace(userAgent), "/")
+	if product == "" || strings.Contains(product, " ") {
+		return "Unknown device"
+	}
+	return product
+}
@@ -10,6 +10,14 @@ import type {
 
 const API_BASE_URL = '/api';
 
+// Respostas de validação (422) trazem uma mensagem por campo em `errors`
+function problemMessage(errorData: any, response: Response): string {
+  if (Array.isArray(errorData?.errors) && errorData.errors.length > 0) {
+    return errorData.errors.map((e: { message: string }) => e.message).join('\n');
+  }
+  return errorData?.detail || errorData?.message || errorData?.error || `HTTP error! status: ${response.status} - ${response.statusText}`;
+}
+
 // Verificar se o backend está rodando em outra porta
 // const API_BASE_URL = 'http://localhost:3001'; // Se estiver em outra porta
 
@@ -51,8 +59,8 @@ class ApiService {
         let errorData = {};
         const contentType = response.headers.get('content-type');
         
-        // Só tentar fazer parse do JSON se houver content-type application/json
-        if (contentType && contentType.includes('application/json')) {
+        // Só tentar fazer parse do JSON se houver content-type JSON (inclui application/problem+json)
+        if (contentType && (contentType.includes('application/json') || contentType.includes('application/problem+json'))) {
           try {
             errorData = await response.json();
           } catch (e) {
@@ -61,7 +69,7 @@ class ApiService {
         }
         
         console.error('API Error Response:', errorData);
-        const errorMessage = (errorData as any)?.message || `HTTP error! status: ${response.status} - ${response.statusText}`;
+        const errorMessage = problemMessage(errorData, response);
         throw new Error(errorMessage);
       }
       
@@ -112,8 +120,8 @@ class ApiService {
         let errorData = {};
         const contentType = response.headers.get('content-type');
         
-        // Só tentar fazer parse do JSON se houver content-type application/json
-        if (contentType && contentType.includes('application/json')) {
+        // Só tentar fazer parse do JSON se houver content-type JSON (inclui application/problem+json)
+        if (contentType && (contentType.includes('application/json') || contentType.includes('application/problem+json'))) {
           try {
             errorData = await response.json();
           } catch (e) {
@@ -122,7 +130,7 @@ class ApiService {
         }
         
         console.error('API Error Response:', errorData);
-        const errorMessage = (errorData as any)?.message || `HTTP error! status: ${response.status} - ${response.statusText}`;
+        const errorMessage = problemMessage(errorData, response);
         throw new Error(errorMessage);
       }
       
@@ -172,7 +180,10 @@ class ApiService {
   async createEvent(data: CreateEventRequest): Promise<CreateEventResponse> {
     return this.request<CreateEventResponse>('/events/', {
       method: 'POST',
-      body: JSON.stringify(data),
+      body: JSON.stringify({
+        ...data,
+        timezone: Intl.DateTimeFormat().resolvedOptions().timeZone,
+      }),
     });
   }
 